@@ -15,16 +15,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/apigee/registry/pkg/log"
 	"github.com/apigee/registry/pkg/log/interceptor"
 	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/auth"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
@@ -40,11 +43,40 @@ const prometheusPath = "/metrics"
 // ServerConfig is the top-level configuration structure.
 type ServerConfig struct {
 	// Server port. If unset or zero, an open port will be assigned.
-	Port       int              `yaml:"port"`
-	Database   DatabaseConfig   `yaml:"database"`
-	Logging    LoggingConfig    `yaml:"logging"`
-	Pubsub     PubsubConfig     `yaml:"pubsub"`
-	Monitoring MonitoringConfig `yaml:"monitoring"`
+	Port          int                 `yaml:"port"`
+	TLS           TLSConfig           `yaml:"tls"`
+	Gateway       GatewayConfig       `yaml:"gateway"`
+	Database      DatabaseConfig      `yaml:"database"`
+	Logging       LoggingConfig       `yaml:"logging"`
+	Pubsub        PubsubConfig        `yaml:"pubsub"`
+	Notifications NotificationsConfig `yaml:"notifications"`
+	Blobs         BlobsConfig         `yaml:"blobs"`
+	Trash         TrashConfig         `yaml:"trash"`
+	Auth          AuthConfig          `yaml:"auth"`
+	Monitoring    MonitoringConfig    `yaml:"monitoring"`
+}
+
+// TLSConfig holds TLS configuration for the server's listener.
+// Files are reloaded when they change.
+type TLSConfig struct {
+	// PEM file with the server's certificate chain. If unset, TLS is not used.
+	CertFile string `yaml:"cert_file"`
+	// PEM file with the private key of the server's certificate.
+	KeyFile string `yaml:"key_file"`
+	// PEM file with the certificates of the authorities that sign client
+	// certificates. If set, clients must present a certificate (mutual TLS).
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+// GatewayConfig holds configuration for the HTTP/JSON gateway, which serves
+// the Registry and Admin services as described by their google.api.http options.
+type GatewayConfig struct {
+	// Enable the gateway.
+	// Values: [ true, false ], default: false
+	Enable bool `yaml:"enable"`
+	// Port where the gateway will listen. It uses the TLS configuration of
+	// the server. If unset or zero, an open port will be assigned.
+	Port int `yaml:"port"`
 }
 
 // DatabaseConfig holds database configuration.
@@ -78,6 +110,110 @@ type PubsubConfig struct {
 	Project string `yaml:"project"`
 }
 
+// NotificationsConfig holds configuration for additional notification sinks.
+type NotificationsConfig struct {
+	// Number of notifications that can be waiting for delivery to each sink.
+	// When a queue is full, delivery waits; changes stay in the outbox
+	// until they are delivered. Default: 1000.
+	QueueSize int `yaml:"queue_size"`
+	// Sinks that receive notifications of changes.
+	Sinks []SinkConfig `yaml:"sinks"`
+}
+
+// SinkConfig holds the configuration of a notification sink.
+type SinkConfig struct {
+	// Name identifying the sink in logs and metrics. Default: the sink type.
+	Name string `yaml:"name"`
+	// Type of the sink.
+	// Values: [ webhook, file ]
+	Type string `yaml:"type"`
+	// URL of the webhook (webhook).
+	// Example: "https://example.com/hook"
+	URL string `yaml:"url"`
+	// Secret used to sign webhook requests with HMAC-SHA256 (webhook).
+	// If unset, requests are not signed.
+	Secret string `yaml:"secret"`
+	// Number of times delivery is attempted (webhook). Default: 5.
+	MaxAttempts int `yaml:"max_attempts"`
+	// Path of the file that notifications are appended to as JSON lines (file).
+	Path string `yaml:"path"`
+}
+
+// BlobsConfig holds configuration for storing spec and artifact contents
+// outside of the database. Contents are addressed by their SHA-256 hashes.
+type BlobsConfig struct {
+	// Type of the blob store. If unset, contents are stored in the database.
+	// Values: [ file, s3 ]
+	Type string `yaml:"type"`
+	// Directory that holds blobs (file).
+	Path string `yaml:"path"`
+	// URL of the S3-compatible object store (s3).
+	// Example: "https://s3.us-east-1.amazonaws.com" or "http://localhost:9000"
+	Endpoint string `yaml:"endpoint"`
+	// Bucket that holds blobs (s3). It must already exist.
+	Bucket string `yaml:"bucket"`
+	// Prefix added to the names of objects (s3).
+	Prefix string `yaml:"prefix"`
+	// Region of the bucket (s3). Default: "us-east-1".
+	Region string `yaml:"region"`
+	// Credentials for the object store (s3).
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+}
+
+// TrashConfig holds configuration for deleted resources,
+// which can be restored until they expire.
+type TrashConfig struct {
+	// Time that deleted resources are kept before they are purged.
+	// Example: "168h". Default: 720h (30 days).
+	Retention time.Duration `yaml:"retention"`
+}
+
+// AuthConfig holds configuration for authenticating callers and for the
+// roles that they are granted. If no authenticators are configured,
+// calls are not authenticated.
+type AuthConfig struct {
+	// Static API keys, sent as bearer tokens or in "x-api-key" metadata.
+	APIKeys []APIKeyConfig `yaml:"api_keys"`
+	// Authenticate callers with the TLS client certificates that they present.
+	// Principals are the first email address, URI or common name of certificates.
+	// Values: [ true, false ]
+	ClientCertificates bool `yaml:"client_certificates"`
+	// Verification of JSON Web Tokens (such as OIDC ID tokens) sent as bearer tokens.
+	JWT JWTConfig `yaml:"jwt"`
+	// Roles granted to principals. If unset, authenticated callers can call every RPC.
+	Roles []RoleConfig `yaml:"roles"`
+}
+
+// APIKeyConfig holds a static API key.
+type APIKeyConfig struct {
+	Key string `yaml:"key"`
+	// Principal identified by the key.
+	Principal string `yaml:"principal"`
+}
+
+// JWTConfig holds configuration for verifying JSON Web Tokens.
+type JWTConfig struct {
+	// Path of a JSON Web Key Set file with the keys that sign tokens.
+	JWKS string `yaml:"jwks"`
+	// Required "iss" claim of tokens. If unset, it is not checked.
+	Issuer string `yaml:"issuer"`
+	// Required "aud" claim of tokens. If unset, it is not checked.
+	Audience string `yaml:"audience"`
+	// Claim that identifies principals. Default: "email", then "sub".
+	PrincipalClaim string `yaml:"principal_claim"`
+}
+
+// RoleConfig grants a role in a project to a principal.
+type RoleConfig struct {
+	// Principal that is granted the role, or "*" for every authenticated caller.
+	Principal string `yaml:"principal"`
+	// Project ID that the role is granted in, or "*" for all projects.
+	Project string `yaml:"project"`
+	// Values: [ viewer, editor, admin ]
+	Role string `yaml:"role"`
+}
+
 type MonitoringConfig struct {
 	// Enable Monitoring
 	// Values: [ true, false ], default: false
@@ -150,6 +286,16 @@ func main() {
 		logInterceptor = interceptor.CallLogger(logOpts...)
 	)
 
+	notifiers, err := newNotifiers(config.Notifications.Sinks)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to create notification sinks")
+	}
+
+	authenticators, err := newAuthenticators(config.Auth)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to create authenticators")
+	}
+
 	registryServer, err := registry.New(registry.Config{
 		Database:  config.Database.Driver,
 		DBConfig:  config.Database.Config,
@@ -158,18 +304,45 @@ func main() {
 		Notify:    config.Pubsub.Enable,
 		ProjectID: config.Pubsub.Project,
 		NoMigrate: noMigrate,
+
+		Notifiers:             notifiers,
+		NotificationQueueSize: config.Notifications.QueueSize,
+
+		BlobStore: registry.BlobStoreConfig{
+			Type:            config.Blobs.Type,
+			Path:            config.Blobs.Path,
+			Endpoint:        config.Blobs.Endpoint,
+			Bucket:          config.Blobs.Bucket,
+			Prefix:          config.Blobs.Prefix,
+			Region:          config.Blobs.Region,
+			AccessKeyID:     config.Blobs.AccessKeyID,
+			SecretAccessKey: config.Blobs.SecretAccessKey,
+		},
+		DeleteRetention: config.Trash.Retention,
+
+		Authenticators: authenticators,
+		Policy:         newPolicy(config.Auth.Roles),
+
+		TLS: registry.TLSConfig{
+			CertFile:     config.TLS.CertFile,
+			KeyFile:      config.TLS.KeyFile,
+			ClientCAFile: config.TLS.ClientCAFile,
+		},
 	})
 	if err != nil {
 		logger.WithError(err).Fatalf("Failed to create registry server")
 	}
 
 	var serverOptions []grpc.ServerOption
+	var unaryInterceptors []grpc.UnaryServerInterceptor
 	if config.Monitoring.Enable {
+		unaryInterceptors = []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor, logInterceptor}
 		serverOptions = []grpc.ServerOption{
-			grpc.ChainUnaryInterceptor(grpc_prometheus.UnaryServerInterceptor, logInterceptor),
+			grpc.ChainUnaryInterceptor(unaryInterceptors...),
 			grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
 		}
 	} else {
+		unaryInterceptors = []grpc.UnaryServerInterceptor{logInterceptor}
 		serverOptions = []grpc.ServerOption{
 			grpc.UnaryInterceptor(logInterceptor),
 		}
@@ -183,6 +356,19 @@ func main() {
 	}
 	logger.Infof("Listening on %s", listener.Addr())
 
+	var gatewayServer *http.Server
+	if config.Gateway.Enable {
+		var gatewayListener net.Listener
+		gatewayListener, gatewayServer, err = registryServer.ServeGateway(
+			&net.TCPAddr{Port: config.Gateway.Port},
+			unaryInterceptors...,
+		)
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to create gateway listener")
+		}
+		logger.Infof("Serving HTTP/JSON gateway on %s", gatewayListener.Addr())
+	}
+
 	if config.Monitoring.Enable {
 		grpc_prometheus.EnableHandlingTimeHistogram()
 		metricsListener, err := net.Listen("tcp", config.Monitoring.Address)
@@ -209,6 +395,11 @@ func main() {
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
 	<-done
+	if gatewayServer != nil {
+		if err := gatewayServer.Shutdown(context.Background()); err != nil {
+			logger.WithError(err).Warn("Failed to stop gateway")
+		}
+	}
 	server.GracefulStop()
 	registryServer.Close()
 }
@@ -218,6 +409,16 @@ func validateConfig() error {
 		return fmt.Errorf("invalid port %q: must be non-negative", config.Port)
 	}
 
+	if tls := config.TLS; tls.CertFile == "" && (tls.KeyFile != "" || tls.ClientCAFile != "") {
+		return fmt.Errorf("invalid tls.cert_file %q: TLS requires a certificate", tls.CertFile)
+	} else if tls.CertFile != "" && tls.KeyFile == "" {
+		return fmt.Errorf("invalid tls.key_file %q: TLS requires a private key", tls.KeyFile)
+	}
+
+	if port := config.Gateway.Port; port < 0 {
+		return fmt.Errorf("invalid gateway.port %d: must be non-negative", port)
+	}
+
 	switch driver := config.Database.Driver; driver {
 	case "sqlite3", "postgres", "cloudsqlpostgres":
 	default:
@@ -240,9 +441,176 @@ func validateConfig() error {
 		return fmt.Errorf("invalid pubsub.project %q: pubsub cannot be enabled without GCP project ID", project)
 	}
 
+	if retention := config.Trash.Retention; retention < 0 {
+		return fmt.Errorf("invalid trash.retention %s: must be non-negative", retention)
+	}
+
+	if size := config.Notifications.QueueSize; size < 0 {
+		return fmt.Errorf("invalid notifications.queue_size %d: must be non-negative", size)
+	}
+
+	names := make(map[string]bool)
+	if config.Pubsub.Enable {
+		names["pubsub"] = true
+	}
+	for i, sink := range config.Notifications.Sinks {
+		switch sink.Type {
+		case "webhook":
+			if sink.URL == "" {
+				return fmt.Errorf("invalid notifications.sinks[%d].url %q: webhook sinks require a URL", i, sink.URL)
+			}
+		case "file":
+			if sink.Path == "" {
+				return fmt.Errorf("invalid notifications.sinks[%d].path %q: file sinks require a path", i, sink.Path)
+			}
+		default:
+			return fmt.Errorf("invalid notifications.sinks[%d].type %q: must be one of [webhook, file]", i, sink.Type)
+		}
+		if sink.MaxAttempts < 0 {
+			return fmt.Errorf("invalid notifications.sinks[%d].max_attempts %d: must be non-negative", i, sink.MaxAttempts)
+		}
+		name := sinkName(sink)
+		if names[name] {
+			return fmt.Errorf("invalid notifications.sinks[%d].name %q: sink names must be unique", i, name)
+		}
+		names[name] = true
+	}
+
+	switch blobs := config.Blobs; blobs.Type {
+	case "":
+	case "file":
+		if blobs.Path == "" {
+			return fmt.Errorf("invalid blobs.path %q: file blob stores require a path", blobs.Path)
+		}
+	case "s3":
+		if blobs.Endpoint == "" {
+			return fmt.Errorf("invalid blobs.endpoint %q: s3 blob stores require an endpoint", blobs.Endpoint)
+		}
+		if blobs.Bucket == "" {
+			return fmt.Errorf("invalid blobs.bucket %q: s3 blob stores require a bucket", blobs.Bucket)
+		}
+	default:
+		return fmt.Errorf("invalid blobs.type %q: must be one of [file, s3]", blobs.Type)
+	}
+
+	if config.Auth.ClientCertificates && config.TLS.ClientCAFile == "" {
+		return fmt.Errorf("invalid auth.client_certificates: client certificates require tls.client_ca_file")
+	}
+
+	return validateAuthConfig(config.Auth)
+}
+
+func validateAuthConfig(conf AuthConfig) error {
+	for i, k := range conf.APIKeys {
+		if k.Key == "" {
+			return fmt.Errorf("invalid auth.api_keys[%d].key %q: keys must not be empty", i, k.Key)
+		}
+		if k.Principal == "" {
+			return fmt.Errorf("invalid auth.api_keys[%d].principal %q: keys require a principal", i, k.Principal)
+		}
+	}
+
+	if jwt := conf.JWT; jwt.JWKS == "" && (jwt.Issuer != "" || jwt.Audience != "" || jwt.PrincipalClaim != "") {
+		return fmt.Errorf("invalid auth.jwt.jwks %q: JWT verification requires a JSON Web Key Set file", jwt.JWKS)
+	}
+
+	if len(conf.Roles) > 0 && len(conf.APIKeys) == 0 && !conf.ClientCertificates && conf.JWT.JWKS == "" {
+		return fmt.Errorf("invalid auth.roles: roles require api_keys, client_certificates or jwt")
+	}
+	for i, r := range conf.Roles {
+		if r.Principal == "" {
+			return fmt.Errorf("invalid auth.roles[%d].principal %q: must be a principal or \"*\"", i, r.Principal)
+		}
+		if r.Project == "" {
+			return fmt.Errorf("invalid auth.roles[%d].project %q: must be a project ID or \"*\"", i, r.Project)
+		}
+		if _, err := auth.ParseRole(r.Role); err != nil {
+			return fmt.Errorf("invalid auth.roles[%d].role %q: must be one of [viewer, editor, admin]", i, r.Role)
+		}
+	}
+
 	return nil
 }
 
+func sinkName(sink SinkConfig) string {
+	if sink.Name != "" {
+		return sink.Name
+	}
+	return sink.Type
+}
+
+// newNotifiers creates the notification sinks described by the configuration.
+func newNotifiers(sinks []SinkConfig) (map[string]registry.Notifier, error) {
+	notifiers := make(map[string]registry.Notifier, len(sinks))
+	for _, sink := range sinks {
+		var n registry.Notifier
+		switch sink.Type {
+		case "webhook":
+			n = registry.NewWebhookNotifier(registry.WebhookConfig{
+				URL:         sink.URL,
+				Secret:      sink.Secret,
+				MaxAttempts: sink.MaxAttempts,
+			})
+		case "file":
+			f, err := registry.NewFileNotifier(sink.Path)
+			if err != nil {
+				return nil, err
+			}
+			n = f
+		}
+		notifiers[sinkName(sink)] = n
+	}
+	return notifiers, nil
+}
+
+// newAuthenticators creates the authenticators described by the configuration.
+func newAuthenticators(conf AuthConfig) ([]auth.Authenticator, error) {
+	var authenticators []auth.Authenticator
+	if len(conf.APIKeys) > 0 {
+		keys := make(map[string]string, len(conf.APIKeys))
+		for _, k := range conf.APIKeys {
+			keys[k.Key] = k.Principal
+		}
+		authenticators = append(authenticators, auth.NewAPIKeys(keys))
+	}
+	if conf.JWT.JWKS != "" {
+		jwks, err := os.ReadFile(conf.JWT.JWKS)
+		if err != nil {
+			return nil, err
+		}
+		j, err := auth.NewJWT(jwks)
+		if err != nil {
+			return nil, err
+		}
+		j.Issuer = conf.JWT.Issuer
+		j.Audience = conf.JWT.Audience
+		j.PrincipalClaim = conf.JWT.PrincipalClaim
+		authenticators = append(authenticators, j)
+	}
+	if conf.ClientCertificates {
+		authenticators = append(authenticators, auth.ClientCertificates{})
+	}
+	return authenticators, nil
+}
+
+// newPolicy returns a policy that grants the configured roles, or nil if
+// no roles are configured.
+func newPolicy(roles []RoleConfig) *auth.Policy {
+	if len(roles) == 0 {
+		return nil
+	}
+	policy := &auth.Policy{}
+	for _, r := range roles {
+		role, _ := auth.ParseRole(r.Role)
+		policy.Bindings = append(policy.Bindings, auth.Binding{
+			Principal: r.Principal,
+			Project:   r.Project,
+			Role:      role,
+		})
+	}
+	return policy
+}
+
 func loggerOptions(conf LoggingConfig) []log.Option {
 	opts := make([]log.Option, 0, 2)
 	switch conf.Level {
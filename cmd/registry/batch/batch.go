@@ -0,0 +1,308 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package batch groups registry changes into batch requests so that they
+// are applied in a few transactions instead of one request per resource.
+package batch
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// maxItems is the number of items that are packed into a batch.
+	maxItems = 100
+	// maxBytes is the approximate size of the items packed into a batch.
+	maxBytes = 2 << 20
+	// serverMaxItems is the largest batch accepted by the server.
+	serverMaxItems = 1000
+)
+
+// queue packs items into batches and sends them when they are full.
+type queue[T proto.Message] struct {
+	send func(context.Context, []T) error
+
+	mu      sync.Mutex
+	pending []T
+	size    int
+}
+
+// add queues a group of items, first sending the pending items if the
+// group doesn't fit with them. Groups are never split unless they are
+// larger than the server allows.
+func (q *queue[T]) add(ctx context.Context, items ...T) error {
+	size := 0
+	for _, item := range items {
+		size += proto.Size(item)
+	}
+	q.mu.Lock()
+	var full []T
+	if len(q.pending) > 0 && (len(q.pending)+len(items) > maxItems || q.size+size > maxBytes) {
+		full, q.pending, q.size = q.pending, nil, 0
+	}
+	q.pending = append(q.pending, items...)
+	q.size += size
+	q.mu.Unlock()
+	if full == nil {
+		return nil
+	}
+	return q.sendAll(ctx, full)
+}
+
+// flush sends all pending items.
+func (q *queue[T]) flush(ctx context.Context) error {
+	q.mu.Lock()
+	pending := q.pending
+	q.pending, q.size = nil, 0
+	q.mu.Unlock()
+	return q.sendAll(ctx, pending)
+}
+
+func (q *queue[T]) sendAll(ctx context.Context, items []T) error {
+	for len(items) > 0 {
+		n := len(items)
+		if n > serverMaxItems {
+			n = serverMaxItems
+		}
+		if err := q.send(ctx, items[:n]); err != nil {
+			return err
+		}
+		items = items[n:]
+	}
+	return nil
+}
+
+// Mutations applies creates, updates and deletes with BatchMutate.
+// Mutations added together are applied in the same transaction.
+// Servers that don't support BatchMutate are sent one request per mutation.
+// It is safe for concurrent use.
+type Mutations struct {
+	client      connection.RegistryClient
+	parent      string
+	unsupported atomic.Bool
+	q           queue[*rpc.Mutation]
+}
+
+// NewMutations returns an empty batch of mutations of resources in a project.
+// The parent is the project with location, e.g. "projects/p/locations/global".
+func NewMutations(client connection.RegistryClient, parent string) *Mutations {
+	b := &Mutations{client: client, parent: parent}
+	b.q.send = b.send
+	return b
+}
+
+// NewRecorder returns a batch that passes its mutations to record instead
+// of applying them.
+func NewRecorder(record func(context.Context, []*rpc.Mutation) error) *Mutations {
+	b := &Mutations{}
+	b.q.send = record
+	return b
+}
+
+// Add queues a group of mutations, applying previously queued mutations
+// if the batch is full.
+func (b *Mutations) Add(ctx context.Context, mutations ...*rpc.Mutation) error {
+	return b.q.add(ctx, mutations...)
+}
+
+// Flush applies all queued mutations.
+func (b *Mutations) Flush(ctx context.Context) error {
+	return b.q.flush(ctx)
+}
+
+func (b *Mutations) send(ctx context.Context, mutations []*rpc.Mutation) error {
+	if !b.unsupported.Load() {
+		_, err := b.client.BatchMutate(ctx, &rpc.BatchMutateRequest{
+			Parent:    b.parent,
+			Mutations: mutations,
+		}, retryOptions(mutations)...)
+		if status.Code(err) != codes.Unimplemented {
+			return err
+		}
+		log.FromContext(ctx).Debug("BatchMutate is unsupported, applying mutations individually")
+		b.unsupported.Store(true)
+	}
+	for i, m := range mutations {
+		if err := Apply(ctx, b.client, m); err != nil {
+			return status.Errorf(status.Code(err), "mutations[%d]: %s", i, status.Convert(err).Message())
+		}
+	}
+	return nil
+}
+
+// Apply applies a single mutation with the corresponding request.
+func Apply(ctx context.Context, client connection.RegistryClient, m *rpc.Mutation) error {
+	opts := retryOptions([]*rpc.Mutation{m})
+	var err error
+	switch op := m.GetOperation().(type) {
+	case *rpc.Mutation_CreateApi:
+		_, err = client.CreateApi(ctx, op.CreateApi, opts...)
+	case *rpc.Mutation_UpdateApi:
+		_, err = client.UpdateApi(ctx, op.UpdateApi, opts...)
+	case *rpc.Mutation_DeleteApi:
+		err = client.DeleteApi(ctx, op.DeleteApi, opts...)
+	case *rpc.Mutation_CreateApiVersion:
+		_, err = client.CreateApiVersion(ctx, op.CreateApiVersion, opts...)
+	case *rpc.Mutation_UpdateApiVersion:
+		_, err = client.UpdateApiVersion(ctx, op.UpdateApiVersion, opts...)
+	case *rpc.Mutation_DeleteApiVersion:
+		err = client.DeleteApiVersion(ctx, op.DeleteApiVersion, opts...)
+	case *rpc.Mutation_CreateApiSpec:
+		_, err = client.CreateApiSpec(ctx, op.CreateApiSpec, opts...)
+	case *rpc.Mutation_UpdateApiSpec:
+		_, err = client.UpdateApiSpec(ctx, op.UpdateApiSpec, opts...)
+	case *rpc.Mutation_DeleteApiSpec:
+		err = client.DeleteApiSpec(ctx, op.DeleteApiSpec, opts...)
+	case *rpc.Mutation_CreateApiDeployment:
+		_, err = client.CreateApiDeployment(ctx, op.CreateApiDeployment, opts...)
+	case *rpc.Mutation_UpdateApiDeployment:
+		_, err = client.UpdateApiDeployment(ctx, op.UpdateApiDeployment, opts...)
+	case *rpc.Mutation_DeleteApiDeployment:
+		err = client.DeleteApiDeployment(ctx, op.DeleteApiDeployment, opts...)
+	case *rpc.Mutation_CreateArtifact:
+		_, err = client.CreateArtifact(ctx, op.CreateArtifact, opts...)
+	case *rpc.Mutation_ReplaceArtifact:
+		if m.GetAllowMissing() {
+			name, err := names.ParseArtifact(op.ReplaceArtifact.GetArtifact().GetName())
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			_, err = client.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: name.String()})
+			if status.Code(err) == codes.NotFound {
+				_, err = client.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+					Parent:     name.Parent(),
+					ArtifactId: name.ArtifactID(),
+					Artifact:   op.ReplaceArtifact.GetArtifact(),
+				}, opts...)
+				return err
+			} else if err != nil {
+				return err
+			}
+		}
+		_, err = client.ReplaceArtifact(ctx, op.ReplaceArtifact, opts...)
+	case *rpc.Mutation_DeleteArtifact:
+		err = client.DeleteArtifact(ctx, op.DeleteArtifact, opts...)
+	default:
+		err = status.Error(codes.InvalidArgument, "operation is required")
+	}
+	return err
+}
+
+// Specs creates specs with BatchCreateApiSpecs.
+// Servers that don't support BatchCreateApiSpecs are sent one request per spec.
+// It is safe for concurrent use.
+type Specs struct {
+	client      connection.RegistryClient
+	parent      string
+	unsupported atomic.Bool
+	q           queue[*rpc.CreateApiSpecRequest]
+}
+
+// NewSpecs returns an empty batch of specs to create in a project.
+// The parent is the project with location, e.g. "projects/p/locations/global".
+func NewSpecs(client connection.RegistryClient, parent string) *Specs {
+	b := &Specs{client: client, parent: parent + "/apis/-/versions/-"}
+	b.q.send = b.send
+	return b
+}
+
+// Add queues a spec, creating the previously queued specs if the batch is full.
+func (b *Specs) Add(ctx context.Context, req *rpc.CreateApiSpecRequest) error {
+	return b.q.add(ctx, req)
+}
+
+// Flush creates all queued specs.
+func (b *Specs) Flush(ctx context.Context) error {
+	return b.q.flush(ctx)
+}
+
+func (b *Specs) send(ctx context.Context, reqs []*rpc.CreateApiSpecRequest) error {
+	if !b.unsupported.Load() {
+		_, err := b.client.BatchCreateApiSpecs(ctx, &rpc.BatchCreateApiSpecsRequest{
+			Parent:   b.parent,
+			Requests: reqs,
+		})
+		switch status.Code(err) {
+		case codes.OK:
+			return nil
+		case codes.Unimplemented:
+			log.FromContext(ctx).Debug("BatchCreateApiSpecs is unsupported, creating specs individually")
+			b.unsupported.Store(true)
+		case codes.AlreadyExists:
+			// Create the specs that don't exist yet one at a time.
+		default:
+			return err
+		}
+	}
+	for _, req := range reqs {
+		spec, err := b.client.CreateApiSpec(ctx, req)
+		switch status.Code(err) {
+		case codes.OK:
+			log.Debugf(ctx, "Created API spec: %s", spec.GetName())
+		case codes.AlreadyExists:
+			// When the spec already exists we can silently continue.
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// retryOptions returns call options for requests that include etags.
+// Requests with stale etags fail with Aborted, which the client otherwise
+// retries, so only unavailable servers are retried.
+func retryOptions(mutations []*rpc.Mutation) []gax.CallOption {
+	for _, m := range mutations {
+		if hasEtag(m.ProtoReflect()) {
+			return []gax.CallOption{
+				gax.WithRetry(func() gax.Retryer {
+					return gax.OnCodes([]codes.Code{codes.Unavailable}, gax.Backoff{
+						Initial:    200 * time.Millisecond,
+						Max:        10000 * time.Millisecond,
+						Multiplier: 1.30,
+					})
+				}),
+			}
+		}
+	}
+	return nil
+}
+
+// hasEtag reports whether a message or any of its fields sets an etag.
+func hasEtag(m protoreflect.Message) bool {
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Name() == "etag" && v.String() != "":
+			found = true
+		case fd.Kind() == protoreflect.MessageKind && fd.Cardinality() != protoreflect.Repeated:
+			found = hasEtag(v.Message())
+		}
+		return !found
+	})
+	return found
+}
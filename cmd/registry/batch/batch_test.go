@@ -0,0 +1,198 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+const parent = "projects/batch-test/locations/global"
+
+func createApi(id string) *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_CreateApi{CreateApi: &rpc.CreateApiRequest{
+		Parent: parent,
+		ApiId:  id,
+		Api:    &rpc.Api{},
+	}}}
+}
+
+func TestMutations(t *testing.T) {
+	ctx := context.Background()
+	client, _ := grpctest.SetupRegistry(ctx, t, "batch-test", nil)
+
+	// More mutations than fit in one batch.
+	b := NewMutations(client, parent)
+	const n = maxItems + 10
+	for i := 0; i < n; i++ {
+		if err := b.Add(ctx, createApi(fmt.Sprintf("api-%d", i))); err != nil {
+			t.Fatalf("Add() returned error: %s", err)
+		}
+	}
+	if err := b.Flush(ctx); err != nil {
+		t.Fatalf("Flush() returned error: %s", err)
+	}
+	count := 0
+	it := client.ListApis(ctx, &rpc.ListApisRequest{Parent: parent})
+	for _, err := it.Next(); err != iterator.Done; _, err = it.Next() {
+		if err != nil {
+			t.Fatalf("ListApis() returned error: %s", err)
+		}
+		count++
+	}
+	if count != n {
+		t.Errorf("ListApis() returned %d APIs, want %d", count, n)
+	}
+
+	// A failing batch applies none of its mutations.
+	b = NewMutations(client, parent)
+	if err := b.Add(ctx, createApi("new"), createApi("api-0")); err != nil {
+		t.Fatalf("Add() returned error: %s", err)
+	}
+	if err := b.Flush(ctx); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("Flush() returned status %s, want %s", status.Code(err), codes.AlreadyExists)
+	}
+	if _, err := client.GetApi(ctx, &rpc.GetApiRequest{Name: parent + "/apis/new"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApi() returned status %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestSpecs(t *testing.T) {
+	ctx := context.Background()
+	client, _ := grpctest.SetupRegistry(ctx, t, "batch-test", []seeder.RegistryResource{
+		&rpc.ApiSpec{Name: parent + "/apis/a/versions/v1/specs/existing"},
+	})
+
+	b := NewSpecs(client, parent)
+	for _, id := range []string{"existing", "new"} {
+		if err := b.Add(ctx, &rpc.CreateApiSpecRequest{
+			Parent:    parent + "/apis/a/versions/v1",
+			ApiSpecId: id,
+			ApiSpec:   &rpc.ApiSpec{},
+		}); err != nil {
+			t.Fatalf("Add() returned error: %s", err)
+		}
+	}
+	// Existing specs are skipped instead of failing the batch.
+	if err := b.Flush(ctx); err != nil {
+		t.Fatalf("Flush() returned error: %s", err)
+	}
+	if _, err := client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: parent + "/apis/a/versions/v1/specs/new"}); err != nil {
+		t.Errorf("GetApiSpec() returned error: %s", err)
+	}
+}
+
+func TestApplyReplaceArtifactAllowMissing(t *testing.T) {
+	ctx := context.Background()
+	client, _ := grpctest.SetupRegistry(ctx, t, "batch-test", nil)
+
+	for _, contents := range []string{"created", "replaced"} {
+		m := &rpc.Mutation{
+			Operation: &rpc.Mutation_ReplaceArtifact{ReplaceArtifact: &rpc.ReplaceArtifactRequest{
+				Artifact: &rpc.Artifact{Name: parent + "/artifacts/x", MimeType: "text/plain", Contents: []byte(contents)},
+			}},
+			AllowMissing: true,
+		}
+		if err := Apply(ctx, client, m); err != nil {
+			t.Fatalf("Apply() returned error: %s", err)
+		}
+		body, err := client.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: parent + "/artifacts/x"})
+		if err != nil {
+			t.Fatalf("GetArtifactContents() returned error: %s", err)
+		}
+		if got := string(body.GetData()); got != contents {
+			t.Errorf("GetArtifactContents() returned %q, want %q", got, contents)
+		}
+	}
+}
+
+func TestHasEtag(t *testing.T) {
+	tests := []struct {
+		desc string
+		m    *rpc.Mutation
+		want bool
+	}{
+		{
+			desc: "create",
+			m:    createApi("a"),
+			want: false,
+		},
+		{
+			desc: "update with etag",
+			m: &rpc.Mutation{Operation: &rpc.Mutation_UpdateApi{UpdateApi: &rpc.UpdateApiRequest{
+				Api: &rpc.Api{Name: parent + "/apis/a", Etag: "123"},
+			}}},
+			want: true,
+		},
+		{
+			desc: "delete with etag",
+			m: &rpc.Mutation{Operation: &rpc.Mutation_DeleteApi{DeleteApi: &rpc.DeleteApiRequest{
+				Name: parent + "/apis/a",
+				Etag: "123",
+			}}},
+			want: true,
+		},
+		{
+			desc: "labels",
+			m: &rpc.Mutation{Operation: &rpc.Mutation_UpdateApi{UpdateApi: &rpc.UpdateApiRequest{
+				Api: &rpc.Api{Name: parent + "/apis/a", Labels: map[string]string{"etag": "123"}},
+			}}},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if got := hasEtag(test.m.ProtoReflect()); got != test.want {
+				t.Errorf("hasEtag() returned %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	var recorded []*rpc.Mutation
+	b := NewRecorder(func(ctx context.Context, mutations []*rpc.Mutation) error {
+		recorded = append(recorded, mutations...)
+		return nil
+	})
+	for i := 0; i < maxItems+1; i++ {
+		if err := b.Add(ctx, createApi(fmt.Sprintf("a%d", i))); err != nil {
+			t.Fatalf("Add() returned error: %s", err)
+		}
+	}
+	if err := b.Flush(ctx); err != nil {
+		t.Fatalf("Flush() returned error: %s", err)
+	}
+	if len(recorded) != maxItems+1 {
+		t.Errorf("recorded %d mutations, want %d", len(recorded), maxItems+1)
+	}
+}
@@ -15,11 +15,15 @@
 package apply
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/apigee/registry/cmd/registry/patch"
 	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/pkg/visitor"
 	"github.com/spf13/cobra"
 )
@@ -30,14 +34,37 @@ func Command() *cobra.Command {
 	var recursive bool
 	var jobs int
 	var yamlArchives bool
+	var prune bool
+	var selector string
+	var dryRun bool
+	var output string
 	cmd := &cobra.Command{
 		Use:   "apply (-f FILE | -f -)",
 		Short: "Apply YAML to the API Registry",
 		Long: `Apply YAML to the API Registry by files / folder names or stdin.
 
 Resources will be created if they don't exist yet. 
+Resources with an etag in their metadata, as written by "registry get -o yaml"
+and "registry export", are only updated if they haven't changed since then.
+Resources that no longer exist are created.
+The resources in a file are applied together, so if one of them can't be
+applied, none of them are.
 Multiple files may be specified by repeating the -f flag.
 
+A .tar or .zip archive written by "registry export --archive" is applied by
+the server in a single transaction, creating the project if necessary.
+
+With --prune, resources under the parent that match the label selector of
+--selector and are not described by any of the applied files are deleted,
+so that the registry matches the files exactly. Resources are not deleted
+if they have children that are kept.
+
+With --dry-run, nothing is changed. Instead, each resource in the files is
+compared with its current state in the registry and reported as one that
+would be created, updated or left unchanged, along with the resources that
+--prune would delete. Updates list the fields that would change, including
+a diff of spec and artifact contents. Use "-o json" for a structured plan.
+
 More info and example usage at https://github.com/apigee/registry/wiki/registry-apply.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -48,6 +75,15 @@ More info and example usage at https://github.com/apigee/registry/wiki/registry-
 					}
 				}
 			}
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output type %q, must be text or json", output)
+			}
+			if output != "text" && !dryRun {
+				return fmt.Errorf("--output requires --dry-run")
+			}
+			if prune && selector == "" {
+				return fmt.Errorf("--prune requires --selector")
+			}
 
 			ctx := cmd.Context()
 			c, err := connection.ActiveConfig()
@@ -71,13 +107,41 @@ More info and example usage at https://github.com/apigee/registry/wiki/registry-
 			if err != nil {
 				return err
 			}
+			if len(files) == 1 && isArchive(files[0]) {
+				if prune || dryRun {
+					return fmt.Errorf("--prune and --dry-run can't be used with archives")
+				}
+				return applyArchive(ctx, adminClient, project, files[0])
+			}
 			if err := visitor.VerifyLocation(ctx, client, project); err != nil {
 				return fmt.Errorf("parent project %q does not exist: %s", project, err)
 			}
 			if yamlArchives { // TODO: remove when default
 				ctx = patch.SetStoreArchivesAsYaml(ctx)
 			}
-			return patch.Apply(ctx, client, adminClient, cmd.InOrStdin(), project, recursive, jobs, files...)
+			var opts *patch.PruneOptions
+			if prune {
+				opts = &patch.PruneOptions{Selector: selector}
+			}
+			if dryRun {
+				plan, err := patch.PlanApply(ctx, client, adminClient, cmd.InOrStdin(), project, recursive, opts, files...)
+				if err != nil {
+					return err
+				}
+				if output == "json" {
+					return printPlanJSON(cmd.OutOrStdout(), plan)
+				}
+				printPlan(cmd.OutOrStdout(), plan)
+				return nil
+			}
+			if opts == nil {
+				return patch.Apply(ctx, client, adminClient, cmd.InOrStdin(), project, recursive, jobs, files...)
+			}
+			pruned, err := patch.ApplyWithPrune(ctx, client, adminClient, cmd.InOrStdin(), project, recursive, jobs, *opts, files...)
+			for _, name := range pruned {
+				fmt.Fprintf(cmd.OutOrStdout(), "pruned %s\n", name)
+			}
+			return err
 		},
 	}
 	cmd.Flags().StringSliceVarP(&files, "file", "f", nil, "file or directory containing the patch(es) to apply. Use '-' to read from standard input")
@@ -86,5 +150,31 @@ More info and example usage at https://github.com/apigee/registry/wiki/registry-
 	cmd.Flags().BoolVarP(&recursive, "recursive", "R", false, "process the directory used in -f, --file recursively")
 	cmd.Flags().BoolVarP(&yamlArchives, "yaml", "y", false, "store the archive data as yaml text instead of binary")
 	cmd.Flags().IntVarP(&jobs, "jobs", "j", 10, "number of actions to perform concurrently")
+	cmd.Flags().BoolVar(&prune, "prune", false, "delete resources that match --selector and are not in the applied files")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector of the resources to prune, such as 'owner=pipeline'")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report the changes that would be made without changing anything")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "output type of --dry-run (text|json)")
 	return cmd
 }
+
+func isArchive(filename string) bool {
+	return strings.HasSuffix(filename, ".tar") || strings.HasSuffix(filename, ".zip")
+}
+
+func applyArchive(ctx context.Context, client connection.AdminClient, project, filename string) error {
+	name, err := names.ParseProjectWithLocation(project)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	resp, err := patch.ImportArchive(ctx, client, name, f)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Infof("%d resource(s) applied from %s", resp.GetResourceCount(), filename)
+	return nil
+}
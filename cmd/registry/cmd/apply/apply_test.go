@@ -15,10 +15,15 @@
 package apply
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/apigee/registry/cmd/registry/patch"
 	"github.com/apigee/registry/pkg/application/apihub"
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/connection/grpctest"
@@ -28,6 +33,9 @@ import (
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"gopkg.in/yaml.v3"
@@ -114,6 +122,22 @@ func TestApplyErrors(t *testing.T) {
 			desc: "invalid parent specified",
 			args: []string{"-f", sampleDir + "/apis/registry.yaml", "--parent", "projects/invalid/locations/global"},
 		},
+		{
+			desc: "prune without selector",
+			args: []string{"-f", sampleDir + "/apis/registry.yaml", "--parent", parent, "--prune"},
+		},
+		{
+			desc: "output without dry run",
+			args: []string{"-f", sampleDir + "/apis/registry.yaml", "--parent", parent, "-o", "json"},
+		},
+		{
+			desc: "invalid output",
+			args: []string{"-f", sampleDir + "/apis/registry.yaml", "--parent", parent, "--dry-run", "-o", "yaml"},
+		},
+		{
+			desc: "invalid selector",
+			args: []string{"-f", sampleDir + "/apis/registry.yaml", "--parent", parent, "--prune", "-l", "a=b,!=c"},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -126,6 +150,231 @@ func TestApplyErrors(t *testing.T) {
 	}
 }
 
+func TestApplyPrune(t *testing.T) {
+	project := names.Project{ProjectID: "apply-test-prune"}
+	parent := project.String() + "/locations/global"
+
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, project.ProjectID, nil)
+
+	labels := map[string]string{"owner": "pipeline"}
+	for _, api := range []*rpc.Api{
+		{Name: parent + "/apis/a", Labels: labels},
+		{Name: parent + "/apis/b", Labels: labels},
+		{Name: parent + "/apis/c", Labels: map[string]string{"owner": "other"}},
+		{Name: parent + "/apis/d"},
+	} {
+		if _, err := registryClient.UpdateApi(ctx, &rpc.UpdateApiRequest{Api: api, AllowMissing: true}); err != nil {
+			t.Fatalf("Setup: UpdateApi(%s) failed: %s", api.Name, err)
+		}
+	}
+	for _, version := range []*rpc.ApiVersion{
+		{Name: parent + "/apis/a/versions/v1", Labels: labels},
+		{Name: parent + "/apis/a/versions/v2", Labels: labels},
+		{Name: parent + "/apis/b/versions/v1"},
+	} {
+		if _, err := registryClient.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{ApiVersion: version, AllowMissing: true}); err != nil {
+			t.Fatalf("Setup: UpdateApiVersion(%s) failed: %s", version.Name, err)
+		}
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.yaml"), []byte(`apiVersion: apigeeregistry/v1
+kind: API
+metadata:
+  name: a
+  labels:
+    owner: pipeline
+data:
+  versions:
+  - metadata:
+      name: v1
+      labels:
+        owner: pipeline
+`), 0644); err != nil {
+		t.Fatalf("Setup: failed to write file: %s", err)
+	}
+
+	apply := func(t *testing.T, args ...string) string {
+		t.Helper()
+		out := &bytes.Buffer{}
+		cmd := Command()
+		cmd.SetArgs(append([]string{"-f", dir, "--parent", parent, "--prune", "-l", "owner=pipeline"}, args...))
+		cmd.SetOut(out)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute() with args %+v returned error: %s", args, err)
+		}
+		return out.String()
+	}
+	exists := func(name string) bool {
+		_, err := registryClient.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: name})
+		if err == nil {
+			return true
+		}
+		_, err = registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: name})
+		return err == nil
+	}
+
+	// API b can't be pruned while it has a version that isn't pruned,
+	// so a dry run lists it along with version a/v2.
+	got := apply(t, "--dry-run")
+	for _, want := range []string{
+		"unchanged " + parent + "/apis/a\n",
+		"unchanged " + parent + "/apis/a/versions/v1\n",
+		"delete " + parent + "/apis/a/versions/v2\n",
+		"delete " + parent + "/apis/b\n",
+		"0 to create, 0 to update, 2 to delete, 2 unchanged\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("dry run printed %q, want %q", got, want)
+		}
+	}
+	if !exists(parent + "/apis/a/versions/v2") {
+		t.Errorf("dry run deleted %s", parent+"/apis/a/versions/v2")
+	}
+
+	// Remove the version that keeps API b from being pruned.
+	if err := registryClient.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: parent + "/apis/b/versions/v1"}); err != nil {
+		t.Fatalf("Setup: DeleteApiVersion failed: %s", err)
+	}
+	got = apply(t)
+	if !strings.Contains(got, "pruned "+parent+"/apis/b\n") || !strings.Contains(got, "pruned "+parent+"/apis/a/versions/v2\n") {
+		t.Errorf("apply printed %q, want both pruned resources", got)
+	}
+	for name, want := range map[string]bool{
+		parent + "/apis/a":             true,
+		parent + "/apis/a/versions/v1": true,
+		parent + "/apis/a/versions/v2": false,
+		parent + "/apis/b":             false,
+		parent + "/apis/c":             true,
+		parent + "/apis/d":             true,
+	} {
+		if got := exists(name); got != want {
+			t.Errorf("exists(%s) = %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestApplyDryRun(t *testing.T) {
+	project := names.Project{ProjectID: "apply-test-dry-run"}
+	parent := project.String() + "/locations/global"
+
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, project.ProjectID, nil)
+
+	if _, err := registryClient.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api: &rpc.Api{
+			Name:        parent + "/apis/a",
+			DisplayName: "A",
+			Labels:      map[string]string{"tier": "silver"},
+		},
+		AllowMissing: true,
+	}); err != nil {
+		t.Fatalf("Setup: UpdateApi failed: %s", err)
+	}
+	if _, err := registryClient.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+		ApiVersion:   &rpc.ApiVersion{Name: parent + "/apis/a/versions/v1"},
+		AllowMissing: true,
+	}); err != nil {
+		t.Fatalf("Setup: UpdateApiVersion failed: %s", err)
+	}
+	if _, err := registryClient.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec: &rpc.ApiSpec{
+			Name:     parent + "/apis/a/versions/v1/specs/s",
+			Filename: "openapi.yaml",
+			MimeType: "application/x.openapi;version=3",
+			Contents: []byte("openapi: 3.0.0\ninfo:\n  title: old\n"),
+		},
+		AllowMissing: true,
+	}); err != nil {
+		t.Fatalf("Setup: UpdateApiSpec failed: %s", err)
+	}
+
+	dir := t.TempDir()
+	for name, contents := range map[string]string{
+		"a.yaml": `apiVersion: apigeeregistry/v1
+kind: API
+metadata:
+  name: a
+  labels:
+    tier: gold
+data:
+  displayName: A
+  versions:
+  - metadata:
+      name: v1
+    data:
+      specs:
+      - metadata:
+          name: s
+        data:
+          filename: openapi.yaml
+          mimeType: application/x.openapi;version=3
+`,
+		"openapi.yaml": "openapi: 3.0.0\ninfo:\n  title: new\n",
+		"b.yaml": `apiVersion: apigeeregistry/v1
+kind: API
+metadata:
+  name: b
+`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatalf("Setup: failed to write file: %s", err)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	cmd := Command()
+	cmd.SetArgs([]string{"-f", dir, "--parent", parent, "--dry-run", "-o", "json"})
+	cmd.SetOut(out)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+	var got patch.Plan
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("failed to parse plan %q: %s", out.String(), err)
+	}
+	want := patch.Plan{Changes: []*patch.Change{
+		{
+			Name:   parent + "/apis/a",
+			Action: patch.ActionUpdate,
+			Fields: []*patch.FieldChange{{Field: "labels.tier", Old: "silver", New: "gold"}},
+		},
+		{
+			Name:   parent + "/apis/a/versions/v1",
+			Action: patch.ActionUnchanged,
+		},
+		{
+			Name:   parent + "/apis/a/versions/v1/specs/s",
+			Action: patch.ActionUpdate,
+			Fields: []*patch.FieldChange{{Field: "contents", Old: "34 bytes", New: "34 bytes"}},
+		},
+		{
+			Name:   parent + "/apis/b",
+			Action: patch.ActionCreate,
+		},
+	}}
+	opts := cmpopts.IgnoreFields(patch.FieldChange{}, "Diff")
+	if diff := cmp.Diff(want, got, opts); diff != "" {
+		t.Fatalf("plan returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if diff := got.Changes[2].Fields[0].Diff; !strings.Contains(diff, "-  title: old") || !strings.Contains(diff, "+  title: new") {
+		t.Errorf("contents diff is %q, want a diff of the titles", diff)
+	}
+
+	// A dry run doesn't change anything.
+	if _, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: parent + "/apis/b"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApi(b) returned %v, want NotFound", err)
+	}
+	a, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: parent + "/apis/a"})
+	if err != nil {
+		t.Fatalf("GetApi(a) failed: %s", err)
+	}
+	if a.Labels["tier"] != "silver" {
+		t.Errorf("dry run changed labels of %s to %v", a.Name, a.Labels)
+	}
+}
+
 func TestApply_Stdin(t *testing.T) {
 	project := names.Project{ProjectID: "apply-test-stdin"}
 	parent := project.String() + "/locations/global"
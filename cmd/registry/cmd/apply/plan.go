@@ -0,0 +1,54 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apigee/registry/cmd/registry/patch"
+)
+
+// printPlan writes a plan for people to read, with the fields changed by
+// each update indented below it.
+func printPlan(w io.Writer, plan *patch.Plan) {
+	for _, c := range plan.Changes {
+		fmt.Fprintf(w, "%s %s\n", c.Action, c.Name)
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "  %s: %q -> %q\n", f.Field, f.Old, f.New)
+			if f.Diff != "" {
+				for _, line := range strings.Split(strings.TrimSuffix(f.Diff, "\n"), "\n") {
+					fmt.Fprintf(w, "    %s\n", line)
+				}
+			}
+		}
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d to delete, %d unchanged\n",
+		plan.Count(patch.ActionCreate),
+		plan.Count(patch.ActionUpdate),
+		plan.Count(patch.ActionDelete),
+		plan.Count(patch.ActionUnchanged))
+}
+
+func printPlanJSON(w io.Writer, plan *patch.Plan) error {
+	b, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
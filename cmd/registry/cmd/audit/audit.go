@@ -0,0 +1,132 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func Command() *cobra.Command {
+	var project string
+	var filter string
+	var limit int
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List the audit log of changes to the API Registry",
+		Long: `List the audit log of changes to the API Registry.
+Each event records the method that changed a resource, the caller that made
+the change, the ID of the request, the fields that were changed and the time
+of the change. Events are listed in the order they were recorded.
+
+Events are listed for the project in the configuration unless --project is
+specified. Use "--project -" to list events for all projects.
+
+Examples:
+
+List the changes made by a user:
+
+	registry audit --filter 'actor == "alice@example.com"'
+
+List the changes made to an API and its children since the start of 2023:
+
+	registry audit --filter 'resource.startsWith("projects/demo/locations/global/apis/petstore") && event_time > timestamp("2023-01-01T00:00:00Z")'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			c, err := connection.ActiveConfig()
+			if err != nil {
+				return err
+			}
+			if project == "" {
+				if c.Project == "" {
+					return fmt.Errorf("unable to identify project: please use --project or set registry.project in configuration")
+				}
+				project = c.Project
+			}
+			if output != "table" && output != "json" {
+				return fmt.Errorf("unsupported output type %q", output)
+			}
+			client, err := connection.NewAdminClientWithSettings(ctx, c)
+			if err != nil {
+				return err
+			}
+
+			var filters []string
+			if project != "-" {
+				filters = append(filters, fmt.Sprintf("project_id == %q", project))
+			}
+			if filter != "" {
+				filters = append(filters, "("+filter+")")
+			}
+			it := client.ListAuditEvents(ctx, &rpc.ListAuditEventsRequest{
+				Filter: strings.Join(filters, " && "),
+			})
+			var events []*rpc.AuditEvent
+			for limit <= 0 || len(events) < limit {
+				e, err := it.Next()
+				if err == iterator.Done {
+					break
+				} else if err != nil {
+					return err
+				}
+				events = append(events, e)
+			}
+
+			if output == "json" {
+				for _, e := range events {
+					b, err := protojson.Marshal(e)
+					if err != nil {
+						return err
+					}
+					fmt.Fprintln(cmd.OutOrStdout(), string(b))
+				}
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			defer w.Flush()
+			fmt.Fprintln(w, "TIME\tMETHOD\tRESOURCE\tACTOR\tCHANGED")
+			for _, e := range events {
+				actor := e.GetActor()
+				if actor == "" {
+					actor = "-"
+				}
+				changed := strings.Join(e.GetChangedFields().GetPaths(), ",")
+				if changed == "" {
+					changed = "-"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					e.GetEventTime().AsTime().Format(time.RFC3339), e.GetMethod(), e.GetResource(), actor, changed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "project", "", "project to list events for, or - for all projects")
+	cmd.Flags().StringVar(&filter, "filter", "", "filter selected resources")
+	cmd.Flags().IntVar(&limit, "limit", 0, "maximum number of events to list, or 0 for all events")
+	cmd.Flags().StringVarP(&output, "output", "o", "table", "output type (table|json)")
+	return cmd
+}
@@ -0,0 +1,151 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+func TestAudit(t *testing.T) {
+	const (
+		projectID = "audit-test"
+		apiName   = "projects/" + projectID + "/locations/global/apis/petstore"
+	)
+
+	ctx := context.Background()
+	client, _ := grpctest.SetupRegistry(ctx, t, projectID, []seeder.RegistryResource{
+		&rpc.Api{Name: apiName},
+	})
+	requestID := fmt.Sprintf("audit-test-%d", time.Now().UnixNano())
+	if _, err := client.UpdateApi(metadata.AppendToOutgoingContext(ctx, "x-request-id", requestID), &rpc.UpdateApiRequest{
+		Api:        &rpc.Api{Name: apiName, DisplayName: "Petstore"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	filter := fmt.Sprintf("--filter=request_id == %q", requestID)
+
+	tests := []struct {
+		desc string
+		args []string
+		want []string
+	}{
+		{
+			desc: "table",
+			args: []string{"--project", projectID, filter},
+			want: []string{"METHOD RESOURCE ACTOR CHANGED", "UpdateApi " + apiName + " - display_name"},
+		},
+		{
+			desc: "all projects",
+			args: []string{"--project", "-", filter},
+			want: []string{"METHOD RESOURCE ACTOR CHANGED", "UpdateApi " + apiName + " - display_name"},
+		},
+		{
+			desc: "other project",
+			args: []string{"--project", "other", filter},
+			want: []string{"METHOD RESOURCE ACTOR CHANGED"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			cmd.SetArgs(test.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute() with args %v returned error: %s", test.args, err)
+			}
+			// Drop the time column, which is first, before comparing.
+			got := strings.Split(strings.TrimSpace(out.String()), "\n")
+			for i := range got {
+				got[i] = strings.Join(strings.Fields(got[i])[1:], " ")
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Execute() with args %v returned unexpected output (-want +got):\n%s", test.args, diff)
+			}
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		cmd := Command()
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"--project", projectID, filter, "-o", "json"})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute() returned error: %s", err)
+		}
+		got := &rpc.AuditEvent{}
+		if err := protojson.Unmarshal(bytes.TrimSpace(out.Bytes()), got); err != nil {
+			t.Fatalf("Execute() returned invalid JSON %q: %s", out, err)
+		}
+		if got.GetMethod() != "UpdateApi" || got.GetRequestId() != requestID {
+			t.Errorf("Execute() returned %v, want the UpdateApi event of request %q", got, requestID)
+		}
+	})
+}
+
+func TestAuditErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		args []string
+	}{
+		{
+			desc: "unsupported output",
+			args: []string{"--project", "audit-test", "-o", "yaml"},
+		},
+		{
+			desc: "invalid filter",
+			args: []string{"--project", "audit-test", "--filter", "this filter is not valid"},
+		},
+		{
+			desc: "unexpected argument",
+			args: []string{"--project", "audit-test", "extra"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs(test.args)
+			if err := cmd.Execute(); err == nil {
+				t.Errorf("Execute() with args %v succeeded and should have failed", test.args)
+			}
+		})
+	}
+}
@@ -29,10 +29,7 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-var (
-	globalRules   = lint.NewRuleRegistry()
-	globalConfigs = defaultConfigs()
-)
+var globalRules = lint.NewRuleRegistry()
 
 func init() {
 	if err := rules.Add(globalRules); err != nil {
@@ -45,17 +42,14 @@ func defaultConfigs() lint.Configs {
 	return lint.Configs{}
 }
 
-var (
-	filter     string
-	jobs       int
-	enable     []string
-	disable    []string
-	configFile string
-	listRules  bool
-	errorlevel string
-)
-
 func Command() *cobra.Command {
+	var filter string
+	var jobs int
+	var enable []string
+	var disable []string
+	var configFile string
+	var listRules bool
+	var errorlevel string
 	cmd := &cobra.Command{
 		Use:   "check [PATTERN]",
 		Short: "Check entities in the API Registry",
@@ -90,7 +84,7 @@ func Command() *cobra.Command {
 				return err
 			}
 
-			configs := globalConfigs
+			configs := defaultConfigs()
 			if configFile != "" {
 				c, err := lint.ReadConfigsFromFile(configFile)
 				if err != nil {
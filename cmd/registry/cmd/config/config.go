@@ -31,6 +31,9 @@ active configuration.
 
 The following are valid configuration properties:
 	- address
+	- ca-file
+	- client-cert-file
+	- client-key-file
 	- insecure
 	- location
 	- project
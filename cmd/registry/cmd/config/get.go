@@ -30,6 +30,9 @@ func getCommand() *cobra.Command {
 
 The following are valid configuration properties:
 	- address
+	- ca-file
+	- client-cert-file
+	- client-key-file
 	- insecure
 	- location
 	- project
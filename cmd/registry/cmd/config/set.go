@@ -29,6 +29,9 @@ func setCommand() *cobra.Command {
 
 The following are valid configuration properties:
 	- address
+	- ca-file
+	- client-cert-file
+	- client-key-file
 	- insecure
 	- location
 	- project
@@ -0,0 +1,83 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/apigee/registry/cmd/registry/controller"
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+)
+
+func Command() *cobra.Command {
+	d := &controller.Daemon{}
+	var metricsAddress string
+	cmd := &cobra.Command{
+		Use:   "controller MANIFEST_ARTIFACT",
+		Short: "Continuously resolve dependencies in a manifest as the API Registry changes",
+		Long: "Continuously resolve dependencies in a manifest as the API Registry changes. " +
+			"The manifest is reloaded when it changes and failed actions are retried with exponential backoff. " +
+			"Runs until interrupted.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			c, err := connection.ActiveConfig()
+			if err != nil {
+				return err
+			}
+			name, err := names.ParseArtifact(c.FQName(args[0]))
+			if err != nil {
+				return err
+			}
+			d.Client, err = connection.NewRegistryClientWithSettings(ctx, c)
+			if err != nil {
+				return err
+			}
+			d.AdminClient, err = connection.NewAdminClientWithSettings(ctx, c)
+			if err != nil {
+				return err
+			}
+			d.Manifest = name
+
+			if metricsAddress != "" {
+				mux := http.NewServeMux()
+				mux.Handle("/metrics", promhttp.Handler())
+				server := &http.Server{Addr: metricsAddress, Handler: mux}
+				go func() {
+					if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+						log.FromContext(ctx).WithError(err).Error("Failed to serve metrics.")
+					}
+				}()
+				defer server.Close()
+			}
+
+			log.Infof(ctx, "Watching %s.", name)
+			return d.Run(ctx)
+		},
+	}
+
+	cmd.Flags().IntVarP(&d.Jobs, "jobs", "j", 10, "number of actions to perform concurrently")
+	cmd.Flags().IntVarP(&d.MaxActions, "actions", "a", 100, "maximum number of actions to generate on each pass")
+	cmd.Flags().DurationVar(&d.ResyncInterval, "resync-interval", 10*time.Minute, "time between passes over all generated resources")
+	cmd.Flags().DurationVar(&d.PollInterval, "poll-interval", 30*time.Second, "time between checks for updated resources if the registry can't be watched")
+	cmd.Flags().DurationVar(&d.Debounce, "debounce", time.Second, "time to wait for related changes before a pass")
+	cmd.Flags().StringVar(&metricsAddress, "metrics-address", "", "if set, serve Prometheus metrics at /metrics on this address (e.g. \":9090\")")
+	return cmd
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/server/registry"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+func TestControllerErrors(t *testing.T) {
+	const projectID = "controller-command-test"
+	ctx := context.Background()
+	grpctest.SetupRegistry(ctx, t, projectID, nil)
+
+	tests := []struct {
+		desc string
+		args []string
+	}{
+		{
+			desc: "no manifest",
+			args: []string{},
+		},
+		{
+			desc: "invalid manifest name",
+			args: []string{"projects/" + projectID + "/locations/global/apis/a"},
+		},
+		{
+			desc: "missing manifest",
+			args: []string{"projects/" + projectID + "/locations/global/artifacts/manifest"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			cmd.SetArgs(test.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			if err := cmd.ExecuteContext(ctx); err == nil {
+				t.Errorf("Execute() with args %v succeeded, want error", test.args)
+			}
+		})
+	}
+}
@@ -17,11 +17,14 @@ package diff
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
 	"github.com/apigee/registry/cmd/registry/compress"
+	"github.com/apigee/registry/pkg/application/diff"
 	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/mime"
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/pkg/visitor"
 	"github.com/apigee/registry/rpc"
@@ -30,14 +33,35 @@ import (
 	"github.com/hexops/gotextdiff/span"
 	"github.com/spf13/cobra"
 	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func Command() *cobra.Command {
+	var semantic bool
+	var output string
+	var store bool
 	cmd := &cobra.Command{
 		Use:   "diff RESOURCE_1 RESOURCE_2",
 		Short: "Compare resources in the API Registry",
-		Args:  cobra.ExactArgs(2),
+		Long: `Compare two revisions of an API spec in the API Registry.
+
+By default, the contents of the specs are compared as text.
+
+With --semantic, two OpenAPI (v2 or v3) specs are compared by their paths,
+operations, parameters, request and response schemas and security
+requirements. RESOURCE_1 is treated as the older revision, and each
+difference is reported as breaking if it can break clients of that
+revision. With --store, the differences are also saved as a Differences
+artifact on the revision of RESOURCE_2.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output type %q, must be text or json", output)
+			}
+			if !semantic && (output != "text" || store) {
+				return fmt.Errorf("--output and --store require --semantic")
+			}
 			ctx := cmd.Context()
 			c, err := connection.ActiveConfig()
 			if err != nil {
@@ -101,15 +125,99 @@ func Command() *cobra.Command {
 			} else {
 				return err
 			}
-			if spec1 != nil && spec2 != nil {
-				err = printDiff(spec1, spec2)
+			if spec1 == nil || spec2 == nil {
+				return err
 			}
-			return err
+			if !semantic {
+				return printDiff(spec1, spec2)
+			}
+			differences, err := semanticDiff(spec1, spec2)
+			if err != nil {
+				return err
+			}
+			if store {
+				if err := storeDifferences(ctx, client, spec2, differences); err != nil {
+					return err
+				}
+			}
+			if output == "json" {
+				fmt.Fprintln(cmd.OutOrStdout(), protojson.Format(differences))
+			} else {
+				printDifferences(cmd.OutOrStdout(), differences)
+			}
+			return nil
 		},
 	}
+	cmd.Flags().BoolVar(&semantic, "semantic", false, "compare OpenAPI specs by their API surfaces and classify changes as breaking or not")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "output type of --semantic (text|json)")
+	cmd.Flags().BoolVar(&store, "store", false, "with --semantic, store the differences as an artifact of the revision of RESOURCE_2")
 	return cmd
 }
 
+// revisionName returns the name of the revision of a spec.
+func revisionName(spec *rpc.ApiSpec) string {
+	if strings.Contains(spec.GetName(), "@") {
+		return spec.GetName()
+	}
+	return spec.GetName() + "@" + spec.GetRevisionId()
+}
+
+func semanticDiff(spec1, spec2 *rpc.ApiSpec) (*diff.Differences, error) {
+	docs := make([]*openapiDocument, 2)
+	for i, spec := range []*rpc.ApiSpec{spec1, spec2} {
+		if !mime.IsOpenAPIv2(spec.MimeType) && !mime.IsOpenAPIv3(spec.MimeType) {
+			return nil, fmt.Errorf("semantic comparison requires OpenAPI specs, %s is %q", spec.GetName(), spec.MimeType)
+		}
+		var err error
+		if docs[i], err = parseOpenAPI(spec.Contents); err != nil {
+			return nil, fmt.Errorf("%s: %s", spec.GetName(), err)
+		}
+	}
+	differences := &diff.Differences{
+		Id:          differencesID,
+		Kind:        "Differences",
+		Base:        revisionName(spec1),
+		Revision:    revisionName(spec2),
+		Differences: compareOpenAPI(docs[0], docs[1]),
+	}
+	for _, d := range differences.Differences {
+		differences.Breaking = differences.Breaking || d.Breaking
+	}
+	return differences, nil
+}
+
+// differencesID is the ID of stored Differences artifacts.
+const differencesID = "differences"
+
+func storeDifferences(ctx context.Context, client connection.RegistryClient, spec *rpc.ApiSpec, differences *diff.Differences) error {
+	name, err := names.ParseSpecRevision(revisionName(spec))
+	if err != nil {
+		return err
+	}
+	contents, err := proto.Marshal(differences)
+	if err != nil {
+		return err
+	}
+	return visitor.SetArtifact(ctx, client, &rpc.Artifact{
+		Name:     name.Artifact(differencesID).String(),
+		MimeType: mime.MimeTypeForMessageType("google.cloud.apigeeregistry.v1.diff.Differences"),
+		Contents: contents,
+	})
+}
+
+func printDifferences(w io.Writer, differences *diff.Differences) {
+	breaking := 0
+	for _, d := range differences.Differences {
+		label := "non-breaking"
+		if d.Breaking {
+			label = "breaking"
+			breaking++
+		}
+		fmt.Fprintf(w, "%-12s %s: %s\n", label, d.Location, d.Description)
+	}
+	fmt.Fprintf(w, "%d difference(s), %d breaking\n", len(differences.Differences), breaking)
+}
+
 func resolveSpecRevision(ctx context.Context,
 	client connection.RegistryClient,
 	base string,
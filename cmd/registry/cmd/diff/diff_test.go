@@ -0,0 +1,117 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/application/diff"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+func TestSemanticDiff(t *testing.T) {
+	ctx := context.Background()
+	specName := "projects/diff-test/locations/global/apis/a/versions/v/specs/s"
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "diff-test", []seeder.RegistryResource{
+		&rpc.ApiVersion{Name: "projects/diff-test/locations/global/apis/a/versions/v"},
+	})
+	var revisions []string
+	for _, contents := range []string{petstoreV3, strings.Replace(petstoreV3, "    delete:", "    patch:", 1)} {
+		spec, err := registryClient.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+			ApiSpec: &rpc.ApiSpec{
+				Name:     specName,
+				MimeType: "application/x.openapi;version=3",
+				Contents: []byte(contents),
+			},
+			AllowMissing: true,
+		})
+		if err != nil {
+			t.Fatalf("Setup: UpdateApiSpec failed: %s", err)
+		}
+		revisions = append(revisions, specName+"@"+spec.RevisionId)
+	}
+
+	out := &bytes.Buffer{}
+	cmd := Command()
+	cmd.SetArgs([]string{revisions[0], revisions[1], "--semantic", "--store"})
+	cmd.SetOut(out)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() returned error: %s", err)
+	}
+	want := `breaking     DELETE /pets/{petId}: removed operation DELETE /pets/{petId}
+non-breaking PATCH /pets/{petId}: added operation PATCH /pets/{petId}
+2 difference(s), 1 breaking
+`
+	if got := out.String(); got != want {
+		t.Errorf("diff printed %q, want %q", got, want)
+	}
+
+	body, err := registryClient.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: revisions[1] + "/artifacts/differences"})
+	if err != nil {
+		t.Fatalf("GetArtifactContents failed: %s", err)
+	}
+	differences := &diff.Differences{}
+	if err := proto.Unmarshal(body.GetData(), differences); err != nil {
+		t.Fatalf("failed to unmarshal differences: %s", err)
+	}
+	if differences.Base != revisions[0] || differences.Revision != revisions[1] || !differences.Breaking || len(differences.Differences) != 2 {
+		t.Errorf("stored unexpected differences %v", differences)
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		args []string
+	}{
+		{
+			desc: "output without semantic",
+			args: []string{"a", "b", "-o", "json"},
+		},
+		{
+			desc: "store without semantic",
+			args: []string{"a", "b", "--store"},
+		},
+		{
+			desc: "invalid output",
+			args: []string{"a", "b", "--semantic", "-o", "yaml"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			cmd.SetArgs(test.args)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			if err := cmd.Execute(); err == nil {
+				t.Errorf("Execute() with args %v succeeded, expected error", test.args)
+			}
+		})
+	}
+}
@@ -0,0 +1,575 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/apigee/registry/pkg/application/diff"
+	"gopkg.in/yaml.v3"
+)
+
+// openapiDocument holds the parts of an OpenAPI v2 or v3 description that
+// affect compatibility, in a form that is the same for both versions.
+type openapiDocument struct {
+	// paths are keyed by their templates with parameter names removed,
+	// so that renaming a path parameter doesn't change the path.
+	paths map[string]*openapiPath
+}
+
+type openapiPath struct {
+	template   string
+	operations map[string]*openapiOperation // keyed by upper case method
+}
+
+type openapiOperation struct {
+	parameters map[string]*openapiParameter // keyed by "name (in)"
+	request    *openapiBody                 // nil if the operation has no body
+	responses  map[string]*openapiBody      // keyed by status code
+	security   map[string]bool              // alternative requirements
+}
+
+type openapiParameter struct {
+	required bool
+	schema   *openapiSchema
+}
+
+type openapiBody struct {
+	required bool
+	content  map[string]*openapiSchema // keyed by media type
+}
+
+type openapiSchema struct {
+	typ        string
+	format     string
+	ref        string // set for unresolved references
+	enum       []string
+	properties map[string]*openapiSchema
+	required   map[string]bool
+	items      *openapiSchema
+}
+
+// anyMediaType is the media type of OpenAPI v2 bodies, which have a single
+// schema for all of the types that an operation consumes or produces.
+const anyMediaType = "*/*"
+
+// noSecurity is the security requirement of operations that don't need any.
+const noSecurity = "none"
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// parseOpenAPI reads an OpenAPI v2 or v3 description in YAML or JSON.
+func parseOpenAPI(contents []byte) (*openapiDocument, error) {
+	var root interface{}
+	if err := yaml.Unmarshal(contents, &root); err != nil {
+		return nil, err
+	}
+	p := &openapiParser{root: asMap(root), schemas: make(map[string]*openapiSchema)}
+	if p.root == nil {
+		return nil, fmt.Errorf("invalid OpenAPI description")
+	}
+	_, p.v2 = p.root["swagger"]
+	if _, v3 := p.root["openapi"]; !p.v2 && !v3 {
+		return nil, fmt.Errorf("invalid OpenAPI description: missing version")
+	}
+	return p.document(), nil
+}
+
+type openapiParser struct {
+	root    map[string]interface{}
+	v2      bool
+	schemas map[string]*openapiSchema // resolved schemas, keyed by reference
+}
+
+func (p *openapiParser) document() *openapiDocument {
+	doc := &openapiDocument{paths: make(map[string]*openapiPath)}
+	security := p.security(p.root["security"], map[string]bool{noSecurity: true})
+	paths := asMap(p.root["paths"])
+	for template, v := range paths {
+		item := asMap(p.resolve(v))
+		path := &openapiPath{template: template, operations: make(map[string]*openapiOperation)}
+		for _, method := range methods {
+			op := asMap(item[method])
+			if op == nil {
+				continue
+			}
+			path.operations[strings.ToUpper(method)] = p.operation(op, asList(item["parameters"]), security)
+		}
+		doc.paths[pathKey(template)] = path
+	}
+	return doc
+}
+
+var pathParameter = regexp.MustCompile(`{[^}]*}`)
+
+func pathKey(template string) string {
+	return pathParameter.ReplaceAllString(template, "{}")
+}
+
+func (p *openapiParser) operation(op map[string]interface{}, pathParameters []interface{}, security map[string]bool) *openapiOperation {
+	o := &openapiOperation{
+		parameters: make(map[string]*openapiParameter),
+		responses:  make(map[string]*openapiBody),
+		security:   security,
+	}
+	if s, ok := op["security"]; ok {
+		o.security = p.security(s, nil)
+	}
+	// Operation parameters override path parameters with the same name.
+	for _, v := range append(pathParameters, asList(op["parameters"])...) {
+		param := asMap(p.resolve(v))
+		name, _ := param["name"].(string)
+		in, _ := param["in"].(string)
+		required, _ := param["required"].(bool)
+		if p.v2 && in == "body" {
+			o.request = &openapiBody{
+				required: required,
+				content:  map[string]*openapiSchema{anyMediaType: p.schema(param["schema"])},
+			}
+			continue
+		}
+		schema := param["schema"]
+		if p.v2 {
+			schema = param // OpenAPI v2 parameters describe their own types.
+		}
+		o.parameters[fmt.Sprintf("%s (%s)", name, in)] = &openapiParameter{
+			required: required || in == "path",
+			schema:   p.schema(schema),
+		}
+	}
+	if body := asMap(p.resolve(op["requestBody"])); body != nil {
+		required, _ := body["required"].(bool)
+		o.request = &openapiBody{required: required, content: p.content(body)}
+	}
+	for code, v := range asMap(op["responses"]) {
+		response := asMap(p.resolve(v))
+		if p.v2 {
+			content := make(map[string]*openapiSchema)
+			if s, ok := response["schema"]; ok {
+				content[anyMediaType] = p.schema(s)
+			}
+			o.responses[code] = &openapiBody{content: content}
+		} else {
+			o.responses[code] = &openapiBody{content: p.content(response)}
+		}
+	}
+	return o
+}
+
+func (p *openapiParser) content(body map[string]interface{}) map[string]*openapiSchema {
+	content := make(map[string]*openapiSchema)
+	for mediaType, v := range asMap(body["content"]) {
+		content[mediaType] = p.schema(asMap(v)["schema"])
+	}
+	return content
+}
+
+// security returns the alternative security requirements of a list, each
+// written as its sorted schemes and scopes.
+func (p *openapiParser) security(v interface{}, defaults map[string]bool) map[string]bool {
+	list, ok := v.([]interface{})
+	if !ok {
+		return defaults
+	}
+	requirements := make(map[string]bool)
+	for _, r := range list {
+		var schemes []string
+		for scheme, scopes := range asMap(r) {
+			var s []string
+			for _, scope := range asList(scopes) {
+				s = append(s, fmt.Sprint(scope))
+			}
+			sort.Strings(s)
+			schemes = append(schemes, fmt.Sprintf("%s[%s]", scheme, strings.Join(s, ",")))
+		}
+		sort.Strings(schemes)
+		if len(schemes) == 0 {
+			requirements[noSecurity] = true
+		} else {
+			requirements[strings.Join(schemes, " & ")] = true
+		}
+	}
+	if len(requirements) == 0 {
+		requirements[noSecurity] = true
+	}
+	return requirements
+}
+
+// schema reads a schema. Each reference is resolved once and its schema is
+// shared by everything that refers to it, so recursive schemas are cycles.
+func (p *openapiParser) schema(v interface{}) *openapiSchema {
+	m := asMap(v)
+	if m == nil {
+		return nil
+	}
+	if ref, ok := m["$ref"].(string); ok {
+		if s, ok := p.schemas[ref]; ok {
+			return s
+		}
+		target := asMap(p.resolve(m))
+		if target == nil {
+			return &openapiSchema{ref: ref}
+		}
+		// The schema is cached before it is read, so that references to it
+		// from its own properties share it.
+		s := &openapiSchema{}
+		p.schemas[ref] = s
+		*s = *p.schema(target)
+		return s
+	}
+	s := &openapiSchema{
+		properties: make(map[string]*openapiSchema),
+		required:   make(map[string]bool),
+	}
+	s.typ, _ = m["type"].(string)
+	s.format, _ = m["format"].(string)
+	for _, e := range asList(m["enum"]) {
+		s.enum = append(s.enum, fmt.Sprint(e))
+	}
+	for name, property := range asMap(m["properties"]) {
+		s.properties[name] = p.schema(property)
+	}
+	for _, name := range asList(m["required"]) {
+		s.required[fmt.Sprint(name)] = true
+	}
+	if items, ok := m["items"]; ok {
+		s.items = p.schema(items)
+	}
+	// The properties of all of the schemas of an allOf apply.
+	for _, v := range asList(m["allOf"]) {
+		sub := p.schema(v)
+		if sub == nil {
+			continue
+		}
+		if s.typ == "" {
+			s.typ = sub.typ
+		}
+		for name, property := range sub.properties {
+			s.properties[name] = property
+		}
+		for name := range sub.required {
+			s.required[name] = true
+		}
+	}
+	return s
+}
+
+// resolve follows a local reference, returning v if it isn't a reference.
+func (p *openapiParser) resolve(v interface{}) interface{} {
+	ref, ok := asMap(v)["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#/") {
+		return v
+	}
+	var node interface{} = p.root
+	for _, segment := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		node = asMap(node)[segment]
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m
+	case map[interface{}]interface{}:
+		// YAML mappings with keys that aren't strings, such as status codes.
+		result := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			result[fmt.Sprint(k)] = v
+		}
+		return result
+	}
+	return nil
+}
+
+func asList(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	return l
+}
+
+// compareOpenAPI returns the differences between two OpenAPI descriptions.
+func compareOpenAPI(base, revision *openapiDocument) []*diff.Difference {
+	c := &openapiComparison{
+		comparing: make(map[[2]*openapiSchema]bool),
+		equal:     make(map[[2]*openapiSchema]bool),
+	}
+	for _, key := range sortedKeys(base.paths, revision.paths) {
+		before, after := base.paths[key], revision.paths[key]
+		switch {
+		case after == nil:
+			c.add(diff.Difference_REMOVED, "path", before.template, true, "removed path %s", before.template)
+		case before == nil:
+			c.add(diff.Difference_ADDED, "path", after.template, false, "added path %s", after.template)
+		default:
+			c.comparePath(before, after)
+		}
+	}
+	return c.differences
+}
+
+type openapiComparison struct {
+	differences []*diff.Difference
+	comparing   map[[2]*openapiSchema]bool // schemas being compared, to stop at cycles
+	equal       map[[2]*openapiSchema]bool // schemas compared without differences
+	cycles      int                        // comparisons stopped at cycles
+}
+
+func (c *openapiComparison) add(change diff.Difference_Change, element, location string, breaking bool, format string, args ...interface{}) {
+	c.differences = append(c.differences, &diff.Difference{
+		Change:      change,
+		Element:     element,
+		Location:    location,
+		Description: fmt.Sprintf(format, args...),
+		Breaking:    breaking,
+	})
+}
+
+func (c *openapiComparison) comparePath(base, revision *openapiPath) {
+	for _, method := range methods {
+		method = strings.ToUpper(method)
+		before, after := base.operations[method], revision.operations[method]
+		location := method + " " + revision.template
+		switch {
+		case before == nil && after == nil:
+		case after == nil:
+			c.add(diff.Difference_REMOVED, "operation", location, true, "removed operation %s", location)
+		case before == nil:
+			c.add(diff.Difference_ADDED, "operation", location, false, "added operation %s", location)
+		default:
+			c.compareOperation(location, before, after)
+		}
+	}
+}
+
+func (c *openapiComparison) compareOperation(location string, base, revision *openapiOperation) {
+	for _, name := range sortedKeys(base.parameters, revision.parameters) {
+		before, after := base.parameters[name], revision.parameters[name]
+		l := location + " parameter " + name
+		switch {
+		case after == nil:
+			c.add(diff.Difference_REMOVED, "parameter", l, true, "removed parameter %s", name)
+		case before == nil && after.required:
+			c.add(diff.Difference_ADDED, "parameter", l, true, "added required parameter %s", name)
+		case before == nil:
+			c.add(diff.Difference_ADDED, "parameter", l, false, "added optional parameter %s", name)
+		default:
+			if !before.required && after.required {
+				c.add(diff.Difference_MODIFIED, "parameter", l, true, "parameter %s is now required", name)
+			} else if before.required && !after.required {
+				c.add(diff.Difference_MODIFIED, "parameter", l, false, "parameter %s is now optional", name)
+			}
+			c.compareSchema(l, before.schema, after.schema, true)
+		}
+	}
+
+	l := location + " request"
+	switch before, after := base.request, revision.request; {
+	case before == nil && after == nil:
+	case after == nil:
+		c.add(diff.Difference_REMOVED, "request", l, true, "removed request body")
+	case before == nil:
+		c.add(diff.Difference_ADDED, "request", l, after.required, "added %s request body", requiredOrOptional(after.required))
+	default:
+		if !before.required && after.required {
+			c.add(diff.Difference_MODIFIED, "request", l, true, "request body is now required")
+		} else if before.required && !after.required {
+			c.add(diff.Difference_MODIFIED, "request", l, false, "request body is now optional")
+		}
+		c.compareContent(l, "request", before.content, after.content, true)
+	}
+
+	for _, code := range sortedKeys(base.responses, revision.responses) {
+		before, after := base.responses[code], revision.responses[code]
+		l := location + " response " + code
+		switch {
+		case after == nil:
+			c.add(diff.Difference_REMOVED, "response", l, true, "removed response %s", code)
+		case before == nil:
+			c.add(diff.Difference_ADDED, "response", l, false, "added response %s", code)
+		default:
+			c.compareContent(l, "response", before.content, after.content, false)
+		}
+	}
+
+	for _, r := range sortedKeys(base.security, revision.security) {
+		switch {
+		case !revision.security[r]:
+			c.add(diff.Difference_REMOVED, "security", location, true, "removed security requirement %s", r)
+		case !base.security[r]:
+			c.add(diff.Difference_ADDED, "security", location, false, "added security requirement %s", r)
+		}
+	}
+}
+
+func requiredOrOptional(required bool) string {
+	if required {
+		return "required"
+	}
+	return "optional"
+}
+
+// compareContent compares the schemas of a request or response body.
+// Clients can only use media types that are in both.
+func (c *openapiComparison) compareContent(location, element string, base, revision map[string]*openapiSchema, request bool) {
+	for _, mediaType := range sortedKeys(base, revision) {
+		before, beforeOK := base[mediaType]
+		after, afterOK := revision[mediaType]
+		switch {
+		case !afterOK:
+			c.add(diff.Difference_REMOVED, element, location, true, "removed media type %s", mediaType)
+		case !beforeOK:
+			c.add(diff.Difference_ADDED, element, location, false, "added media type %s", mediaType)
+		default:
+			l := location
+			if mediaType != anyMediaType {
+				l += " " + mediaType
+			}
+			c.compareSchema(l, before, after, request)
+		}
+	}
+}
+
+// compareSchema compares two schemas of values that are sent by clients if
+// request is true, or received by clients otherwise. Clients break if they
+// must send more than before or if they receive less than before.
+func (c *openapiComparison) compareSchema(location string, base, revision *openapiSchema, request bool) {
+	if base == nil || revision == nil {
+		if base != revision {
+			c.add(diff.Difference_MODIFIED, "schema", location, true, "changed schema")
+		}
+		return
+	}
+	if base.ref != "" || revision.ref != "" {
+		if base.ref != revision.ref {
+			c.add(diff.Difference_MODIFIED, "schema", location, true, "changed schema from %s to %s", describeRef(base), describeRef(revision))
+		}
+		return
+	}
+	// Shared schemas are compared once unless they differ, and recursive
+	// schemas are compared until they repeat. Schemas that were only
+	// compared up to a cycle aren't known to be equal.
+	pair := [2]*openapiSchema{base, revision}
+	if c.comparing[pair] {
+		c.cycles++
+		return
+	}
+	if c.equal[pair] {
+		return
+	}
+	c.comparing[pair] = true
+	differences, cycles := len(c.differences), c.cycles
+	c.compareSchemaFields(location, base, revision, request)
+	delete(c.comparing, pair)
+	if len(c.differences) == differences && c.cycles == cycles {
+		c.equal[pair] = true
+	}
+}
+
+// compareSchemaFields compares the fields of two resolved schemas.
+func (c *openapiComparison) compareSchemaFields(location string, base, revision *openapiSchema, request bool) {
+	if base.typ != revision.typ {
+		c.add(diff.Difference_MODIFIED, "schema", location, true, "changed type from %q to %q", base.typ, revision.typ)
+		return
+	}
+	if base.format != revision.format {
+		c.add(diff.Difference_MODIFIED, "schema", location, true, "changed format from %q to %q", base.format, revision.format)
+	}
+	c.compareEnum(location, base.enum, revision.enum, request)
+
+	for _, name := range sortedKeys(base.properties, revision.properties) {
+		before, after := base.properties[name], revision.properties[name]
+		l := location + " property " + name
+		switch {
+		case after == nil && before == nil:
+		case after == nil:
+			c.add(diff.Difference_REMOVED, "property", l, true, "removed property %s", name)
+		case before == nil:
+			required := revision.required[name]
+			c.add(diff.Difference_ADDED, "property", l, request && required, "added %s property %s", requiredOrOptional(required), name)
+		default:
+			if !base.required[name] && revision.required[name] {
+				c.add(diff.Difference_MODIFIED, "property", l, request, "property %s is now required", name)
+			} else if base.required[name] && !revision.required[name] {
+				c.add(diff.Difference_MODIFIED, "property", l, !request, "property %s is now optional", name)
+			}
+			c.compareSchema(l, before, after, request)
+		}
+	}
+	if base.items != nil || revision.items != nil {
+		c.compareSchema(location+" items", base.items, revision.items, request)
+	}
+}
+
+// compareEnum compares allowed values. Clients break if they can no longer
+// send a value, or if they can receive a value that they don't expect.
+func (c *openapiComparison) compareEnum(location string, base, revision []string, request bool) {
+	if len(base) == 0 && len(revision) > 0 {
+		c.add(diff.Difference_MODIFIED, "schema", location, request, "restricted values to %s", strings.Join(revision, ", "))
+		return
+	}
+	if len(base) > 0 && len(revision) == 0 {
+		c.add(diff.Difference_MODIFIED, "schema", location, !request, "removed restriction of values")
+		return
+	}
+	before, after := stringSet(base), stringSet(revision)
+	for _, v := range sortedKeys(before, after) {
+		switch {
+		case !after[v]:
+			c.add(diff.Difference_REMOVED, "value", location, request, "removed value %s", v)
+		case !before[v]:
+			c.add(diff.Difference_ADDED, "value", location, !request, "added value %s", v)
+		}
+	}
+}
+
+func describeRef(s *openapiSchema) string {
+	if s.ref != "" {
+		return s.ref
+	}
+	if s.typ != "" {
+		return s.typ
+	}
+	return "inline schema"
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// sortedKeys returns the keys of two maps, sorted.
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,378 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/application/diff"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+const petstoreV3 = `
+openapi: 3.0.0
+info:
+  title: Petstore
+  version: 1.0.0
+security:
+  - apiKey: []
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: "#/components/schemas/Pet"
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Pet"
+      responses:
+        "201":
+          description: created
+  /pets/{petId}:
+    get:
+      parameters:
+        - name: petId
+          in: path
+          schema:
+            type: string
+      responses:
+        "200":
+          description: a pet
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Pet"
+    delete:
+      responses:
+        "204":
+          description: deleted
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+        name:
+          type: string
+        tag:
+          type: string
+        status:
+          type: string
+          enum: [available, sold]
+        parent:
+          $ref: "#/components/schemas/Pet"
+`
+
+func TestCompareOpenAPI(t *testing.T) {
+	tests := []struct {
+		desc     string
+		base     string
+		revision string
+		want     []*diff.Difference
+	}{
+		{
+			desc:     "unchanged",
+			base:     petstoreV3,
+			revision: petstoreV3,
+		},
+		{
+			desc: "reformatted and renamed path parameter",
+			base: petstoreV3,
+			revision: `{"openapi": "3.0.0", "info": {"title": "Petstore", "version": "1.0.0"},
+"security": [{"apiKey": []}],
+"paths": {
+  "/pets": {
+    "get": {"parameters": [{"name": "limit", "in": "query", "schema": {"type": "integer"}}],
+      "responses": {"200": {"description": "pets", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Pet"}}}}}}},
+    "post": {"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}},
+      "responses": {"201": {"description": "created"}}}},
+  "/pets/{id}": {
+    "get": {"parameters": [{"name": "petId", "in": "path", "schema": {"type": "string"}}],
+      "responses": {"200": {"description": "a pet", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}}},
+    "delete": {"responses": {"204": {"description": "deleted"}}}}},
+"components": {"schemas": {"Pet": {"type": "object", "required": ["name", "id"], "properties": {
+  "id": {"type": "integer"}, "name": {"type": "string"}, "tag": {"type": "string"},
+  "status": {"type": "string", "enum": ["available", "sold"]},
+  "parent": {"$ref": "#/components/schemas/Pet"}}}}}}`,
+		},
+		{
+			desc: "breaking and non-breaking changes",
+			base: petstoreV3,
+			revision: `
+openapi: 3.0.0
+info:
+  title: Petstore
+  version: 2.0.0
+security:
+  - apiKey: []
+  - oauth: [read]
+paths:
+  /pets:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          required: true
+          schema:
+            type: integer
+        - name: owner
+          in: query
+          schema:
+            type: string
+      responses:
+        "200":
+          description: pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: "#/components/schemas/Pet"
+        "400":
+          description: bad request
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Pet"
+      responses:
+        "201":
+          description: created
+  /pets/{petId}:
+    get:
+      security: []
+      parameters:
+        - name: petId
+          in: path
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: a pet
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Pet"
+  /owners:
+    get:
+      responses:
+        "200":
+          description: owners
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, name, age]
+      properties:
+        id:
+          type: integer
+        name:
+          type: string
+        age:
+          type: integer
+        status:
+          type: string
+          enum: [available, sold, pending]
+        parent:
+          $ref: "#/components/schemas/Pet"
+`,
+			want: []*diff.Difference{
+				{Change: diff.Difference_ADDED, Element: "path", Location: "/owners", Description: "added path /owners"},
+				{Change: diff.Difference_MODIFIED, Element: "parameter", Location: "GET /pets parameter limit (query)", Description: "parameter limit (query) is now required", Breaking: true},
+				{Change: diff.Difference_ADDED, Element: "parameter", Location: "GET /pets parameter owner (query)", Description: "added optional parameter owner (query)"},
+				{Change: diff.Difference_ADDED, Element: "property", Location: "GET /pets response 200 application/json items property age", Description: "added required property age"},
+				{Change: diff.Difference_ADDED, Element: "value", Location: "GET /pets response 200 application/json items property status", Description: "added value pending", Breaking: true},
+				{Change: diff.Difference_REMOVED, Element: "property", Location: "GET /pets response 200 application/json items property tag", Description: "removed property tag", Breaking: true},
+				{Change: diff.Difference_ADDED, Element: "response", Location: "GET /pets response 400", Description: "added response 400"},
+				{Change: diff.Difference_ADDED, Element: "security", Location: "GET /pets", Description: "added security requirement oauth[read]"},
+				{Change: diff.Difference_ADDED, Element: "property", Location: "POST /pets request application/json property age", Description: "added required property age", Breaking: true},
+				{Change: diff.Difference_ADDED, Element: "value", Location: "POST /pets request application/json property status", Description: "added value pending"},
+				{Change: diff.Difference_REMOVED, Element: "property", Location: "POST /pets request application/json property tag", Description: "removed property tag", Breaking: true},
+				{Change: diff.Difference_ADDED, Element: "security", Location: "POST /pets", Description: "added security requirement oauth[read]"},
+				{Change: diff.Difference_MODIFIED, Element: "schema", Location: "GET /pets/{petId} parameter petId (path)", Description: `changed type from "string" to "integer"`, Breaking: true},
+				{Change: diff.Difference_ADDED, Element: "property", Location: "GET /pets/{petId} response 200 application/json property age", Description: "added required property age"},
+				{Change: diff.Difference_ADDED, Element: "value", Location: "GET /pets/{petId} response 200 application/json property status", Description: "added value pending", Breaking: true},
+				{Change: diff.Difference_REMOVED, Element: "property", Location: "GET /pets/{petId} response 200 application/json property tag", Description: "removed property tag", Breaking: true},
+				{Change: diff.Difference_REMOVED, Element: "security", Location: "GET /pets/{petId}", Description: "removed security requirement apiKey[]", Breaking: true},
+				{Change: diff.Difference_ADDED, Element: "security", Location: "GET /pets/{petId}", Description: "added security requirement none"},
+				{Change: diff.Difference_REMOVED, Element: "operation", Location: "DELETE /pets/{petId}", Description: "removed operation DELETE /pets/{petId}", Breaking: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			base, err := parseOpenAPI([]byte(test.base))
+			if err != nil {
+				t.Fatalf("parseOpenAPI(base) failed: %s", err)
+			}
+			revision, err := parseOpenAPI([]byte(test.revision))
+			if err != nil {
+				t.Fatalf("parseOpenAPI(revision) failed: %s", err)
+			}
+			got := compareOpenAPI(base, revision)
+			if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("compareOpenAPI() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompareOpenAPIv2(t *testing.T) {
+	base := `
+swagger: "2.0"
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      parameters:
+        - name: body
+          in: body
+          schema:
+            $ref: "#/definitions/Pet"
+        - name: dryRun
+          in: query
+          type: boolean
+      responses:
+        200:
+          description: created
+          schema:
+            $ref: "#/definitions/Pet"
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+`
+	revision := `
+swagger: "2.0"
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      parameters:
+        - name: body
+          in: body
+          required: true
+          schema:
+            $ref: "#/definitions/Pet"
+      responses:
+        200:
+          description: created
+          schema:
+            $ref: "#/definitions/Pet"
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: integer
+`
+	want := []*diff.Difference{
+		{Change: diff.Difference_REMOVED, Element: "parameter", Location: "POST /pets parameter dryRun (query)", Description: "removed parameter dryRun (query)", Breaking: true},
+		{Change: diff.Difference_MODIFIED, Element: "request", Location: "POST /pets request", Description: "request body is now required", Breaking: true},
+		{Change: diff.Difference_MODIFIED, Element: "schema", Location: "POST /pets request property name", Description: `changed type from "string" to "integer"`, Breaking: true},
+		{Change: diff.Difference_MODIFIED, Element: "schema", Location: "POST /pets response 200 property name", Description: `changed type from "string" to "integer"`, Breaking: true},
+	}
+	b, err := parseOpenAPI([]byte(base))
+	if err != nil {
+		t.Fatalf("parseOpenAPI(base) failed: %s", err)
+	}
+	r, err := parseOpenAPI([]byte(revision))
+	if err != nil {
+		t.Fatalf("parseOpenAPI(revision) failed: %s", err)
+	}
+	if diff := cmp.Diff(want, compareOpenAPI(b, r), protocmp.Transform()); diff != "" {
+		t.Errorf("compareOpenAPI() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseOpenAPIErrors(t *testing.T) {
+	for _, contents := range []string{"{", "[]", "info: {}"} {
+		if _, err := parseOpenAPI([]byte(contents)); err == nil {
+			t.Errorf("parseOpenAPI(%q) succeeded, expected error", contents)
+		}
+	}
+}
+
+// sharedSchemas returns an OpenAPI description with a chain of schemas that
+// each refer to the next one twice, so that expanding its references would
+// produce 2^n copies of the last schema.
+func sharedSchemas(n int, lastType string) string {
+	var b strings.Builder
+	b.WriteString(`{"openapi": "3.0.0", "info": {"title": "Shared", "version": "1.0.0"},
+"paths": {"/nodes": {"get": {"responses": {"200": {"description": "a node",
+  "content": {"application/json": {"schema": {"$ref": "#/components/schemas/S0"}}}}}}}},
+"components": {"schemas": {`)
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, `"S%d": {"type": "object", "properties": {"a": {"$ref": "#/components/schemas/S%d"}, "b": {"$ref": "#/components/schemas/S%d"}}},`, i, i+1, i+1)
+	}
+	fmt.Fprintf(&b, `"S%d": {"type": %q}}}}`, n, lastType)
+	return b.String()
+}
+
+func TestCompareOpenAPISharedSchemas(t *testing.T) {
+	base, err := parseOpenAPI([]byte(sharedSchemas(64, "string")))
+	if err != nil {
+		t.Fatalf("parseOpenAPI(base) failed: %s", err)
+	}
+	if got := compareOpenAPI(base, base); len(got) != 0 {
+		t.Errorf("compareOpenAPI() returned %d differences for the same description, want 0", len(got))
+	}
+	revision, err := parseOpenAPI([]byte(sharedSchemas(64, "string")))
+	if err != nil {
+		t.Fatalf("parseOpenAPI(revision) failed: %s", err)
+	}
+	if got := compareOpenAPI(base, revision); len(got) != 0 {
+		t.Errorf("compareOpenAPI() returned %d differences for equal descriptions, want 0", len(got))
+	}
+
+	// A change to the last schema is reported at each of its locations.
+	base, err = parseOpenAPI([]byte(sharedSchemas(3, "string")))
+	if err != nil {
+		t.Fatalf("parseOpenAPI(base) failed: %s", err)
+	}
+	revision, err = parseOpenAPI([]byte(sharedSchemas(3, "integer")))
+	if err != nil {
+		t.Fatalf("parseOpenAPI(revision) failed: %s", err)
+	}
+	if got := compareOpenAPI(base, revision); len(got) != 8 {
+		t.Errorf("compareOpenAPI() returned %d differences, want 8: %v", len(got), got)
+	}
+}
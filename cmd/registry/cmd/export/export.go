@@ -17,10 +17,14 @@ package export
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/apigee/registry/cmd/registry/patch"
 	"github.com/apigee/registry/cmd/registry/tasks"
 	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/log"
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/pkg/visitor"
 	"github.com/apigee/registry/rpc"
@@ -32,10 +36,21 @@ func Command() *cobra.Command {
 	var recursive bool
 	var jobs int
 	var root string
+	var archive string
+	var etags bool
 	cmd := &cobra.Command{
 		Use:   "export PATTERN",
 		Short: "Export resources from the API Registry",
-		Args:  cobra.ExactArgs(1),
+		Long: `Export resources from the API Registry as YAML files.
+
+With --archive, a project is exported by the server as a single tar or zip
+archive, which is a consistent snapshot of the project. Archives can be
+applied with "registry apply -f ARCHIVE".
+
+The YAML files include the etag of each resource, so that "registry apply"
+only updates resources that haven't changed since then. Use --etags=false
+to leave the etags out.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			c, err := connection.ActiveConfig()
@@ -51,6 +66,12 @@ func Command() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if archive != "" {
+				return exportArchive(ctx, adminClient, pattern, archive)
+			}
+			if !etags {
+				ctx = patch.SetExcludeEtags(ctx)
+			}
 			// Initialize task queue.
 			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
 			defer wait()
@@ -86,9 +107,35 @@ func Command() *cobra.Command {
 	cmd.Flags().IntVarP(&jobs, "jobs", "j", 10, "number of actions to perform concurrently")
 	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "include child resources in export")
 	cmd.Flags().StringVar(&root, "root", "", "root directory for export")
+	cmd.Flags().StringVar(&archive, "archive", "", "export a project to a .tar or .zip archive file")
+	cmd.Flags().BoolVar(&etags, "etags", true, "include etags in exported YAML files")
 	return cmd
 }
 
+func exportArchive(ctx context.Context, client connection.AdminClient, pattern, filename string) error {
+	name, err := names.ParseProject(pattern)
+	if err != nil {
+		if name, err = names.ParseProjectWithLocation(pattern); err != nil {
+			return fmt.Errorf("--archive requires a project: %s", err)
+		}
+	}
+	format := rpc.ExportProjectRequest_TAR
+	if strings.HasSuffix(filename, ".zip") {
+		format = rpc.ExportProjectRequest_ZIP
+	}
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := patch.ExportArchive(ctx, client, name, format, f); err != nil {
+		f.Close()
+		os.Remove(filename)
+		return err
+	}
+	log.FromContext(ctx).Infof("Exported %s to %s", name, filename)
+	return f.Close()
+}
+
 type exportVisitor struct {
 	registryClient connection.RegistryClient
 	adminClient    connection.AdminClient
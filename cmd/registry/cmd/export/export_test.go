@@ -17,8 +17,10 @@ package export
 import (
 	"context"
 	"io"
+	"path/filepath"
 	"testing"
 
+	"github.com/apigee/registry/cmd/registry/cmd/apply"
 	"github.com/apigee/registry/pkg/connection/grpctest"
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry"
@@ -219,3 +221,57 @@ func TestExportValidResourcesWithFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestExportArchive(t *testing.T) {
+	specs := []seeder.RegistryResource{
+		&rpc.ApiSpec{
+			Name:     "projects/my-project/locations/global/apis/a/versions/v/specs/s",
+			Filename: "openapi.yaml",
+			MimeType: "application/x.openapi;version=3",
+			Contents: []byte("openapi: 3.0.0\n"),
+		},
+	}
+	ctx := context.Background()
+	registryClient, adminClient := grpctest.SetupRegistry(ctx, t, "my-project", specs)
+
+	for _, ext := range []string{"tar", "zip"} {
+		t.Run(ext, func(t *testing.T) {
+			archive := filepath.Join(t.TempDir(), "my-project."+ext)
+			cmd := Command()
+			args := []string{"projects/my-project", "--archive", archive}
+			cmd.SetArgs(args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute() with args %v returned error: %s", args, err)
+			}
+
+			project := "projects/restored-" + ext
+			t.Cleanup(func() {
+				_ = adminClient.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: project, Force: true})
+			})
+			cmd = apply.Command()
+			args = []string{"-f", archive, "--parent", project}
+			cmd.SetArgs(args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute() with args %v returned error: %s", args, err)
+			}
+			contents, err := registryClient.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{
+				Name: project + "/locations/global/apis/a/versions/v/specs/s",
+			})
+			if err != nil {
+				t.Fatalf("GetApiSpecContents() returned error: %s", err)
+			}
+			if got := string(contents.GetData()); got != "openapi: 3.0.0\n" {
+				t.Errorf("GetApiSpecContents() returned %q, want exported contents", got)
+			}
+		})
+	}
+
+	cmd := Command()
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	args := []string{"projects/my-project/locations/global/apis/a", "--archive", filepath.Join(t.TempDir(), "a.tar")}
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err == nil {
+		t.Errorf("Execute() with args %v succeeded but should have failed", args)
+	}
+}
@@ -36,6 +36,7 @@ func Command() *cobra.Command {
 	var filter string
 	var output string
 	var nested bool
+	var etags bool
 
 	cmd := &cobra.Command{
 		Use:   "get PATTERN",
@@ -67,6 +68,9 @@ The "--output yaml" or "--nested" parameters will generate a single top-level
 YAML element, either the entity itself or an array named "items" that contains
 the entities. In addition, if "--nested" is specified, each returned YAML 
 element will recursively include all sub-elements within its YAML.
+The YAML includes the etag of each entity, so that "registry apply" only
+updates entities that haven't changed since then. Use "--etags=false" to
+leave the etags out.
 
 Examples:
 
@@ -111,6 +115,9 @@ Retrieve YAML for all deployment revisions of the "bookstore" api:
 			if nested && output != "yaml" {
 				return errors.New("--nested is only supported for yaml output")
 			}
+			if !etags {
+				ctx = patch.SetExcludeEtags(ctx)
+			}
 			// Create the visitor that will perform gets.
 			v := &getVisitor{
 				registryClient: registryClient,
@@ -146,6 +153,7 @@ Retrieve YAML for all deployment revisions of the "bookstore" api:
 	cmd.Flags().StringVar(&filter, "filter", "", "filter selected resources")
 	cmd.Flags().StringVarP(&output, "output", "o", "", "output type (name|yaml|contents)")
 	cmd.Flags().BoolVar(&nested, "nested", false, "include nested subresources in YAML output")
+	cmd.Flags().BoolVar(&etags, "etags", true, "include etags in YAML output")
 	return cmd
 }
 
@@ -0,0 +1,126 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/apigee/registry/cmd/registry/controller"
+	"github.com/apigee/registry/cmd/registry/patch"
+	controller_message "github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/encoding"
+	"gopkg.in/yaml.v3"
+)
+
+// readManifest reads a manifest from a YAML file in the format used by
+// "registry apply".
+func readManifest(filename string) (*controller_message.Manifest, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var artifact encoding.Artifact
+	if err := yaml.Unmarshal(b, &artifact); err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+	if artifact.Kind != "Manifest" {
+		return nil, fmt.Errorf("%s: expected kind Manifest, got %q", filename, artifact.Kind)
+	}
+	mimeType, contents, err := encoding.ArtifactContents(&artifact, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+	manifest := &controller_message.Manifest{}
+	if err := patch.UnmarshalContents(contents, mimeType, manifest); err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+	return manifest, nil
+}
+
+// printPlan writes a plan for people to read, with a table of targets for
+// each manifest entry. Unless explain is set, targets that are up to date
+// are omitted. If it is set, each target is followed by the reason for its
+// action and the dependencies that were compared.
+func printPlan(w io.Writer, plan *controller.Plan, explain bool) {
+	for _, e := range plan.Entries {
+		if e.Stage > 0 {
+			fmt.Fprintf(w, "%s (stage %d)\n", e.Pattern, e.Stage)
+		} else {
+			fmt.Fprintf(w, "%s\n", e.Pattern)
+		}
+		for _, err := range e.Errors {
+			fmt.Fprintf(w, "  error: %s\n", err)
+		}
+		if e.Skipped != "" {
+			fmt.Fprintf(w, "  skipped: %s\n", e.Skipped)
+		}
+		if explain {
+			for _, t := range e.Targets {
+				fmt.Fprintf(w, "  %s %s\n", t.Action, t.Resource)
+				fmt.Fprintf(w, "    reason: %s\n", t.Reason)
+				fmt.Fprintf(w, "    updated: %s\n", formatTime(t.UpdateTime))
+				if t.Command != "" {
+					fmt.Fprintf(w, "    command: %s\n", t.Command)
+				}
+				for _, d := range t.Dependencies {
+					fmt.Fprintf(w, "    dependency %s updated %s\n", d.Pattern, formatTime(d.UpdateTime))
+					for _, r := range d.Resources {
+						fmt.Fprintf(w, "      %s\n", r)
+					}
+				}
+			}
+		} else {
+			tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			n := 0
+			for _, t := range e.Targets {
+				if t.Action == controller.PlanNone {
+					continue
+				}
+				if n == 0 {
+					fmt.Fprintln(tw, "  ACTION\tRESOURCE\tCOMMAND")
+				}
+				fmt.Fprintf(tw, "  %s\t%s\t%s\n", t.Action, t.Resource, t.Command)
+				n++
+			}
+			tw.Flush()
+		}
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d unchanged, %d invalid\n",
+		plan.Count(controller.PlanCreate),
+		plan.Count(controller.PlanUpdate),
+		plan.Count(controller.PlanNone),
+		plan.Invalid())
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func printPlanJSON(w io.Writer, plan *controller.Plan) error {
+	b, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
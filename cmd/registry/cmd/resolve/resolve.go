@@ -15,54 +15,50 @@
 package resolve
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/apigee/registry/cmd/registry/controller"
-	"github.com/apigee/registry/cmd/registry/patch"
 	"github.com/apigee/registry/cmd/registry/tasks"
 	controller_message "github.com/apigee/registry/pkg/application/controller"
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/log"
 	"github.com/apigee/registry/pkg/names"
-	"github.com/apigee/registry/rpc"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
 
-func fetchManifest(
-	ctx context.Context,
-	client connection.RegistryClient,
-	manifestName string) (*controller_message.Manifest, error) {
-	manifest := &controller_message.Manifest{}
-	body, err := client.GetArtifactContents(
-		ctx,
-		&rpc.GetArtifactContentsRequest{
-			Name: manifestName,
-		})
-	if err != nil {
-		return nil, err
-	}
-
-	contents := body.GetData()
-	err = patch.UnmarshalContents(contents, body.GetContentType(), manifest)
-	if err != nil {
-		return nil, err
-	}
-
-	return manifest, nil
-}
-
 func Command() *cobra.Command {
 	var dryRun bool
 	var jobs int
 	var maxActions int
+	var plan, explain bool
+	var output, manifestFile string
 	cmd := &cobra.Command{
 		Use:   "resolve MANIFEST_ARTIFACT",
 		Short: "Resolve dependencies by performing actions in a specified manifest",
-		Args:  cobra.ExactArgs(1),
+		Long: `Resolve dependencies by performing actions in a specified manifest.
+
+With --plan, the actions are explained instead of performed. For each entry
+of the manifest, the plan lists the target resources, whether they need to be
+created or updated, and the command that would do it. With --explain, it also
+lists targets that are up to date, the dependencies and timestamps that were
+compared, and the reason for each decision. Invalid manifest entries are
+reported as errors.
+
+With --file, the manifest is read from a YAML file instead of the registry,
+so that it can be checked before it is uploaded to MANIFEST_ARTIFACT.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if output != "table" && output != "json" {
+				return fmt.Errorf("invalid output type %q, must be table or json", output)
+			}
+			if output != "table" && !plan {
+				return fmt.Errorf("--output requires --plan")
+			}
+			if explain && !plan {
+				return fmt.Errorf("--explain requires --plan")
+			}
 			c, err := connection.ActiveConfig()
 			if err != nil {
 				return err
@@ -79,43 +75,86 @@ func Command() *cobra.Command {
 				return err
 			}
 
-			manifest, err := fetchManifest(ctx, registryClient, name.String())
+			var manifest *controller_message.Manifest
+			if manifestFile != "" {
+				manifest, err = readManifest(manifestFile)
+			} else {
+				manifest, err = controller.FetchManifest(ctx, registryClient, name.String())
+			}
 			if err != nil {
 				return err
 			}
 
 			client := &controller.RegistryLister{RegistryClient: registryClient}
 
-			log.Debug(ctx, "Generating the list of actions...")
-			actions := controller.ProcessManifest(ctx, client, name.ProjectID(), manifest, maxActions)
-
-			// The monitoring metrics/dashboards are built on top of the format of the log messages here.
-			// Check the metric filters before making any changes to the format.
-			// Location: registry/deployments/controller/dashboard/*
-			if len(actions) == 0 {
-				log.Debug(ctx, "Generated 0 actions. The registry is already in a resolved state.")
+			if plan {
+				p := controller.PlanManifest(ctx, client, name.ProjectID(), manifest)
+				if output == "json" {
+					err = printPlanJSON(cmd.OutOrStdout(), p)
+				} else {
+					printPlan(cmd.OutOrStdout(), p, explain)
+				}
+				if err != nil {
+					return err
+				}
+				if n := p.Invalid(); n > 0 {
+					return fmt.Errorf("manifest has %d invalid entries", n)
+				}
 				return nil
 			}
 
-			log.Debugf(ctx, "Generated %d actions.", len(actions))
+			// Entries are processed in stages, so that the actions of each
+			// stage use the resources generated by earlier stages.
+			var adminClient connection.AdminClient
+			total := 0
+			for _, stage := range controller.ManifestStages(ctx, name.ProjectID(), manifest) {
+				if total >= maxActions {
+					break
+				}
+				log.Debug(ctx, "Generating the list of actions...")
+				actions := controller.ProcessManifest(ctx, client, name.ProjectID(), stage, maxActions-total)
+
+				// The monitoring metrics/dashboards are built on top of the format of the log messages here.
+				// Check the metric filters before making any changes to the format.
+				// Location: registry/deployments/controller/dashboard/*
+				if len(actions) == 0 {
+					continue
+				}
+				total += len(actions)
 
-			// If dry_run is set to true, print the generated actions and exit
-			if dryRun {
-				for _, a := range actions {
-					log.Debugf(ctx, "Action: %q", a.Command)
+				log.Debugf(ctx, "Generated %d actions.", len(actions))
+
+				// If dry_run is set to true, print the generated actions and continue
+				if dryRun {
+					for _, a := range actions {
+						log.Debugf(ctx, "Action: %q", a.Command)
+					}
+					continue
+				}
+
+				if adminClient == nil {
+					adminClient, err = connection.NewAdminClientWithSettings(ctx, c)
+					if err != nil {
+						return err
+					}
 				}
-				return nil
-			}
 
-			log.Debug(ctx, "Starting execution...")
-			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
-			defer wait()
-			// Submit tasks to taskQueue
-			for i := 0; i < len(actions) && i < maxActions; i++ {
-				taskQueue <- &controller.ExecCommandTask{
-					Action: actions[i],
-					TaskID: fmt.Sprintf("%.8s", uuid.New()),
+				log.Debug(ctx, "Starting execution...")
+				taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
+				// Submit tasks to taskQueue
+				for _, a := range actions {
+					taskQueue <- &controller.ExecCommandTask{
+						Action:         a,
+						TaskID:         fmt.Sprintf("%.8s", uuid.New()),
+						RegistryClient: registryClient,
+						AdminClient:    adminClient,
+					}
 				}
+				// Wait for the stage to finish before generating the actions of the next one.
+				wait()
+			}
+			if total == 0 {
+				log.Debug(ctx, "Generated 0 actions. The registry is already in a resolved state.")
 			}
 			return nil
 		},
@@ -124,6 +163,10 @@ func Command() *cobra.Command {
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "if set, actions will only be printed and not executed")
 	cmd.Flags().IntVarP(&jobs, "jobs", "j", 10, "number of actions to perform concurrently")
 	cmd.Flags().IntVarP(&maxActions, "actions", "a", 100, "maximum number of actions to execute")
+	cmd.Flags().BoolVar(&plan, "plan", false, "if set, explain the actions for each manifest entry instead of performing them")
+	cmd.Flags().BoolVar(&explain, "explain", false, "if set, include dependency timestamps, reasons and up-to-date targets in the plan")
+	cmd.Flags().StringVarP(&output, "output", "o", "table", "output type of --plan (table|json)")
+	cmd.Flags().StringVarP(&manifestFile, "file", "f", "", "read the manifest from a YAML file instead of MANIFEST_ARTIFACT")
 
 	cmd.Flags().IntVar(&maxActions, "max-actions", 100, "maximum number of actions to execute")
 	_ = cmd.Flags().MarkDeprecated("max-actions", "use -a or --actions")
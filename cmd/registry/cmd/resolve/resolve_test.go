@@ -18,13 +18,16 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/apigee/registry/cmd/registry/cmd/apply"
+	"github.com/apigee/registry/cmd/registry/controller"
 	"github.com/apigee/registry/pkg/connection/grpctest"
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
@@ -73,8 +76,11 @@ func TestResolve(t *testing.T) {
 			listParent:   "projects/controller-demo/locations/global/apis/petstore/versions/-/specs/-",
 			want: []string{
 				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/complexity",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/complexity-receipt",
 				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.1/specs/openapi/artifacts/complexity",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.1/specs/openapi/artifacts/complexity-receipt",
 				"projects/controller-demo/locations/global/apis/petstore/versions/1.1.0/specs/openapi/artifacts/complexity",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.1.0/specs/openapi/artifacts/complexity-receipt",
 			},
 		},
 		{
@@ -88,6 +94,20 @@ func TestResolve(t *testing.T) {
 				"projects/controller-demo/locations/global/apis/petstore/versions/1.1.0/specs/openapi/artifacts/test-receipt-artifact",
 			},
 		},
+		{
+			desc:         "dependent entries",
+			manifestPath: filepath.Join("testdata", "manifest_stages.yaml"),
+			dryRun:       false,
+			listParent:   "projects/controller-demo/locations/global/apis/petstore/versions/-/specs/-",
+			want: []string{
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/first",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/second",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.1/specs/openapi/artifacts/first",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.0.1/specs/openapi/artifacts/second",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.1.0/specs/openapi/artifacts/first",
+				"projects/controller-demo/locations/global/apis/petstore/versions/1.1.0/specs/openapi/artifacts/second",
+			},
+		},
 		{
 			desc:         "dry run",
 			manifestPath: filepath.Join("testdata", "manifest.yaml"),
@@ -279,3 +299,139 @@ func TestResolve(t *testing.T) {
 		})
 	}
 }
+
+func TestResolvePlan(t *testing.T) {
+	ctx := context.Background()
+	testProject := "controller-plan"
+	client, _ := grpctest.SetupRegistry(ctx, t, testProject, nil)
+
+	buf, err := readAndGZipFile(t, filepath.Join("testdata", "openapi.yaml"))
+	if err != nil {
+		t.Fatalf("Failed reading API contents: %s", err.Error())
+	}
+	if _, err := client.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/" + testProject + "/locations/global",
+		ApiId:  "petstore",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("Failed CreateApi: %s", err)
+	}
+	if _, err := client.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+		Parent:       "projects/" + testProject + "/locations/global/apis/petstore",
+		ApiVersionId: "1.0.0",
+		ApiVersion:   &rpc.ApiVersion{},
+	}); err != nil {
+		t.Fatalf("Failed CreateApiVersion: %s", err)
+	}
+	spec, err := client.CreateApiSpec(ctx, &rpc.CreateApiSpecRequest{
+		Parent:    "projects/" + testProject + "/locations/global/apis/petstore/versions/1.0.0",
+		ApiSpecId: "openapi",
+		ApiSpec: &rpc.ApiSpec{
+			MimeType: "application/x.openapi+gzip;version=3.0.0",
+			Contents: buf.Bytes(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed CreateApiSpec: %s", err)
+	}
+	specName := spec.Name + "@" + spec.RevisionId
+	manifestName := "projects/" + testProject + "/locations/global/artifacts/test-manifest"
+
+	run := func(args ...string) (string, error) {
+		t.Helper()
+		cmd := Command()
+		out := new(bytes.Buffer)
+		cmd.SetArgs(append([]string{manifestName}, args...))
+		cmd.SetOut(out)
+		cmd.SetErr(io.Discard)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	t.Run("json", func(t *testing.T) {
+		out, err := run("--plan", "-o", "json", "--file", filepath.Join("testdata", "manifest.yaml"))
+		if err != nil {
+			t.Fatalf("Execute() returned error: %s", err)
+		}
+		var got controller.Plan
+		if err := json.Unmarshal([]byte(out), &got); err != nil {
+			t.Fatalf("Failed to unmarshal plan %q: %s", out, err)
+		}
+		want := controller.Plan{
+			Entries: []*controller.PlanEntry{{
+				Pattern: "apis/-/versions/-/specs/-/artifacts/complexity",
+				Stage:   1,
+				Targets: []*controller.PlanTarget{{
+					Resource: specName + "/artifacts/complexity",
+					Dependencies: []*controller.PlanDependency{{
+						Pattern:   "$resource.spec",
+						Resources: []string{specName},
+					}},
+					Action:  controller.PlanCreate,
+					Reason:  "target does not exist",
+					Command: "registry compute complexity " + specName,
+				}},
+			}},
+		}
+		opts := cmpopts.IgnoreFields(controller.PlanDependency{}, "UpdateTime")
+		if diff := cmp.Diff(want, got, opts); diff != "" {
+			t.Errorf("Plan returned unexpected diff (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("table", func(t *testing.T) {
+		out, err := run("--plan", "--file", filepath.Join("testdata", "manifest.yaml"))
+		if err != nil {
+			t.Fatalf("Execute() returned error: %s", err)
+		}
+		for _, want := range []string{
+			"create  " + specName + "/artifacts/complexity  registry compute complexity " + specName,
+			"1 to create, 0 to update, 0 unchanged, 0 invalid",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("Plan %q does not contain %q", out, want)
+			}
+		}
+	})
+
+	t.Run("explain", func(t *testing.T) {
+		out, err := run("--plan", "--explain", "--file", filepath.Join("testdata", "manifest.yaml"))
+		if err != nil {
+			t.Fatalf("Execute() returned error: %s", err)
+		}
+		for _, want := range []string{
+			"reason: target does not exist",
+			"dependency $resource.spec updated ",
+			"      " + specName,
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("Plan %q does not contain %q", out, want)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		out, err := run("--plan", "--file", filepath.Join("testdata", "manifest_invalid.yaml"))
+		if err == nil {
+			t.Fatalf("Execute() succeeded with an invalid manifest")
+		}
+		if want := "manifest has 1 invalid entries"; err.Error() != want {
+			t.Errorf("Execute() returned error %q, want %q", err, want)
+		}
+		if want := "error: generated_resources[1]: invalid generatedResource pattern"; !strings.Contains(out, want) {
+			t.Errorf("Plan %q does not contain %q", out, want)
+		}
+	})
+
+	t.Run("flags", func(t *testing.T) {
+		for _, args := range [][]string{
+			{"--explain"},
+			{"-o", "json"},
+			{"--plan", "-o", "yaml"},
+		} {
+			if _, err := run(args...); err == nil {
+				t.Errorf("Execute() with args %v succeeded, want error", args)
+			}
+		}
+	})
+}
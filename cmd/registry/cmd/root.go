@@ -17,10 +17,12 @@ package cmd
 import (
 	"github.com/apigee/registry/cmd/registry/cmd/annotate"
 	"github.com/apigee/registry/cmd/registry/cmd/apply"
+	"github.com/apigee/registry/cmd/registry/cmd/audit"
 	"github.com/apigee/registry/cmd/registry/cmd/auth"
 	"github.com/apigee/registry/cmd/registry/cmd/check"
 	"github.com/apigee/registry/cmd/registry/cmd/compute"
 	"github.com/apigee/registry/cmd/registry/cmd/config"
+	"github.com/apigee/registry/cmd/registry/cmd/controller"
 	"github.com/apigee/registry/cmd/registry/cmd/delete"
 	"github.com/apigee/registry/cmd/registry/cmd/diff"
 	"github.com/apigee/registry/cmd/registry/cmd/export"
@@ -28,6 +30,8 @@ import (
 	"github.com/apigee/registry/cmd/registry/cmd/label"
 	"github.com/apigee/registry/cmd/registry/cmd/resolve"
 	"github.com/apigee/registry/cmd/registry/cmd/rpc"
+	"github.com/apigee/registry/cmd/registry/cmd/search"
+	"github.com/apigee/registry/cmd/registry/cmd/snapshot"
 	"github.com/apigee/registry/cmd/registry/cmd/upload"
 	pkgconf "github.com/apigee/registry/pkg/config"
 	"github.com/spf13/cobra"
@@ -47,16 +51,20 @@ func Command() *cobra.Command {
 
 	cmd.AddCommand(annotate.Command())
 	cmd.AddCommand(apply.Command())
+	cmd.AddCommand(audit.Command())
 	cmd.AddCommand(auth.Command())
 	cmd.AddCommand(check.Command())
 	cmd.AddCommand(compute.Command())
 	cmd.AddCommand(config.Command())
+	cmd.AddCommand(controller.Command())
 	cmd.AddCommand(resolve.Command())
 	cmd.AddCommand(delete.Command())
 	cmd.AddCommand(diff.Command())
 	cmd.AddCommand(export.Command())
 	cmd.AddCommand(get.Command())
 	cmd.AddCommand(label.Command())
+	cmd.AddCommand(search.Command())
+	cmd.AddCommand(snapshot.Command())
 	cmd.AddCommand(upload.Command())
 	cmd.AddCommand(rpc.Command())
 	return cmd
@@ -19,6 +19,13 @@ var AdminSubCommands []string = []string{
 	"create-project",
 	"update-project",
 	"delete-project",
+	"undelete-project",
+	"export-project",
+	"import-project",
+	"create-snapshot",
+	"list-snapshots",
+	"delete-snapshot",
+	"restore-snapshot",
 }
 
 func init() {
@@ -33,6 +33,8 @@ func init() {
 
 	ListApiDeploymentsCmd.Flags().StringVar(&ListApiDeploymentsInput.OrderBy, "order_by", "", "A comma-separated list of fields, e.g. 'foo,bar' ...")
 
+	ListApiDeploymentsCmd.Flags().BoolVar(&ListApiDeploymentsInput.ShowDeleted, "show_deleted", false, "If true, deleted deployments that have not expired are...")
+
 	ListApiDeploymentsCmd.Flags().StringVar(&ListApiDeploymentsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -33,6 +33,8 @@ func init() {
 
 	ListApiSpecsCmd.Flags().StringVar(&ListApiSpecsInput.OrderBy, "order_by", "", "A comma-separated list of fields, e.g. 'foo,bar' ...")
 
+	ListApiSpecsCmd.Flags().BoolVar(&ListApiSpecsInput.ShowDeleted, "show_deleted", false, "If true, deleted specs that have not expired are...")
+
 	ListApiSpecsCmd.Flags().StringVar(&ListApiSpecsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
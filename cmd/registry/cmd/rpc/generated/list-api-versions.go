@@ -33,6 +33,8 @@ func init() {
 
 	ListApiVersionsCmd.Flags().StringVar(&ListApiVersionsInput.OrderBy, "order_by", "", "A comma-separated list of fields, e.g. 'foo,bar' ...")
 
+	ListApiVersionsCmd.Flags().BoolVar(&ListApiVersionsInput.ShowDeleted, "show_deleted", false, "If true, deleted versions that have not expired are...")
+
 	ListApiVersionsCmd.Flags().StringVar(&ListApiVersionsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
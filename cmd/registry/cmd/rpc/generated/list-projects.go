@@ -31,6 +31,8 @@ func init() {
 
 	ListProjectsCmd.Flags().StringVar(&ListProjectsInput.OrderBy, "order_by", "", "A comma-separated list of fields, e.g. 'foo,bar' ...")
 
+	ListProjectsCmd.Flags().BoolVar(&ListProjectsInput.ShowDeleted, "show_deleted", false, "If true, deleted projects that have not expired are...")
+
 	ListProjectsCmd.Flags().StringVar(&ListProjectsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
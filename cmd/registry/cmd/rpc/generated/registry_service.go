@@ -16,17 +16,20 @@ var RegistrySubCommands []string = []string{
 	"create-api",
 	"update-api",
 	"delete-api",
+	"undelete-api",
 	"list-api-versions",
 	"get-api-version",
 	"create-api-version",
 	"update-api-version",
 	"delete-api-version",
+	"undelete-api-version",
 	"list-api-specs",
 	"get-api-spec",
 	"get-api-spec-contents",
 	"create-api-spec",
 	"update-api-spec",
 	"delete-api-spec",
+	"undelete-api-spec",
 	"tag-api-spec-revision",
 	"list-api-spec-revisions",
 	"rollback-api-spec",
@@ -36,6 +39,7 @@ var RegistrySubCommands []string = []string{
 	"create-api-deployment",
 	"update-api-deployment",
 	"delete-api-deployment",
+	"undelete-api-deployment",
 	"tag-api-deployment-revision",
 	"list-api-deployment-revisions",
 	"rollback-api-deployment",
@@ -46,6 +50,12 @@ var RegistrySubCommands []string = []string{
 	"create-artifact",
 	"replace-artifact",
 	"delete-artifact",
+	"undelete-artifact",
+	"watch-resources",
+	"search-resources",
+	"batch-create-api-specs",
+	"batch-update-artifacts",
+	"batch-mutate",
 }
 
 func init() {
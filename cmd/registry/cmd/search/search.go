@@ -0,0 +1,109 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+	"google.golang.org/api/iterator"
+)
+
+func Command() *cobra.Command {
+	var parent string
+	var limit int
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "search QUERY",
+		Short: "Search APIs, specs and artifacts in the API Registry",
+		Long: `Search APIs, specs and artifacts in the API Registry.
+Resources match when their display names, descriptions, labels, annotations
+or spec contents (such as operation IDs, paths and message names) contain
+all of the words in QUERY. The most relevant resources are listed first.
+
+The project to search is taken from the configuration unless --parent is
+specified. Use "--parent projects/-/locations/global" to search all projects.
+
+Examples:
+
+Find the specs and APIs that mention pets:
+
+	registry search pets
+
+List the names of resources with an operation named "listPets":
+
+	registry search listPets --output name`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			c, err := connection.ActiveConfig()
+			if err != nil {
+				return err
+			}
+			if parent == "" {
+				parent, err = c.ProjectWithLocation()
+				if err != nil {
+					return fmt.Errorf("unable to identify parent: please use --parent or set registry.project in configuration (%s)", err)
+				}
+			}
+			if output != "table" && output != "name" {
+				return fmt.Errorf("unsupported output type %q", output)
+			}
+			client, err := connection.NewRegistryClientWithSettings(ctx, c)
+			if err != nil {
+				return err
+			}
+
+			it := client.SearchResources(ctx, &rpc.SearchResourcesRequest{
+				Parent: parent,
+				Query:  strings.Join(args, " "),
+			})
+			var results []*rpc.SearchResult
+			for limit <= 0 || len(results) < limit {
+				r, err := it.Next()
+				if err == iterator.Done {
+					break
+				} else if err != nil {
+					return err
+				}
+				results = append(results, r)
+			}
+
+			if output == "name" {
+				for _, r := range results {
+					fmt.Fprintln(cmd.OutOrStdout(), r.GetName())
+				}
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			defer w.Flush()
+			fmt.Fprintln(w, "NAME\tKIND\tTITLE")
+			for _, r := range results {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", r.GetName(), r.GetKind(), r.GetTitle())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&parent, "parent", "", "parent to search (projects/PROJECT/locations/LOCATION)")
+	cmd.Flags().IntVar(&limit, "limit", 20, "maximum number of results to list, or 0 for all results")
+	cmd.Flags().StringVarP(&output, "output", "o", "table", "output type (table|name)")
+	return cmd
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+func TestSearch(t *testing.T) {
+	const (
+		projectID = "search-test"
+		parent    = "projects/" + projectID + "/locations/global"
+		apiName   = parent + "/apis/petstore"
+		specName  = apiName + "/versions/v1/specs/openapi"
+	)
+
+	ctx := context.Background()
+	grpctest.SetupRegistry(ctx, t, projectID, []seeder.RegistryResource{
+		&rpc.Api{
+			Name:        apiName,
+			DisplayName: "Petstore",
+			Description: "A sample API for pets",
+		},
+		&rpc.ApiSpec{
+			Name:     specName,
+			MimeType: "application/x.openapi;version=3.0.0",
+			Contents: []byte("openapi: 3.0.0\npaths:\n  /pets:\n    get:\n      operationId: listPets\n"),
+		},
+	})
+
+	tests := []struct {
+		desc string
+		args []string
+		want []string
+	}{
+		{
+			desc: "names",
+			args: []string{"pets", "--parent", parent, "-o", "name"},
+			want: []string{apiName, specName},
+		},
+		{
+			desc: "query of several arguments",
+			args: []string{"list", "pets", "--parent", parent, "-o", "name"},
+			want: []string{specName},
+		},
+		{
+			desc: "limit",
+			args: []string{"pets", "--parent", parent, "-o", "name", "--limit", "1"},
+			want: []string{apiName},
+		},
+		{
+			desc: "table",
+			args: []string{"petstore", "--parent", parent},
+			want: []string{"NAME KIND TITLE", apiName + " api Petstore"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			cmd.SetArgs(test.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute() with args %v returned error: %s", test.args, err)
+			}
+			got := strings.Split(strings.TrimSpace(out.String()), "\n")
+			for i := range got {
+				got[i] = strings.Join(strings.Fields(got[i]), " ")
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Execute() with args %v returned unexpected output (-want +got):\n%s", test.args, diff)
+			}
+		})
+	}
+}
+
+func TestSearchErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		args []string
+	}{
+		{
+			desc: "missing query",
+			args: []string{"--parent", "projects/search-test/locations/global"},
+		},
+		{
+			desc: "unsupported output",
+			args: []string{"pets", "--parent", "projects/search-test/locations/global", "-o", "yaml"},
+		},
+		{
+			desc: "missing project",
+			args: []string{"pets", "--parent", "projects/missing/locations/global"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cmd := Command()
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs(test.args)
+			if err := cmd.Execute(); err == nil {
+				t.Errorf("Execute() with args %v succeeded and should have failed", test.args)
+			}
+		})
+	}
+}
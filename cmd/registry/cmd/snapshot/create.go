@@ -0,0 +1,63 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+)
+
+func createCommand() *cobra.Command {
+	var project string
+	var description string
+	cmd := &cobra.Command{
+		Use:   "create SNAPSHOT",
+		Short: "Record a snapshot of a project",
+		Long: `Record a snapshot of a project and the resources that it owns.
+
+Example:
+
+	registry snapshot create before-cleanup --description "before removing old versions"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			name, err := snapshotName(args[0], project)
+			if err != nil {
+				return err
+			}
+			client, err := connection.NewAdminClient(ctx)
+			if err != nil {
+				return err
+			}
+			snapshot, err := client.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{
+				Parent:     name.Parent(),
+				SnapshotId: name.SnapshotID,
+				Snapshot:   &rpc.Snapshot{Description: description},
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Created %s with %d resources\n", snapshot.GetName(), snapshot.GetResourceCount())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "project", "", "project to snapshot")
+	cmd.Flags().StringVar(&description, "description", "", "description of the snapshot")
+	return cmd
+}
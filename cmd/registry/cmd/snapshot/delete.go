@@ -0,0 +1,53 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+)
+
+func deleteCommand() *cobra.Command {
+	var project string
+	cmd := &cobra.Command{
+		Use:   "delete SNAPSHOT",
+		Short: "Delete a snapshot",
+		Long: `Delete a snapshot. Contents that the snapshot shares with its project or
+with other snapshots are kept.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			name, err := snapshotName(args[0], project)
+			if err != nil {
+				return err
+			}
+			client, err := connection.NewAdminClient(ctx)
+			if err != nil {
+				return err
+			}
+			if err := client.DeleteSnapshot(ctx, &rpc.DeleteSnapshotRequest{Name: name.String()}); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Deleted %s\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "project", "", "project of the snapshot")
+	return cmd
+}
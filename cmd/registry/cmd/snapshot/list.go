@@ -0,0 +1,87 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func listCommand() *cobra.Command {
+	var project string
+	var output string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the snapshots of a project",
+		Long:  "List the snapshots of a project, oldest first.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if output != "table" && output != "json" {
+				return fmt.Errorf("unsupported output type %q", output)
+			}
+			parent, err := projectName(project)
+			if err != nil {
+				return err
+			}
+			client, err := connection.NewAdminClient(ctx)
+			if err != nil {
+				return err
+			}
+
+			it := client.ListSnapshots(ctx, &rpc.ListSnapshotsRequest{Parent: parent.String()})
+			var snapshots []*rpc.Snapshot
+			for {
+				s, err := it.Next()
+				if err == iterator.Done {
+					break
+				} else if err != nil {
+					return err
+				}
+				snapshots = append(snapshots, s)
+			}
+
+			if output == "json" {
+				for _, s := range snapshots {
+					b, err := protojson.Marshal(s)
+					if err != nil {
+						return err
+					}
+					fmt.Fprintln(cmd.OutOrStdout(), string(b))
+				}
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			defer w.Flush()
+			fmt.Fprintln(w, "NAME\tCREATED\tRESOURCES\tDESCRIPTION")
+			for _, s := range snapshots {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\n",
+					s.GetName(), s.GetCreateTime().AsTime().Format(time.RFC3339), s.GetResourceCount(), s.GetDescription())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "project", "", "project to list snapshots for")
+	cmd.Flags().StringVarP(&output, "output", "o", "table", "output type (table|json)")
+	return cmd
+}
@@ -0,0 +1,74 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+)
+
+func restoreCommand() *cobra.Command {
+	var project string
+	cmd := &cobra.Command{
+		Use:   "restore SNAPSHOT",
+		Short: "Restore a project from a snapshot",
+		Long: `Restore a project from a snapshot.
+
+The project and the resources that it owns are replaced with the copies in
+the snapshot in a single transaction. Resources that were created since the
+snapshot are deleted, including any in the trash. The project is created if
+it doesn't exist. Each changed resource is listed with the kind of change.
+
+Example:
+
+	registry snapshot restore projects/demo/snapshots/before-cleanup`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			name, err := snapshotName(args[0], project)
+			if err != nil {
+				return err
+			}
+			client, err := connection.NewAdminClient(ctx)
+			if err != nil {
+				return err
+			}
+			response, err := client.RestoreSnapshot(ctx, &rpc.RestoreSnapshotRequest{Name: name.String()})
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			for _, r := range response.GetDeleted() {
+				fmt.Fprintf(out, "deleted %s\n", r)
+			}
+			for _, r := range response.GetCreated() {
+				fmt.Fprintf(out, "created %s\n", r)
+			}
+			for _, r := range response.GetUpdated() {
+				fmt.Fprintf(out, "updated %s\n", r)
+			}
+			fmt.Fprintf(out, "Restored %s from %s: %d created, %d updated, %d deleted\n",
+				response.GetProject().GetName(), name,
+				len(response.GetCreated()), len(response.GetUpdated()), len(response.GetDeleted()))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "project", "", "project of the snapshot")
+	return cmd
+}
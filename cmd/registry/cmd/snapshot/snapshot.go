@@ -0,0 +1,77 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/spf13/cobra"
+)
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create, list and restore snapshots of projects",
+		Long: `Create, list and restore snapshots of projects.
+
+A snapshot is a named, immutable copy of a project and the resources that it
+owns. Snapshots share the contents of specs and artifacts with the project,
+so they are inexpensive to create. Take a snapshot before a risky change and
+restore it to undo the change. Snapshots are kept when their project is
+deleted, so a deleted project can be restored from a snapshot.
+
+Snapshots are named by IDs in the project in the configuration unless
+--project is specified, or by full names like projects/demo/snapshots/before.`,
+	}
+
+	cmd.AddCommand(createCommand())
+	cmd.AddCommand(listCommand())
+	cmd.AddCommand(restoreCommand())
+	cmd.AddCommand(deleteCommand())
+	return cmd
+}
+
+// projectName returns the project named by the --project flag or, if it is
+// not set, the project in the active configuration.
+func projectName(project string) (names.Project, error) {
+	if project == "" {
+		c, err := connection.ActiveConfig()
+		if err != nil {
+			return names.Project{}, err
+		}
+		if c.Project == "" {
+			return names.Project{}, fmt.Errorf("unable to identify project: please use --project or set registry.project in configuration")
+		}
+		project = c.Project
+	}
+	return names.ParseProject("projects/" + strings.TrimPrefix(project, "projects/"))
+}
+
+// snapshotName returns the name of a snapshot given either as a full name
+// or as an ID in a project.
+func snapshotName(arg, project string) (names.Snapshot, error) {
+	if strings.HasPrefix(arg, "projects/") {
+		return names.ParseSnapshot(arg)
+	}
+	p, err := projectName(project)
+	if err != nil {
+		return names.Snapshot{}, err
+	}
+	name := names.Snapshot{ProjectID: p.ProjectID, SnapshotID: arg}
+	return name, name.Validate()
+}
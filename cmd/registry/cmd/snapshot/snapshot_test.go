@@ -0,0 +1,102 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestMain will set up a local RegistryServer and grpc.Server for all
+// tests in this package if REGISTRY_ADDRESS env var is not set
+// for the client.
+func TestMain(m *testing.M) {
+	grpctest.TestMain(m, registry.Config{})
+}
+
+func run(t *testing.T, args ...string) string {
+	t.Helper()
+	cmd := Command()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() with args %v returned error: %s", args, err)
+	}
+	return out.String()
+}
+
+func TestSnapshot(t *testing.T) {
+	const (
+		projectID = "snapshot-test"
+		apiName   = "projects/" + projectID + "/locations/global/apis/petstore"
+		snapshot  = "projects/" + projectID + "/snapshots/before"
+	)
+
+	ctx := context.Background()
+	client, _ := grpctest.SetupRegistry(ctx, t, projectID, []seeder.RegistryResource{
+		&rpc.Api{Name: apiName},
+	})
+
+	if got := run(t, "create", "before", "--project", projectID, "--description", "before changes"); !strings.Contains(got, "Created "+snapshot+" with 2 resources") {
+		t.Errorf("create returned %q", got)
+	}
+	got := run(t, "list", "--project", projectID)
+	if lines := strings.Split(strings.TrimSpace(got), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[1], snapshot) || !strings.HasSuffix(lines[1], "before changes") {
+		t.Errorf("list returned %q", got)
+	}
+
+	if err := client.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: apiName}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	got = run(t, "restore", snapshot)
+	if !strings.Contains(got, "created "+apiName+"\n") || !strings.Contains(got, "1 created, 0 updated, 0 deleted") {
+		t.Errorf("restore returned %q", got)
+	}
+	if _, err := client.GetApi(ctx, &rpc.GetApiRequest{Name: apiName}); err != nil {
+		t.Errorf("GetApi() returned error after restore: %s", err)
+	}
+
+	run(t, "delete", "before", "--project", projectID)
+	cmd := Command()
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"restore", snapshot})
+	if err := cmd.Execute(); status.Code(err) != codes.NotFound {
+		t.Errorf("restore of a deleted snapshot returned %v, want NotFound", err)
+	}
+}
+
+func TestSnapshotName(t *testing.T) {
+	for _, arg := range []string{"projects/p", "projects/p/snapshots/Bad!", "Bad!"} {
+		if _, err := snapshotName(arg, "p"); err == nil {
+			t.Errorf("snapshotName(%q) succeeded and should have failed", arg)
+		}
+	}
+	name, err := snapshotName("s", "projects/p")
+	if err != nil {
+		t.Fatalf("snapshotName() returned error: %s", err)
+	} else if name.String() != "projects/p/snapshots/s" {
+		t.Errorf("snapshotName() returned %s, want projects/p/snapshots/s", name)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/cmd/registry/compress"
 	"github.com/apigee/registry/cmd/registry/tasks"
 	"github.com/apigee/registry/pkg/connection"
@@ -47,7 +48,7 @@ func csvCommand() *cobra.Command {
 		Use:   "csv FILE",
 		Short: "Upload API descriptions from a CSV file",
 		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(delimiter) != 1 {
 				return fmt.Errorf("invalid delimiter %q: must be exactly one character", delimiter)
 			}
@@ -68,8 +69,16 @@ func csvCommand() *cobra.Command {
 				return fmt.Errorf("parent does not exist (%s)", err)
 			}
 
+			// specs are created in batches after their APIs and versions.
+			specs := batch.NewSpecs(client, parent)
 			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
-			defer wait()
+			defer func() {
+				wait()
+				// Create the specs that didn't fill a batch.
+				if flushErr := specs.Flush(ctx); err == nil {
+					err = flushErr
+				}
+			}()
 
 			file, err := os.Open(args[0])
 			if err != nil {
@@ -89,6 +98,7 @@ func csvCommand() *cobra.Command {
 
 				taskQueue <- &uploadSpecTask{
 					client:     client,
+					specs:      specs,
 					parentName: parentName,
 					apiID:      row.ApiID,
 					versionID:  row.VersionID,
@@ -181,6 +191,7 @@ func (r *uploadCSVReader) buildColumnIndex(header []string) error {
 
 type uploadSpecTask struct {
 	client     connection.RegistryClient
+	specs      *batch.Specs
 	parentName names.Project
 	apiID      string
 	versionID  string
@@ -243,22 +254,16 @@ func (t uploadSpecTask) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Specs that already exist are skipped when the batch is created.
 	specName := versionName.Spec(t.specID)
-	spec, err := t.client.CreateApiSpec(ctx, &rpc.CreateApiSpecRequest{
+	if err := t.specs.Add(ctx, &rpc.CreateApiSpecRequest{
 		Parent:    specName.Parent(),
 		ApiSpecId: specName.SpecID,
 		ApiSpec: &rpc.ApiSpec{
 			MimeType: oasMimeType,
 			Contents: compressed,
 		},
-	})
-
-	switch status.Code(err) {
-	case codes.OK:
-		log.Debugf(ctx, "Created API spec: %s", spec.GetName())
-	case codes.AlreadyExists:
-		// When the spec already exists we can silently continue.
-	default:
+	}); err != nil {
 		return fmt.Errorf("failed to upload API spec: %s", err)
 	}
 
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/cmd/registry/compress"
 	"github.com/apigee/registry/cmd/registry/tasks"
 	"github.com/apigee/registry/pkg/connection"
@@ -27,8 +28,6 @@ import (
 	"github.com/apigee/registry/pkg/visitor"
 	"github.com/apigee/registry/rpc"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v3"
 
 	"github.com/google/gnostic/compiler"
@@ -54,7 +53,7 @@ func discoveryCommand() *cobra.Command {
 		Use:   "discovery",
 		Args:  cobra.NoArgs,
 		Short: "Upload API Discovery documents from the Google API Discovery service",
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			ctx := cmd.Context()
 			parent, err := getParent(cmd)
 			if err != nil {
@@ -67,9 +66,17 @@ func discoveryCommand() *cobra.Command {
 			if err := visitor.VerifyLocation(ctx, client, parent); err != nil {
 				return fmt.Errorf("parent does not exist (%s)", err)
 			}
+			// the tasks add their changes to a shared batch of mutations.
+			b := batch.NewMutations(client, parent)
 			// create a queue for upload tasks and wait for the workers to finish after filling it.
 			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
-			defer wait()
+			defer func() {
+				wait()
+				// Apply the changes that didn't fill a batch.
+				if flushErr := b.Flush(ctx); err == nil {
+					err = flushErr
+				}
+			}()
 
 			discoveryResponse, err := fetchDiscoveryList(service)
 			if err != nil {
@@ -80,6 +87,7 @@ func discoveryCommand() *cobra.Command {
 			for _, api := range discoveryResponse.APIs {
 				taskQueue <- &uploadDiscoveryTask{
 					client:    client,
+					batch:     b,
 					path:      api.DiscoveryRestURL,
 					parent:    parent,
 					apiID:     sanitize(api.Name),
@@ -100,6 +108,7 @@ func discoveryCommand() *cobra.Command {
 
 type uploadDiscoveryTask struct {
 	client    connection.RegistryClient
+	batch     *batch.Mutations
 	path      string
 	parent    string
 	apiID     string
@@ -121,64 +130,49 @@ func (task *uploadDiscoveryTask) Run(ctx context.Context) error {
 		log.FromContext(ctx).WithError(err).Error("Failed to download discovery doc")
 		return nil
 	}
-	// If the API does not exist, create it.
-	if err := task.createAPI(ctx); err != nil {
+	// Create or update the API, version and spec together.
+	mutations := []*rpc.Mutation{task.apiMutation(), task.versionMutation()}
+	spec, err := task.specMutation(ctx)
+	if err != nil {
 		return err
 	}
-	// If the API version does not exist, create it.
-	if err := task.createVersion(ctx); err != nil {
-		return err
+	if spec != nil {
+		mutations = append(mutations, spec)
 	}
-	// Create or update the spec as needed.
-	if err := task.createOrUpdateSpec(ctx); err != nil {
-		return err
+	if err := task.batch.Add(ctx, mutations...); err != nil {
+		log.FromContext(ctx).WithError(err).Debugf("Failed to upload %s", task.specName())
+		// Returning this error ends all tasks, which seems appropriate to
+		// handle situations where all might fail due to a common problem
+		// (a missing project or incorrect project-id).
+		return fmt.Errorf("failed to upload %s, %s", task.specName(), err)
 	}
 	return nil
 }
 
-func (task *uploadDiscoveryTask) createAPI(ctx context.Context) error {
-	// Create an API if needed (or update an existing one)
-	response, err := task.client.UpdateApi(ctx, &rpc.UpdateApiRequest{
+// apiMutation creates the API if needed (or updates an existing one).
+func (task *uploadDiscoveryTask) apiMutation() *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApi{UpdateApi: &rpc.UpdateApiRequest{
 		Api: &rpc.Api{
 			Name:        task.apiName(),
 			DisplayName: task.info.Title,
 			Description: task.info.Description,
 		},
 		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else if status.Code(err) == codes.AlreadyExists {
-		log.Debugf(ctx, "Found %s", task.apiName())
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create API %s", task.apiName())
-		// Returning this error ends all tasks, which seems appropriate to
-		// handle situations where all might fail due to a common problem
-		// (a missing project or incorrect project-id).
-		return fmt.Errorf("failed to create %s, %s", task.apiName(), err)
-	}
-
-	return nil
+	}}}
 }
 
-func (task *uploadDiscoveryTask) createVersion(ctx context.Context) error {
-	// Create an API version if needed (or update an existing one)
-	response, err := task.client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+// versionMutation creates the API version if needed (or updates an existing one).
+func (task *uploadDiscoveryTask) versionMutation() *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiVersion{UpdateApiVersion: &rpc.UpdateApiVersionRequest{
 		ApiVersion: &rpc.ApiVersion{
 			Name: task.versionName(),
 		},
 		AllowMissing: true,
-	})
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create version %s", task.versionName())
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
-
-	return nil
+	}}}
 }
 
-func (task *uploadDiscoveryTask) createOrUpdateSpec(ctx context.Context) error {
+// specMutation creates or updates the spec, or returns nil if it is unchanged.
+func (task *uploadDiscoveryTask) specMutation(ctx context.Context) (*rpc.Mutation, error) {
 	// Use the spec size and hash to avoid unnecessary uploads.
 	spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{
 		Name: task.specName(),
@@ -186,12 +180,12 @@ func (task *uploadDiscoveryTask) createOrUpdateSpec(ctx context.Context) error {
 
 	if err == nil && int(spec.GetSizeBytes()) == len(task.contents) && spec.GetHash() == hashForBytes(task.contents) {
 		log.Debugf(ctx, "Matched already uploaded spec %s", task.specName())
-		return nil
+		return nil, nil
 	}
 
 	gzippedContents, err := compress.GZippedBytes(task.contents)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	request := &rpc.UpdateApiSpecRequest{
@@ -205,14 +199,7 @@ func (task *uploadDiscoveryTask) createOrUpdateSpec(ctx context.Context) error {
 		AllowMissing: true,
 	}
 
-	response, err := task.client.UpdateApiSpec(ctx, request)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Debugf("Error %s [contents-length: %d]", task.specName(), len(task.contents))
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
-
-	return nil
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiSpec{UpdateApiSpec: request}}, nil
 }
 
 func (task *uploadDiscoveryTask) apiName() string {
@@ -23,6 +23,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/cmd/registry/compress"
 	"github.com/apigee/registry/cmd/registry/tasks"
 	"github.com/apigee/registry/pkg/connection"
@@ -31,8 +32,6 @@ import (
 	"github.com/apigee/registry/pkg/visitor"
 	"github.com/apigee/registry/rpc"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v3"
 )
 
@@ -45,7 +44,7 @@ func openAPICommand() *cobra.Command {
 		Use:   "openapi DIRECTORY",
 		Short: "Upload OpenAPI descriptions from a directory of specs",
 		Args:  cobra.MinimumNArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			ctx := cmd.Context()
 			parent, err := getParent(cmd)
 			if err != nil {
@@ -58,16 +57,24 @@ func openAPICommand() *cobra.Command {
 			if err := visitor.VerifyLocation(ctx, client, parent); err != nil {
 				return fmt.Errorf("parent does not exist (%s)", err)
 			}
+			// the tasks add their changes to a shared batch of mutations.
+			b := batch.NewMutations(client, parent)
 			// create a queue for upload tasks and wait for the workers to finish after filling it.
 			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
-			defer wait()
+			defer func() {
+				wait()
+				// Apply the changes that didn't fill a batch.
+				if flushErr := b.Flush(ctx); err == nil {
+					err = flushErr
+				}
+			}()
 
 			for _, arg := range args {
 				path, err := filepath.Abs(arg)
 				if err != nil {
 					return fmt.Errorf("invalid path: %s", err)
 				}
-				scanDirectoryForOpenAPI(ctx, client, parent, baseURI, path, taskQueue)
+				scanDirectoryForOpenAPI(ctx, client, b, parent, baseURI, path, taskQueue)
 			}
 			return nil
 		},
@@ -80,7 +87,7 @@ func openAPICommand() *cobra.Command {
 	return cmd
 }
 
-func scanDirectoryForOpenAPI(ctx context.Context, client connection.RegistryClient, parent, baseURI, directory string, taskQueue chan<- tasks.Task) {
+func scanDirectoryForOpenAPI(ctx context.Context, client connection.RegistryClient, b *batch.Mutations, parent, baseURI, directory string, taskQueue chan<- tasks.Task) {
 	// walk a directory hierarchy, uploading every API spec that matches a set of expected file names.
 	if err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -89,6 +96,7 @@ func scanDirectoryForOpenAPI(ctx context.Context, client connection.RegistryClie
 
 		task := &uploadOpenAPITask{
 			client:    client,
+			batch:     b,
 			parent:    parent,
 			baseURI:   baseURI,
 			path:      path,
@@ -127,6 +135,7 @@ func sanitize(name string) string {
 
 type uploadOpenAPITask struct {
 	client    connection.RegistryClient
+	batch     *batch.Mutations
 	baseURI   string
 	path      string
 	directory string
@@ -150,17 +159,21 @@ func (task *uploadOpenAPITask) Run(ctx context.Context) error {
 	}
 	log.Infof(ctx, "Uploading apis/%s/versions/%s/specs/%s", task.apiID, task.versionID, openAPISpecID)
 
-	// If the API does not exist, create it.
-	if err := task.createAPI(ctx); err != nil {
+	// Create or update the API, version and spec together.
+	mutations := []*rpc.Mutation{task.apiMutation(), task.versionMutation()}
+	spec, err := task.specMutation(ctx)
+	if err != nil {
 		return err
 	}
-	// If the API version does not exist, create it.
-	if err := task.createVersion(ctx); err != nil {
-		return err
+	if spec != nil {
+		mutations = append(mutations, spec)
 	}
-	// Create or update the spec as needed.
-	if err := task.createOrUpdateSpec(ctx); err != nil {
-		return err
+	if err := task.batch.Add(ctx, mutations...); err != nil {
+		log.FromContext(ctx).WithError(err).Debugf("Failed to upload %s", task.specName())
+		// Returning this error ends all tasks, which seems appropriate to
+		// handle situations where all might fail due to a common problem
+		// (a missing project or incorrect project-id).
+		return fmt.Errorf("failed to upload %s, %s", task.specName(), err)
 	}
 	return nil
 }
@@ -186,49 +199,30 @@ func (task *uploadOpenAPITask) populateFields() error {
 	return yaml.Unmarshal(task.contents, &(task.document))
 }
 
-func (task *uploadOpenAPITask) createAPI(ctx context.Context) error {
-	// Create an API if needed (or update an existing one)
-	response, err := task.client.UpdateApi(ctx, &rpc.UpdateApiRequest{
+// apiMutation creates the API if needed (or updates an existing one).
+func (task *uploadOpenAPITask) apiMutation() *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApi{UpdateApi: &rpc.UpdateApiRequest{
 		Api: &rpc.Api{
 			Name:        task.apiName(),
 			DisplayName: task.apiID,
 			Description: task.document.Info.Title,
 		},
 		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else if status.Code(err) == codes.AlreadyExists {
-		log.Debugf(ctx, "Found %s", task.apiName())
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create API %s", task.apiName())
-		// Returning this error ends all tasks, which seems appropriate to
-		// handle situations where all might fail due to a common problem
-		// (a missing project or incorrect project-id).
-		return fmt.Errorf("failed to create %s, %s", task.apiName(), err)
-	}
-
-	return nil
+	}}}
 }
 
-func (task *uploadOpenAPITask) createVersion(ctx context.Context) error {
-	// Create an API version if needed (or update an existing one)
-	response, err := task.client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+// versionMutation creates the API version if needed (or updates an existing one).
+func (task *uploadOpenAPITask) versionMutation() *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiVersion{UpdateApiVersion: &rpc.UpdateApiVersionRequest{
 		ApiVersion: &rpc.ApiVersion{
 			Name: task.versionName(),
 		},
 		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create version %s", task.versionName())
-	}
-
-	return nil
+	}}}
 }
 
-func (task *uploadOpenAPITask) createOrUpdateSpec(ctx context.Context) error {
+// specMutation creates or updates the spec, or returns nil if it is unchanged.
+func (task *uploadOpenAPITask) specMutation(ctx context.Context) (*rpc.Mutation, error) {
 	// Use the spec size and hash to avoid unnecessary uploads.
 	spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{
 		Name: task.specName(),
@@ -236,12 +230,12 @@ func (task *uploadOpenAPITask) createOrUpdateSpec(ctx context.Context) error {
 
 	if err == nil && int(spec.GetSizeBytes()) == len(task.contents) && spec.GetHash() == hashForBytes(task.contents) {
 		log.Debugf(ctx, "Matched already uploaded spec %s", task.specName())
-		return nil
+		return nil, nil
 	}
 
 	gzippedContents, err := compress.GZippedBytes(task.contents)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	request := &rpc.UpdateApiSpecRequest{
@@ -257,14 +251,7 @@ func (task *uploadOpenAPITask) createOrUpdateSpec(ctx context.Context) error {
 		request.ApiSpec.SourceUri = fmt.Sprintf("%s/%s", task.baseURI, task.apiPath())
 	}
 
-	response, err := task.client.UpdateApiSpec(ctx, request)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Debugf("Error %s [contents-length: %d]", task.specName(), len(task.contents))
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
-
-	return nil
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiSpec{UpdateApiSpec: request}}, nil
 }
 
 func (task *uploadOpenAPITask) apiName() string {
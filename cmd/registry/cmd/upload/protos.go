@@ -26,6 +26,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/cmd/registry/compress"
 	"github.com/apigee/registry/cmd/registry/tasks"
 	"github.com/apigee/registry/pkg/connection"
@@ -34,8 +35,6 @@ import (
 	"github.com/apigee/registry/pkg/visitor"
 	"github.com/apigee/registry/rpc"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"gopkg.in/yaml.v3"
@@ -62,7 +61,7 @@ func protosCommand() *cobra.Command {
 		Use:   "protos DIRECTORY",
 		Short: "Upload Protocol Buffer descriptions from a directory of specs",
 		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			ctx := cmd.Context()
 			parent, err := getParent(cmd)
 			if err != nil {
@@ -75,9 +74,17 @@ func protosCommand() *cobra.Command {
 			if err := visitor.VerifyLocation(ctx, client, parent); err != nil {
 				return fmt.Errorf("parent does not exist (%s)", err)
 			}
+			// the tasks add their changes to a shared batch of mutations.
+			b := batch.NewMutations(client, parent)
 			// create a queue for upload tasks and wait for the workers to finish after filling it.
 			taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
-			defer wait()
+			defer func() {
+				wait()
+				// Apply the changes that didn't fill a batch.
+				if flushErr := b.Flush(ctx); err == nil {
+					err = flushErr
+				}
+			}()
 
 			for _, arg := range args {
 				path, err := filepath.Abs(arg)
@@ -93,7 +100,7 @@ func protosCommand() *cobra.Command {
 					return fmt.Errorf("invalid path: %s", err)
 				}
 
-				if err := scanDirectoryForProtos(client, parent, baseURI, path, root, taskQueue); err != nil {
+				if err := scanDirectoryForProtos(client, b, parent, baseURI, path, root, taskQueue); err != nil {
 					log.FromContext(ctx).WithError(err).Debug("Failed to walk directory")
 				}
 			}
@@ -109,7 +116,7 @@ func protosCommand() *cobra.Command {
 	return cmd
 }
 
-func scanDirectoryForProtos(client connection.RegistryClient, parent, baseURI, start, root string, taskQueue chan<- tasks.Task) error {
+func scanDirectoryForProtos(client connection.RegistryClient, b *batch.Mutations, parent, baseURI, start, root string, taskQueue chan<- tasks.Task) error {
 	return filepath.Walk(start, func(filepath string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -138,6 +145,7 @@ func scanDirectoryForProtos(client connection.RegistryClient, parent, baseURI, s
 
 		taskQueue <- &uploadProtoTask{
 			client:         client,
+			batch:          b,
 			baseURI:        baseURI,
 			parent:         parent,
 			apiID:          strings.TrimSuffix(sc.Name, ".googleapis.com"),
@@ -154,6 +162,7 @@ func scanDirectoryForProtos(client connection.RegistryClient, parent, baseURI, s
 
 type uploadProtoTask struct {
 	client         connection.RegistryClient
+	batch          *batch.Mutations
 	baseURI        string
 	parent         string
 	path           string
@@ -180,17 +189,21 @@ func (task *uploadProtoTask) Run(ctx context.Context) error {
 	if task.contents, err = task.zipContents(); err != nil {
 		return err
 	}
-	// If the API does not exist, create it.
-	if err := task.createAPI(ctx); err != nil {
+	// Create or update the API, version and spec together.
+	mutations := []*rpc.Mutation{task.apiMutation(), task.versionMutation()}
+	spec, err := task.specMutation(ctx)
+	if err != nil {
 		return err
 	}
-	// If the API version does not exist, create it.
-	if err := task.createVersion(ctx); err != nil {
-		return err
+	if spec != nil {
+		mutations = append(mutations, spec)
 	}
-	// Create or update the spec as needed.
-	if err := task.createOrUpdateSpec(ctx); err != nil {
-		return err
+	if err := task.batch.Add(ctx, mutations...); err != nil {
+		log.FromContext(ctx).WithError(err).Debugf("Failed to upload %s", task.specName())
+		// Returning this error ends all tasks, which seems appropriate to
+		// handle situations where all might fail due to a common problem
+		// (a missing project or incorrect project-id).
+		return fmt.Errorf("failed to upload %s, %s", task.specName(), err)
 	}
 	return nil
 }
@@ -205,49 +218,30 @@ func (task *uploadProtoTask) populateFields() {
 	task.specID = sanitize(specPart)
 }
 
-func (task *uploadProtoTask) createAPI(ctx context.Context) error {
-	// Create an API if needed (or update an existing one)
-	response, err := task.client.UpdateApi(ctx, &rpc.UpdateApiRequest{
+// apiMutation creates the API if needed (or updates an existing one).
+func (task *uploadProtoTask) apiMutation() *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApi{UpdateApi: &rpc.UpdateApiRequest{
 		Api: &rpc.Api{
 			Name:        task.apiName(),
 			DisplayName: task.apiTitle,
 			Description: task.apiDescription,
 		},
 		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else if status.Code(err) == codes.AlreadyExists {
-		log.Debugf(ctx, "Found %s", task.apiName())
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create API %s", task.apiName())
-		// Returning this error ends all tasks, which seems appropriate to
-		// handle situations where all might fail due to a common problem
-		// (a missing project or incorrect project-id).
-		return fmt.Errorf("failed to create %s, %s", task.apiName(), err)
-	}
-
-	return nil
+	}}}
 }
 
-func (task *uploadProtoTask) createVersion(ctx context.Context) error {
-	// Create an API version if needed (or update an existing one)
-	response, err := task.client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+// versionMutation creates the API version if needed (or updates an existing one).
+func (task *uploadProtoTask) versionMutation() *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiVersion{UpdateApiVersion: &rpc.UpdateApiVersionRequest{
 		ApiVersion: &rpc.ApiVersion{
 			Name: task.versionName(),
 		},
 		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create version %s", task.versionName())
-	}
-
-	return nil
+	}}}
 }
 
-func (task *uploadProtoTask) createOrUpdateSpec(ctx context.Context) error {
+// specMutation creates or updates the spec, or returns nil if it is unchanged.
+func (task *uploadProtoTask) specMutation(ctx context.Context) (*rpc.Mutation, error) {
 	// Use the spec size and hash to avoid unnecessary uploads.
 	spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{
 		Name: task.specName(),
@@ -255,7 +249,7 @@ func (task *uploadProtoTask) createOrUpdateSpec(ctx context.Context) error {
 
 	if err == nil && int(spec.GetSizeBytes()) == len(task.contents) && spec.GetHash() == hashForBytes(task.contents) {
 		log.Debugf(ctx, "Matched already uploaded spec %s", task.specName())
-		return nil
+		return nil, nil
 	}
 
 	request := &rpc.UpdateApiSpecRequest{
@@ -271,14 +265,7 @@ func (task *uploadProtoTask) createOrUpdateSpec(ctx context.Context) error {
 		request.ApiSpec.SourceUri = fmt.Sprintf("%s/%s", task.baseURI, task.apiPath())
 	}
 
-	response, err := task.client.UpdateApiSpec(ctx, request)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Error %s [contents-length: %d]", task.specName(), len(task.contents))
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
-
-	return nil
+	return &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiSpec{UpdateApiSpec: request}}, nil
 }
 
 func (task *uploadProtoTask) apiName() string {
@@ -333,7 +333,7 @@ func TestTimestampArtifacts(t *testing.T) {
 			}
 			actions := ProcessManifest(ctx, client, projectID, manifest, 10)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -476,7 +476,7 @@ func TestTimestampAggregateArtifacts(t *testing.T) {
 			}
 			actions := ProcessManifest(ctx, client, projectID, manifest, 10)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -658,7 +658,7 @@ func TestTimestampDerivedArtifacts(t *testing.T) {
 			}
 			actions := ProcessManifest(ctx, client, projectID, manifest, 10)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -828,7 +828,7 @@ func TestRefreshArtifacts(t *testing.T) {
 			}
 			actions := ProcessManifest(ctx, client, projectID, manifest, 10)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
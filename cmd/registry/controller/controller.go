@@ -29,6 +29,16 @@ type Action struct {
 	Command           string
 	GeneratedResource string
 	RequiresReceipt   bool
+	// Inputs are the names (including revisions) of the dependencies of the
+	// generated resource.
+	Inputs []string
+}
+
+// dependencyGroup holds the resources that match a dependency of a target
+// resource.
+type dependencyGroup struct {
+	updated   time.Time // latest update time of the resources
+	resources []string  // names of the resources, including revisions
 }
 
 func ProcessManifest(
@@ -42,18 +52,24 @@ func ProcessManifest(
 	errs := ValidateManifest(fmt.Sprintf("projects/%s/locations/global", projectID), manifest)
 	if len(errs) > 0 {
 		for _, err := range errs {
-			log.FromContext(ctx).WithError(err).Debugf("Error in manifest")
+			log.FromContext(ctx).WithError(err).Warnf("Error in manifest")
 		}
 	}
 
-	for _, resource := range manifest.GeneratedResources {
-		log.Debugf(ctx, "Processing entry: %v", resource)
-
-		errs := validateGeneratedResourceEntry(fmt.Sprintf("projects/%s/locations/global", projectID), resource)
-		if len(errs) > 0 {
-			log.FromContext(ctx).Debugf("Skipping resource: %q", resource)
-			continue
+	// Process entries after the entries that generate their dependencies.
+	// Invalid entries and entries in dependency cycles are skipped.
+	var ordered []*controller.GeneratedResource
+	for _, stage := range orderEntries(fmt.Sprintf("projects/%s/locations/global", projectID), manifest.GeneratedResources).stages {
+		for _, i := range stage {
+			ordered = append(ordered, manifest.GeneratedResources[i])
 		}
+	}
+	if n := len(manifest.GeneratedResources) - len(ordered); n > 0 {
+		log.FromContext(ctx).Warnf("Skipping %d entries that are invalid or in dependency cycles", n)
+	}
+
+	for _, resource := range ordered {
+		log.Debugf(ctx, "Processing entry: %v", resource)
 
 		newActions, err := processManifestResource(ctx, client, projectID, resource)
 		if err != nil {
@@ -81,9 +97,20 @@ func processManifestResource(
 	client listingClient,
 	projectID string,
 	generatedResource *controller.GeneratedResource) ([]*Action, error) {
+	return planManifestResource(ctx, client, projectID, generatedResource, nil)
+}
+
+// planManifestResource generates the actions for an entry of a manifest and,
+// if plan is not nil, records why each target resource needs an action or not.
+func planManifestResource(
+	ctx context.Context,
+	client listingClient,
+	projectID string,
+	generatedResource *controller.GeneratedResource,
+	plan *PlanEntry) ([]*Action, error) {
 	resourcePattern := fmt.Sprintf("projects/%s/locations/global/%s", projectID, generatedResource.Pattern)
 	// Generate dependency map
-	dependencyMaps := make([]map[string]time.Time, 0, len(generatedResource.Dependencies))
+	dependencyMaps := make([]map[string]*dependencyGroup, 0, len(generatedResource.Dependencies))
 	for _, dependency := range generatedResource.Dependencies {
 		dMap, err := generateDependencyMap(ctx, client, resourcePattern, dependency)
 		if err != nil {
@@ -94,7 +121,7 @@ func processManifestResource(
 
 	// Generate actions to create and update target resources
 	actions := generateActions(
-		ctx, client, resourcePattern, generatedResource.Filter, dependencyMaps, generatedResource)
+		ctx, client, resourcePattern, generatedResource.Filter, dependencyMaps, generatedResource, plan)
 
 	return actions, nil
 }
@@ -103,18 +130,18 @@ func generateDependencyMap(
 	ctx context.Context,
 	client listingClient,
 	resourcePattern string,
-	dependency *controller.Dependency) (map[string]time.Time, error) {
+	dependency *controller.Dependency) (map[string]*dependencyGroup, error) {
 	// Creates a map of the resources to group them into corresponding buckets
 	// of match pattern which store the maxTimestamp
 	// An example entry will look like this:
 	// dependencyPattern: $resource.api/versions/-/specs/-   ($resource.api is the match)
 	// Map:
 	// - key: projects/demo/locations/global/apis/petstore
-	//   value: maxUpdateTime: 00:00:00
+	//   value: maxUpdateTime: 00:00:00, resources: [...]
 	// - key: projects/demo/locations/global/apis/wordnik.com
-	//   value: maxUpdateTime: 00:00:00
+	//   value: maxUpdateTime: 00:00:00, resources: [...]
 
-	sourceMap := make(map[string]time.Time)
+	sourceMap := make(map[string]*dependencyGroup)
 
 	resourceName, err := patterns.ParseResourcePattern(resourcePattern)
 	if err != nil {
@@ -140,10 +167,14 @@ func generateDependencyMap(
 		}
 
 		sourceTime := source.UpdateTimestamp()
-		maxUpdateTime, exists := sourceMap[group]
-		if !exists || maxUpdateTime.Before(sourceTime) {
-			sourceMap[group] = sourceTime
+		g, exists := sourceMap[group]
+		if !exists {
+			g = &dependencyGroup{updated: sourceTime}
+			sourceMap[group] = g
+		} else if g.updated.Before(sourceTime) {
+			g.updated = sourceTime
 		}
+		g.resources = append(g.resources, source.ResourceName().String())
 	}
 
 	if len(sourceMap) == 0 {
@@ -158,17 +189,23 @@ func generateActions(
 	client listingClient,
 	resourcePattern string,
 	filter string,
-	dependencyMaps []map[string]time.Time,
-	generatedResource *controller.GeneratedResource) []*Action {
+	dependencyMaps []map[string]*dependencyGroup,
+	generatedResource *controller.GeneratedResource,
+	plan *PlanEntry) []*Action {
 	actions := make([]*Action, 0)
 
-	updateActions, visited, err := generateUpdateActions(ctx, client, resourcePattern, filter, dependencyMaps, generatedResource)
+	receipts, err := listReceipts(ctx, client, resourcePattern, generatedResource.Receipt)
+	if err != nil {
+		log.Errorf(ctx, "Error while listing receipts: %s", err)
+	}
+
+	updateActions, visited, err := generateUpdateActions(ctx, client, resourcePattern, filter, dependencyMaps, generatedResource, receipts, plan)
 	if err != nil {
 		log.Errorf(ctx, "Error while generating UpdateActions: %s", err)
 	}
 	actions = append(actions, updateActions...)
 
-	createActions, err := generateCreateActions(ctx, client, resourcePattern, dependencyMaps, generatedResource, visited)
+	createActions, err := generateCreateActions(ctx, client, resourcePattern, dependencyMaps, generatedResource, receipts, visited, plan)
 	if err != nil {
 		log.Errorf(ctx, "Error while generating CreateActions: %s", err)
 	}
@@ -183,8 +220,10 @@ func generateUpdateActions(
 	client listingClient,
 	resourcePattern string,
 	filter string,
-	dependencyMaps []map[string]time.Time,
-	generatedResource *controller.GeneratedResource) ([]*Action, map[string]bool, error) {
+	dependencyMaps []map[string]*dependencyGroup,
+	generatedResource *controller.GeneratedResource,
+	receipts map[string]*controller.Receipt,
+	plan *PlanEntry) ([]*Action, map[string]bool, error) {
 	// Visited tracks the parents of target resources which were already generated.
 	visited := make(map[string]bool)
 	actions := make([]*Action, 0)
@@ -199,11 +238,12 @@ func generateUpdateActions(
 	for _, targetResource := range resourceList {
 		visited[targetResource.ResourceName().ParentName().String()] = true
 
-		takeAction, err := needsUpdate(
+		d, err := needsUpdate(
 			targetResource.ResourceName(),
 			targetResource.UpdateTimestamp(),
 			dependencyMaps,
 			generatedResource,
+			receipts[targetResource.ResourceName().String()],
 		)
 
 		if err != nil {
@@ -211,18 +251,22 @@ func generateUpdateActions(
 			continue
 		}
 
-		if takeAction {
+		var a *Action
+		if d.take {
 			cmd, err := generateCommand(generatedResource.Action, targetResource.ResourceName().String())
 			if err != nil {
 				return nil, nil, fmt.Errorf("cannot generate command: %s", err)
 			}
-			a := &Action{
+			a = &Action{
 				Command:           cmd,
 				GeneratedResource: targetResource.ResourceName().String(),
 				RequiresReceipt:   generatedResource.Receipt,
+				Inputs:            dependencyInputs(targetResource.ResourceName(), dependencyMaps, generatedResource),
 			}
 			actions = append(actions, a)
 		}
+		updateTime := targetResource.UpdateTimestamp()
+		plan.addTarget(targetResource.ResourceName(), &updateTime, dependencyMaps, generatedResource, d, a)
 	}
 
 	return actions, visited, nil
@@ -259,9 +303,11 @@ func generateCreateActions(
 	ctx context.Context,
 	client listingClient,
 	resourcePattern string,
-	dependencyMaps []map[string]time.Time,
+	dependencyMaps []map[string]*dependencyGroup,
 	generatedResource *controller.GeneratedResource,
-	visited map[string]bool) ([]*Action, error) {
+	receipts map[string]*controller.Receipt,
+	visited map[string]bool,
+	plan *PlanEntry) ([]*Action, error) {
 	var parentList []patterns.ResourceInstance
 
 	parsedResourcePattern, err := patterns.ParseResourcePattern(resourcePattern)
@@ -307,15 +353,17 @@ func generateCreateActions(
 			return nil, err
 		}
 
-		takeAction, err := needsCreate(
+		d, err := needsCreate(
 			targetResourceName,
 			dependencyMaps,
 			generatedResource,
+			receipts[targetResourceName.String()],
 		)
 
 		if err != nil {
 			return nil, err
-		} else if !takeAction {
+		} else if !d.take {
+			plan.addTarget(targetResourceName, nil, dependencyMaps, generatedResource, d, nil)
 			continue
 		}
 
@@ -327,21 +375,38 @@ func generateCreateActions(
 			Command:           cmd,
 			GeneratedResource: targetResourceName.String(),
 			RequiresReceipt:   generatedResource.Receipt,
+			Inputs:            dependencyInputs(targetResourceName, dependencyMaps, generatedResource),
 		}
 		actions = append(actions, a)
+		plan.addTarget(targetResourceName, nil, dependencyMaps, generatedResource, d, a)
 	}
 
 	return actions, nil
 }
 
+// decision records whether an action is needed for a target resource and why.
+type decision struct {
+	take    bool
+	refresh bool // the action is needed because the refresh interval elapsed
+	reason  string
+}
+
 func needsUpdate(
 	targetResourceName patterns.ResourceName,
 	targetResourceTime time.Time,
-	dependencyMaps []map[string]time.Time,
-	generatedResource *controller.GeneratedResource) (bool, error) {
+	dependencyMaps []map[string]*dependencyGroup,
+	generatedResource *controller.GeneratedResource,
+	receipt *controller.Receipt) (decision, error) {
+	if receipt.GetOutcome() == controller.Receipt_FAILED {
+		return needsRetry(targetResourceName, dependencyMaps, generatedResource, receipt, time.Now())
+	}
 	// Check "refresh" first to decide whether to take action or not.
 	if generatedResource.Refresh != nil && targetResourceTime.Add(generatedResource.Refresh.AsDuration()).Before(time.Now()) {
-		return true, nil
+		return decision{
+			take:    true,
+			refresh: true,
+			reason:  fmt.Sprintf("refresh interval of %s elapsed", generatedResource.Refresh.AsDuration()),
+		}, nil
 	}
 	// Check for dependencies otherwise
 	for i, dependency := range generatedResource.Dependencies {
@@ -350,31 +415,38 @@ func needsUpdate(
 		entityKey, err := patterns.GetReferenceEntityValue(dependency.Pattern, targetResourceName)
 		if err != nil {
 			// This means that there is error in the pattern definition, hence return
-			return false, fmt.Errorf("cannot match resource with dependency. Error: %s", err.Error())
+			return decision{}, fmt.Errorf("cannot match resource with dependency. Error: %s", err.Error())
 		}
 
 		// All the dependencies should be present to generate an action.
-		maxUpdateTime, ok := dMap[entityKey]
+		group, ok := dMap[entityKey]
 		if !ok {
-			return false, nil
+			return decision{reason: fmt.Sprintf("no resources match dependency %s", dependency.Pattern)}, nil
 		}
 
 		// Take action if the target resource is less than n seconds newer compared to the dependencies, where n=thresholdSeconds.
 		// https://github.com/apigee/registry/issues/641
-		if maxUpdateTime.Add(patterns.ResourceUpdateThreshold).After(targetResourceTime) {
-			return true, nil
+		if group.updated.Add(patterns.ResourceUpdateThreshold).After(targetResourceTime) {
+			return decision{
+				take:   true,
+				reason: fmt.Sprintf("dependency %s was updated less than %s before the target", dependency.Pattern, patterns.ResourceUpdateThreshold),
+			}, nil
 		}
 	}
-	return false, nil
+	return decision{reason: "up to date"}, nil
 }
 
 func needsCreate(
 	targetResourceName patterns.ResourceName,
-	dependencyMaps []map[string]time.Time,
-	generatedResource *controller.GeneratedResource) (bool, error) {
+	dependencyMaps []map[string]*dependencyGroup,
+	generatedResource *controller.GeneratedResource,
+	receipt *controller.Receipt) (decision, error) {
+	if receipt.GetOutcome() == controller.Receipt_FAILED {
+		return needsRetry(targetResourceName, dependencyMaps, generatedResource, receipt, time.Now())
+	}
 	// Take action if "refresh" is set and > 0
 	if generatedResource.Refresh != nil && generatedResource.Refresh.AsDuration().Seconds() > 0 {
-		return true, nil
+		return decision{take: true, refresh: true, reason: "target does not exist and refresh is set"}, nil
 	}
 	// Check for dependencies otherwise
 	for i, dependency := range generatedResource.Dependencies {
@@ -383,13 +455,83 @@ func needsCreate(
 		entityVal, err := patterns.GetReferenceEntityValue(dependency.Pattern, targetResourceName)
 		if err != nil {
 			// This means that there is error in the pattern definition, hence return
-			return false, fmt.Errorf("cannot match resource with dependency. Error: %s", err.Error())
+			return decision{}, fmt.Errorf("cannot match resource with dependency. Error: %s", err.Error())
 		}
 
 		// All the dependencies should be present to generate an action.
 		if _, ok := dMap[entityVal]; !ok {
-			return false, nil
+			return decision{reason: fmt.Sprintf("no resources match dependency %s", dependency.Pattern)}, nil
+		}
+	}
+	return decision{take: true, reason: "target does not exist"}, nil
+}
+
+// needsRetry decides whether a failed action should be retried. Failed actions
+// are retried when their dependencies change, or after a delay that doubles
+// with each consecutive failure.
+func needsRetry(
+	targetResourceName patterns.ResourceName,
+	dependencyMaps []map[string]*dependencyGroup,
+	generatedResource *controller.GeneratedResource,
+	receipt *controller.Receipt,
+	now time.Time) (decision, error) {
+	for i, dependency := range generatedResource.Dependencies {
+		entityKey, err := patterns.GetReferenceEntityValue(dependency.Pattern, targetResourceName)
+		if err != nil {
+			return decision{}, fmt.Errorf("cannot match resource with dependency. Error: %s", err.Error())
+		}
+		group, ok := dependencyMaps[i][entityKey]
+		if !ok {
+			return decision{reason: fmt.Sprintf("no resources match dependency %s", dependency.Pattern)}, nil
+		}
+		if group.updated.After(receipt.GetStartTime().AsTime()) {
+			return decision{
+				take:   true,
+				reason: fmt.Sprintf("failed %d time(s), dependency %s changed since", receipt.GetFailures(), dependency.Pattern),
+			}, nil
+		}
+	}
+	retryTime := receipt.GetEndTime().AsTime().Add(retryBackoff(receipt.GetFailures()))
+	if now.Before(retryTime) {
+		return decision{
+			reason: fmt.Sprintf("failed %d time(s), retrying after %s", receipt.GetFailures(), retryTime.UTC().Format(time.RFC3339)),
+		}, nil
+	}
+	return decision{take: true, reason: fmt.Sprintf("failed %d time(s), retrying", receipt.GetFailures())}, nil
+}
+
+const (
+	initialRetryBackoff = 5 * time.Minute
+	maxRetryBackoff     = 24 * time.Hour
+)
+
+// retryBackoff returns the delay before retrying an action that has failed
+// a number of consecutive times.
+func retryBackoff(failures int32) time.Duration {
+	d := initialRetryBackoff
+	for i := int32(1); i < failures && d < maxRetryBackoff; i++ {
+		d *= 2
+	}
+	if d > maxRetryBackoff {
+		d = maxRetryBackoff
+	}
+	return d
+}
+
+// dependencyInputs returns the names of the dependencies of a target resource.
+func dependencyInputs(
+	targetResourceName patterns.ResourceName,
+	dependencyMaps []map[string]*dependencyGroup,
+	generatedResource *controller.GeneratedResource) []string {
+	var inputs []string
+	for i, dependency := range generatedResource.Dependencies {
+		entityKey, err := patterns.GetReferenceEntityValue(dependency.Pattern, targetResourceName)
+		if err != nil {
+			continue
+		}
+		if group, ok := dependencyMaps[i][entityKey]; ok {
+			inputs = append(inputs, group.resources...)
 		}
 	}
-	return true, nil
+	return inputs
 }
@@ -45,6 +45,9 @@ func TestMain(m *testing.M) {
 const gzipOpenAPIv3 = "application/x.openapi+gzip;version=3.0.0"
 
 var sortActions = cmpopts.SortSlices(func(a, b *Action) bool { return a.Command < b.Command })
+
+// compareActions compares actions without their inputs, which are tested separately.
+var compareActions = cmp.Options{sortActions, cmpopts.IgnoreFields(Action{}, "Inputs")}
 var styleguide = &style.StyleGuide{
 	Id:        "registry-styleguide",
 	MimeTypes: []string{gzipOpenAPIv3},
@@ -149,7 +152,7 @@ func TestArtifacts(t *testing.T) {
 			actions := ProcessManifest(ctx, lister, projectID, manifest, 10)
 			addSpecRevisions(t, ctx, registryClient, test.want)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -225,7 +228,7 @@ func TestAggregateArtifacts(t *testing.T) {
 			actions := ProcessManifest(ctx, lister, projectID, manifest, 10)
 			addSpecRevisions(t, ctx, registryClient, test.want)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -346,7 +349,7 @@ func TestDerivedArtifacts(t *testing.T) {
 			actions := ProcessManifest(ctx, lister, projectID, manifest, 10)
 			addSpecRevisions(t, ctx, registryClient, test.want)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -418,7 +421,7 @@ func TestReceiptArtifacts(t *testing.T) {
 			actions := ProcessManifest(ctx, lister, projectID, manifest, 10)
 			addSpecRevisions(t, ctx, registryClient, test.want)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -505,7 +508,7 @@ func TestReceiptAggArtifacts(t *testing.T) {
 			actions := ProcessManifest(ctx, lister, projectID, manifest, 10)
 			addSpecRevisions(t, ctx, registryClient, test.want)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
@@ -653,7 +656,7 @@ func TestMultipleEntitiesArtifacts(t *testing.T) {
 			actions := ProcessManifest(ctx, lister, projectID, manifest, 10)
 			addSpecRevisions(t, ctx, registryClient, test.want)
 
-			if diff := cmp.Diff(test.want, actions, sortActions); diff != "" {
+			if diff := cmp.Diff(test.want, actions, compareActions); diff != "" {
 				t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
 			}
 		})
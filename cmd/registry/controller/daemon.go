@@ -0,0 +1,465 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/cmd/registry/patch"
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	controllerQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "registry_controller_queue_depth",
+		Help: "Number of actions waiting to be executed.",
+	})
+	controllerActions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "registry_controller_actions_total",
+		Help: "Number of actions executed, by result (succeeded or failed).",
+	}, []string{"result"})
+	controllerActionSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "registry_controller_action_seconds",
+		Help:    "Time spent executing actions.",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 14),
+	})
+	controllerReconciliations = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "registry_controller_reconciliations_total",
+		Help: "Number of times the manifest was compared with the registry.",
+	})
+)
+
+// FetchManifest reads a manifest from an artifact.
+func FetchManifest(ctx context.Context, client connection.RegistryClient, manifestName string) (*controller.Manifest, error) {
+	body, err := client.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{
+		Name: manifestName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	manifest := &controller.Manifest{}
+	if err := patch.UnmarshalContents(body.GetData(), body.GetContentType(), manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Daemon keeps the resources generated by a manifest up to date. When the
+// registry changes, it compares the targets that depend on the changed
+// resources with their dependencies and executes the resulting actions,
+// until its context is done.
+type Daemon struct {
+	Client connection.RegistryClient
+	// AdminClient is used by actions that run in-process. If nil, it is
+	// created from the active configuration when it is needed.
+	AdminClient connection.AdminClient
+	// Manifest is the artifact containing the manifest. The manifest is
+	// reloaded when the artifact changes.
+	Manifest names.Artifact
+	// Jobs is the number of actions executed concurrently. Default: 10.
+	Jobs int
+	// MaxActions is the maximum number of actions generated each time the
+	// manifest is compared with the registry. Default: 100.
+	MaxActions int
+	// Debounce is the time to wait for more changes after a change, so
+	// that a burst of changes causes a single comparison. Default: 1s.
+	Debounce time.Duration
+	// ResyncInterval is the time between comparisons of all targets, which
+	// catch changes that weren't seen and run refreshes. Default: 10m.
+	ResyncInterval time.Duration
+	// PollInterval is the time between checks for updated resources if the
+	// registry can't be watched for changes. Default: 30s.
+	PollInterval time.Duration
+	// Execute runs an action. Default: the action's command is executed.
+	Execute func(ctx context.Context, a *Action) error
+
+	mu       sync.Mutex
+	manifest *controller.Manifest
+	changed  map[string]bool // resources changed since the last comparison
+	resync   bool            // all targets must be compared
+	queue    *workQueue
+	trigger  chan struct{}
+
+	manifestUpdated time.Time // only used by watch
+	polled          time.Time // only used by watch
+}
+
+func (d *Daemon) setDefaults() {
+	if d.Jobs <= 0 {
+		d.Jobs = 10
+	}
+	if d.MaxActions <= 0 {
+		d.MaxActions = 100
+	}
+	if d.Debounce <= 0 {
+		d.Debounce = time.Second
+	}
+	if d.ResyncInterval <= 0 {
+		d.ResyncInterval = 10 * time.Minute
+	}
+	if d.PollInterval <= 0 {
+		d.PollInterval = 30 * time.Second
+	}
+	if d.Execute == nil {
+		d.Execute = func(ctx context.Context, a *Action) error {
+			task := &ExecCommandTask{
+				Action:         a,
+				TaskID:         fmt.Sprintf("%.8s", uuid.New()),
+				RegistryClient: d.Client,
+				AdminClient:    d.AdminClient,
+			}
+			return task.Run(ctx)
+		}
+	}
+}
+
+// Run runs the daemon until ctx is done. It returns an error if the manifest
+// can't be read when it starts.
+func (d *Daemon) Run(ctx context.Context) error {
+	d.setDefaults()
+	manifest, err := FetchManifest(ctx, d.Client, d.Manifest.String())
+	if err != nil {
+		return fmt.Errorf("failed to read manifest %s: %s", d.Manifest, err)
+	}
+	d.setManifest(manifest)
+	d.queue = newWorkQueue()
+	d.trigger = make(chan struct{}, 1)
+	d.changed = make(map[string]bool)
+	d.polled = time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < d.Jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.work(ctx)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		d.watch(ctx)
+	}()
+	d.reconcileLoop(ctx)
+	wg.Wait()
+	return nil
+}
+
+func (d *Daemon) setManifest(m *controller.Manifest) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.manifest = m
+}
+
+func (d *Daemon) currentManifest() *controller.Manifest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.manifest
+}
+
+// reconcile requests a comparison of the manifest with the registry.
+func (d *Daemon) reconcile() {
+	select {
+	case d.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// recordChanges requests a comparison of the targets that depend on resources.
+func (d *Daemon) recordChanges(resources ...string) {
+	if len(resources) == 0 {
+		return
+	}
+	d.deferChanges(resources)
+	d.reconcile()
+}
+
+// deferChanges adds resources to the changes handled by the next comparison
+// without requesting one.
+func (d *Daemon) deferChanges(resources []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range resources {
+		d.changed[r] = true
+	}
+}
+
+// resyncAll requests a comparison of all targets.
+func (d *Daemon) resyncAll() {
+	d.mu.Lock()
+	d.resync = true
+	d.mu.Unlock()
+	d.reconcile()
+}
+
+// takeChanges returns the resources changed since the last comparison,
+// or nil if all targets must be compared.
+func (d *Daemon) takeChanges() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	changed := make([]string, 0, len(d.changed))
+	for r := range d.changed {
+		changed = append(changed, r)
+	}
+	sort.Strings(changed)
+	d.changed = make(map[string]bool)
+	if d.resync {
+		d.resync = false
+		return nil
+	}
+	return changed
+}
+
+func (d *Daemon) reconcileLoop(ctx context.Context) {
+	resync := time.NewTicker(d.ResyncInterval)
+	defer resync.Stop()
+	var changed []string // nil compares all targets
+	for {
+		d.enqueueActions(ctx, changed)
+		select {
+		case <-ctx.Done():
+			return
+		case <-resync.C:
+			d.takeChanges()
+			changed = nil
+		case <-d.trigger:
+			// Wait for related changes before comparing.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(d.Debounce):
+			}
+			select {
+			case <-d.trigger:
+			default:
+			}
+			changed = d.takeChanges()
+		}
+	}
+}
+
+// enqueueActions compares the manifest with the registry and queues the
+// resulting actions. If changed is not nil, only the targets that depend on
+// the changed resources are compared. Only the actions of the first stage of
+// the manifest that has any are queued, so that later stages aren't computed
+// from resources that are about to change. They are queued by the comparison
+// that follows the changes made by the earlier stages, which also handles
+// the changes that later stages weren't compared for.
+func (d *Daemon) enqueueActions(ctx context.Context, changed []string) {
+	manifest := d.currentManifest()
+	if changed != nil {
+		manifest = affectedEntries(d.Manifest.ProjectID(), manifest, changed)
+		if len(manifest.GeneratedResources) == 0 {
+			return
+		}
+	}
+	controllerReconciliations.Inc()
+	lister := &RegistryLister{RegistryClient: d.Client}
+	generated := time.Now()
+	var actions []*Action
+	stages := ManifestStages(ctx, d.Manifest.ProjectID(), manifest)
+	for i, stage := range stages {
+		actions = ProcessManifest(ctx, lister, d.Manifest.ProjectID(), stage, d.MaxActions)
+		if len(actions) > 0 {
+			if changed != nil && i < len(stages)-1 {
+				d.deferChanges(changed)
+			}
+			break
+		}
+	}
+	added := 0
+	for _, a := range actions {
+		if d.queue.add(a, generated) {
+			added++
+		}
+	}
+	controllerQueueDepth.Set(float64(d.queue.len()))
+	log.Debugf(ctx, "Generated %d actions, queued %d.", len(actions), added)
+}
+
+func (d *Daemon) work(ctx context.Context) {
+	for {
+		a := d.queue.get(ctx)
+		if a == nil {
+			return
+		}
+		controllerQueueDepth.Set(float64(d.queue.len()))
+		start := time.Now()
+		err := d.Execute(ctx, a)
+		if ctx.Err() != nil {
+			return
+		}
+		controllerActionSeconds.Observe(time.Since(start).Seconds())
+		if err != nil {
+			controllerActions.WithLabelValues("failed").Inc()
+			log.FromContext(ctx).WithError(err).Warnf("Action failed: %s", a.Command)
+		} else {
+			controllerActions.WithLabelValues("succeeded").Inc()
+		}
+		d.queue.done(a, err)
+	}
+}
+
+// watch records the changes to the project. While the registry can't be
+// watched, it polls instead.
+func (d *Daemon) watch(ctx context.Context) {
+	var token string
+	for {
+		err := d.watchStream(ctx, &token)
+		if ctx.Err() != nil {
+			return
+		}
+		switch status.Code(err) {
+		case codes.Unimplemented:
+			log.Infof(ctx, "Watching is not supported, polling every %s.", d.PollInterval)
+			for d.sleep(ctx, d.PollInterval) {
+				d.poll(ctx)
+			}
+			return
+		case codes.OutOfRange:
+			// The resume token expired, so changes may have been missed.
+			token = ""
+		default:
+			log.FromContext(ctx).WithError(err).Warnf("Watch failed, retrying in %s.", d.PollInterval)
+			if !d.sleep(ctx, d.PollInterval) {
+				return
+			}
+			if token != "" {
+				continue // resuming returns the changes that were missed
+			}
+		}
+		d.reloadManifest(ctx)
+		d.resyncAll()
+	}
+}
+
+// sleep waits for a duration and reports whether ctx is still active.
+func (d *Daemon) sleep(ctx context.Context, t time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(t):
+		return true
+	}
+}
+
+func (d *Daemon) watchStream(ctx context.Context, token *string) error {
+	stream, err := d.Client.WatchResources(ctx, &rpc.WatchResourcesRequest{
+		Pattern:     "projects/" + d.Manifest.ProjectID(),
+		ResumeToken: *token,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		*token = resp.GetResumeToken()
+		d.handle(ctx, resp.GetNotification())
+	}
+}
+
+// handle reloads the manifest and requests a comparison of all targets if
+// the manifest changed. Otherwise it requests a comparison of the targets
+// that depend on the changed resource.
+func (d *Daemon) handle(ctx context.Context, n *rpc.Notification) {
+	if n.GetResource() == d.Manifest.String() {
+		d.reloadManifest(ctx)
+		d.resyncAll()
+		return
+	}
+	d.recordChanges(n.GetResource())
+}
+
+func (d *Daemon) reloadManifest(ctx context.Context) {
+	manifest, err := FetchManifest(ctx, d.Client, d.Manifest.String())
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warnf("Failed to reload manifest %s, keeping the previous one.", d.Manifest)
+		return
+	}
+	log.Infof(ctx, "Reloaded manifest %s.", d.Manifest)
+	d.setManifest(manifest)
+}
+
+// polledCollections are the collections that are checked for updated
+// resources when the registry can't be watched, with the fields that hold
+// their update times.
+var polledCollections = []struct {
+	pattern string
+	field   string
+}{
+	{"apis/-", "update_time"},
+	{"apis/-/versions/-", "update_time"},
+	{"apis/-/versions/-/specs/-", "revision_update_time"},
+	{"artifacts/-", "update_time"},
+	{"apis/-/artifacts/-", "update_time"},
+	{"apis/-/versions/-/artifacts/-", "update_time"},
+	{"apis/-/versions/-/specs/-/artifacts/-", "update_time"},
+}
+
+// poll reloads the manifest and requests a comparison of all targets if the
+// manifest's update time changed. Otherwise it requests a comparison of the
+// targets that depend on resources updated since the last poll. Deletions
+// aren't seen by polling; they are handled by the next comparison of all
+// targets.
+func (d *Daemon) poll(ctx context.Context) {
+	artifact, err := d.Client.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: d.Manifest.String()})
+	if err == nil {
+		t := artifact.GetUpdateTime().AsTime()
+		changed := !d.manifestUpdated.IsZero() && !t.Equal(d.manifestUpdated)
+		d.manifestUpdated = t
+		if changed {
+			d.reloadManifest(ctx)
+			d.resyncAll()
+			return
+		}
+	}
+
+	lister := &RegistryLister{RegistryClient: d.Client}
+	parent := fmt.Sprintf("projects/%s/locations/global", d.Manifest.ProjectID())
+	polled := d.polled
+	var changed []string
+	for _, c := range polledCollections {
+		filter := fmt.Sprintf("%s > timestamp(%q)", c.field, d.polled.UTC().Format(time.RFC3339Nano))
+		resources, err := listResources(ctx, lister, fmt.Sprintf("%s/%s", parent, c.pattern), filter)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warnf("Failed to list updated resources in %s.", parent)
+			return
+		}
+		for _, r := range resources {
+			changed = append(changed, r.ResourceName().String())
+			if t := r.UpdateTimestamp(); t.After(polled) {
+				polled = t
+			}
+		}
+	}
+	d.polled = polled
+	d.recordChanges(changed...)
+}
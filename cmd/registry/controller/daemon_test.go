@@ -0,0 +1,192 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/pkg/visitor"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+)
+
+func TestDaemon(t *testing.T) {
+	const (
+		projectID = "daemon-test"
+		parent    = "projects/daemon-test/locations/global"
+		manifest  = parent + "/artifacts/manifest"
+	)
+	manifestContents := func(artifactID string) []byte {
+		return protoMarshal(&controller.Manifest{
+			Id: "manifest",
+			GeneratedResources: []*controller.GeneratedResource{
+				{
+					Pattern: "apis/-/versions/-/specs/-/artifacts/" + artifactID,
+					Dependencies: []*controller.Dependency{
+						{Pattern: "$resource.spec"},
+					},
+					Action: "registry compute lint $resource.spec",
+				},
+			},
+		})
+	}
+	manifestType := mime.MimeTypeForMessageType("google.cloud.apigeeregistry.v1.controller.Manifest")
+
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, projectID, []seeder.RegistryResource{
+		&rpc.ApiSpec{Name: parent + "/apis/a/versions/v1/specs/openapi"},
+		&rpc.Artifact{Name: manifest, MimeType: manifestType, Contents: manifestContents("lint")},
+	})
+
+	manifestName, err := names.ParseArtifact(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	executed := make(chan string, 100)
+	d := &Daemon{
+		Client:   registryClient,
+		Manifest: manifestName,
+		Debounce: 10 * time.Millisecond,
+		Execute: func(ctx context.Context, a *Action) error {
+			select {
+			case executed <- a.GeneratedResource:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			return visitor.SetArtifact(ctx, registryClient, &rpc.Artifact{Name: a.GeneratedResource})
+		},
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() {
+		done <- d.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Run() returned error: %s", err)
+		}
+	}()
+
+	// Actions may run more than once, so wait until each expected action has
+	// run at least once.
+	expect := func(suffix string, prefixes ...string) {
+		t.Helper()
+		timeout := time.After(10 * time.Second)
+		for len(prefixes) > 0 {
+			select {
+			case got := <-executed:
+				for i, prefix := range prefixes {
+					if strings.HasPrefix(got, prefix) && strings.HasSuffix(got, suffix) {
+						prefixes = append(prefixes[:i], prefixes[i+1:]...)
+						break
+					}
+				}
+			case <-timeout:
+				t.Fatalf("timed out waiting for actions for %v...%s", prefixes, suffix)
+			}
+		}
+	}
+
+	// Existing resources are reconciled on start.
+	expect("/artifacts/lint", parent+"/apis/a/versions/v1/specs/openapi@")
+
+	// New resources are reconciled when they are created.
+	if _, err := registryClient.CreateApiSpec(ctx, &rpc.CreateApiSpecRequest{
+		Parent:    parent + "/apis/a/versions/v1",
+		ApiSpecId: "grpc",
+		ApiSpec:   &rpc.ApiSpec{},
+	}); err != nil {
+		t.Fatalf("CreateApiSpec() returned error: %s", err)
+	}
+	expect("/artifacts/lint", parent+"/apis/a/versions/v1/specs/grpc@")
+
+	// The manifest is reloaded when it changes.
+	if err := visitor.SetArtifact(ctx, registryClient, &rpc.Artifact{
+		Name:     manifest,
+		MimeType: manifestType,
+		Contents: manifestContents("summary"),
+	}); err != nil {
+		t.Fatalf("SetArtifact() returned error: %s", err)
+	}
+	expect("/artifacts/summary",
+		parent+"/apis/a/versions/v1/specs/openapi@",
+		parent+"/apis/a/versions/v1/specs/grpc@")
+}
+
+func TestDaemonMissingManifest(t *testing.T) {
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "daemon-test", nil)
+	name, err := names.ParseArtifact("projects/daemon-test/locations/global/artifacts/missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Daemon{
+		Client:   registryClient,
+		Manifest: name,
+	}
+	if err := d.Run(ctx); err == nil {
+		t.Error("Run() succeeded for a missing manifest, want error")
+	}
+}
+
+func TestDaemonPoll(t *testing.T) {
+	const parent = "projects/daemon-test/locations/global"
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "daemon-test", []seeder.RegistryResource{
+		&rpc.ApiSpec{Name: parent + "/apis/a/versions/v1/specs/openapi"},
+		&rpc.Artifact{Name: parent + "/artifacts/manifest"},
+	})
+	name, err := names.ParseArtifact(parent + "/artifacts/manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Daemon{
+		Client:   registryClient,
+		Manifest: name,
+		changed:  make(map[string]bool),
+		trigger:  make(chan struct{}, 1),
+		polled:   time.Now(),
+	}
+	d.poll(ctx)
+	if got := d.takeChanges(); len(got) != 0 {
+		t.Errorf("poll() recorded changes %v before any were made", got)
+	}
+
+	// Only resources updated since the last poll are recorded.
+	if _, err := registryClient.CreateApiSpec(ctx, &rpc.CreateApiSpecRequest{
+		Parent:    parent + "/apis/a/versions/v1",
+		ApiSpecId: "grpc",
+		ApiSpec:   &rpc.ApiSpec{},
+	}); err != nil {
+		t.Fatalf("CreateApiSpec() returned error: %s", err)
+	}
+	d.poll(ctx)
+	got := d.takeChanges()
+	if len(got) != 1 || !strings.HasPrefix(got[0], parent+"/apis/a/versions/v1/specs/grpc") {
+		t.Errorf("poll() recorded changes %v, want the created spec", got)
+	}
+	d.poll(ctx)
+	if got := d.takeChanges(); len(got) != 0 {
+		t.Errorf("poll() recorded changes %v that were already recorded", got)
+	}
+}
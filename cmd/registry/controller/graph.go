@@ -0,0 +1,309 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/apigee/registry/cmd/registry/patterns"
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// entryOrder is the order in which the entries of a manifest are processed.
+// Each stage lists the indices of entries whose dependencies are generated
+// only by entries in earlier stages. Entries in a dependency cycle can't be
+// ordered, so they are listed as cycles instead. Invalid entries are in
+// neither.
+type entryOrder struct {
+	stages [][]int
+	cycles [][]int
+}
+
+// orderEntries builds a graph of the entries of a manifest, with an edge from
+// each entry to the entries that generate resources matching its
+// dependencies, and sorts it topologically. Dependency filters are ignored,
+// so an entry may be ordered after an entry that generates none of its
+// dependencies, but never before one that does. Entries that match their
+// own dependencies don't depend on themselves.
+func orderEntries(parent string, entries []*controller.GeneratedResource) *entryOrder {
+	targets := make([]patterns.ResourceName, len(entries))
+	for i, e := range entries {
+		if len(validateGeneratedResourceEntry(parent, e)) > 0 {
+			continue
+		}
+		targets[i], _ = patterns.ParseResourcePattern(fmt.Sprintf("%s/%s", parent, e.Pattern))
+	}
+	deps := make([][]int, len(entries))
+	for i, e := range entries {
+		if targets[i] == nil {
+			continue
+		}
+		for j := range entries {
+			if j == i || targets[j] == nil {
+				continue
+			}
+			for _, d := range e.Dependencies {
+				p, err := patterns.SubstituteReferenceEntity(d.Pattern, targets[i])
+				if err == nil && patternsOverlap(p.String(), targets[j].String()) {
+					deps[i] = append(deps[i], j)
+					break
+				}
+			}
+		}
+	}
+
+	order := &entryOrder{}
+	const (
+		pending = iota
+		ordered
+		cyclic
+	)
+	state := make([]int, len(entries))
+	remaining := 0
+	for i := range entries {
+		if targets[i] != nil {
+			remaining++
+		} else {
+			state[i] = ordered
+		}
+	}
+	// blocker returns a dependency of an entry that hasn't been ordered, or -1.
+	blocker := func(i int) int {
+		for _, j := range deps[i] {
+			if state[j] == pending {
+				return j
+			}
+		}
+		return -1
+	}
+	for remaining > 0 {
+		var stage []int
+		for i := range entries {
+			if state[i] == pending && blocker(i) < 0 {
+				stage = append(stage, i)
+			}
+		}
+		if len(stage) > 0 {
+			for _, i := range stage {
+				state[i] = ordered
+			}
+			remaining -= len(stage)
+			order.stages = append(order.stages, stage)
+			continue
+		}
+		// Every pending entry is blocked, so following blockers from any of
+		// them leads to a cycle. Its entries are set aside and the entries
+		// that depend on them are ordered as if they didn't exist.
+		var path []int
+		seen := make(map[int]int)
+		i := 0
+		for state[i] != pending {
+			i++
+		}
+		for {
+			if start, ok := seen[i]; ok {
+				cycle := path[start:]
+				for _, j := range cycle {
+					state[j] = cyclic
+				}
+				remaining -= len(cycle)
+				order.cycles = append(order.cycles, cycle)
+				break
+			}
+			seen[i] = len(path)
+			path = append(path, i)
+			i = blocker(i)
+		}
+	}
+	return order
+}
+
+// patternsOverlap returns true if some resource could match both patterns.
+// Revisions are ignored.
+func patternsOverlap(a, b string) bool {
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	if len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		x, _, _ := strings.Cut(as[i], "@")
+		y, _, _ := strings.Cut(bs[i], "@")
+		if x != y && x != "-" && y != "-" {
+			return false
+		}
+	}
+	return true
+}
+
+// cycleError describes a dependency cycle between entries of a manifest.
+func cycleError(entries []*controller.GeneratedResource, cycle []int) error {
+	names := make([]string, 0, len(cycle)+1)
+	for _, i := range cycle {
+		names = append(names, entries[i].Pattern)
+	}
+	names = append(names, entries[cycle[0]].Pattern)
+	return fmt.Errorf("dependency cycle between generated resources: %s", strings.Join(names, " -> "))
+}
+
+// ManifestStages splits a manifest into manifests that must be processed in
+// order, so that the actions of each stage use the resources generated by
+// earlier stages. Invalid entries and entries in dependency cycles are
+// logged and omitted.
+func ManifestStages(ctx context.Context, projectID string, manifest *controller.Manifest) []*controller.Manifest {
+	parent := fmt.Sprintf("projects/%s/locations/global", projectID)
+	for _, err := range ValidateManifest(parent, manifest) {
+		log.FromContext(ctx).WithError(err).Warnf("Error in manifest")
+	}
+	order := orderEntries(parent, manifest.GeneratedResources)
+	stages := make([]*controller.Manifest, 0, len(order.stages))
+	for _, stage := range order.stages {
+		m := &controller.Manifest{
+			Id:                 manifest.Id,
+			Kind:               manifest.Kind,
+			DisplayName:        manifest.DisplayName,
+			Description:        manifest.Description,
+			GeneratedResources: make([]*controller.GeneratedResource, 0, len(stage)),
+		}
+		for _, i := range stage {
+			m.GeneratedResources = append(m.GeneratedResources, manifest.GeneratedResources[i])
+		}
+		stages = append(stages, m)
+	}
+	return stages
+}
+
+// affectedEntries returns a manifest with the entries of a manifest whose
+// targets depend on any of the changed resources. A target depends on a
+// resource if the resource matches one of its dependencies or the target
+// itself. The patterns of the returned entries are narrowed to the targets
+// that depend on the changes, except for entries with a dependency that
+// doesn't refer to the target ($resource), which are returned unchanged.
+func affectedEntries(projectID string, manifest *controller.Manifest, changed []string) *controller.Manifest {
+	parent := fmt.Sprintf("projects/%s/locations/global", projectID)
+	m := &controller.Manifest{
+		Id:          manifest.Id,
+		Kind:        manifest.Kind,
+		DisplayName: manifest.DisplayName,
+		Description: manifest.Description,
+	}
+	for _, e := range manifest.GeneratedResources {
+		if len(validateGeneratedResourceEntry(parent, e)) > 0 {
+			continue
+		}
+		target, err := patterns.ParseResourcePattern(fmt.Sprintf("%s/%s", parent, e.Pattern))
+		if err != nil {
+			continue
+		}
+		targets, all := affectedTargets(target, e.Dependencies, changed)
+		if all {
+			m.GeneratedResources = append(m.GeneratedResources, e)
+			continue
+		}
+		for _, t := range targets {
+			narrowed := proto.Clone(e).(*controller.GeneratedResource)
+			narrowed.Pattern = strings.TrimPrefix(t, parent+"/")
+			m.GeneratedResources = append(m.GeneratedResources, narrowed)
+		}
+	}
+	return m
+}
+
+// affectedTargets returns the narrowest patterns that match the targets of
+// an entry that depend on the changed resources, or true if all of its
+// targets depend on them.
+func affectedTargets(target patterns.ResourceName, dependencies []*controller.Dependency, changed []string) ([]string, bool) {
+	var targets []string
+	add := func(group string) {
+		if t, ok := narrowPattern(target.String(), group); ok {
+			targets = append(targets, t)
+		}
+	}
+	for _, name := range changed {
+		if patternsOverlap(target.String(), name) {
+			add(name)
+		}
+		for _, d := range dependencies {
+			p, err := patterns.SubstituteReferenceEntity(d.Pattern, target)
+			if err != nil || !patternsOverlap(p.String(), name) {
+				continue
+			}
+			if _, entityType, _ := patterns.GetReferenceEntityType(d.Pattern); entityType == "default" {
+				return nil, true
+			}
+			resource, err := patterns.ParseResourcePattern(name)
+			if err != nil {
+				continue
+			}
+			if group, err := patterns.GetReferenceEntityValue(d.Pattern, resource); err == nil {
+				add(group)
+			}
+		}
+	}
+
+	// Patterns that match only targets of other patterns are redundant.
+	var result []string
+	for i, t := range targets {
+		redundant := false
+		for j, u := range targets {
+			if i != j && coversPattern(u, t) && (t != u || j < i) {
+				redundant = true
+				break
+			}
+		}
+		if !redundant {
+			result = append(result, t)
+		}
+	}
+	return result, false
+}
+
+// narrowPattern restricts a pattern to the resources named by name or its
+// children, by replacing the leading segments of the pattern with the
+// segments of name. It returns false if name doesn't match those segments.
+// Revisions in name are ignored.
+func narrowPattern(pattern, name string) (string, bool) {
+	ps, ns := strings.Split(pattern, "/"), strings.Split(name, "/")
+	if len(ns) > len(ps) {
+		return "", false
+	}
+	for i := range ns {
+		id, _, _ := strings.Cut(ns[i], "@")
+		p, _, _ := strings.Cut(ps[i], "@")
+		if p == "-" {
+			ps[i] = id
+		} else if p != id {
+			return "", false
+		}
+	}
+	return strings.Join(ps, "/"), true
+}
+
+// coversPattern returns true if every resource that matches b also matches a.
+func coversPattern(a, b string) bool {
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	if len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		if as[i] != "-" && as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
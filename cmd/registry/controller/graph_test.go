@@ -0,0 +1,349 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestOrderEntries(t *testing.T) {
+	entry := func(pattern string, dependencies ...string) *controller.GeneratedResource {
+		r := &controller.GeneratedResource{
+			Pattern: pattern,
+			Action:  "registry compute something",
+		}
+		for _, d := range dependencies {
+			r.Dependencies = append(r.Dependencies, &controller.Dependency{Pattern: d})
+		}
+		if len(dependencies) == 0 {
+			r.Refresh = durationpb.New(time.Hour)
+		}
+		return r
+	}
+	tests := []struct {
+		desc    string
+		entries []*controller.GeneratedResource
+		want    *entryOrder
+	}{
+		{
+			desc: "independent entries",
+			entries: []*controller.GeneratedResource{
+				entry("apis/-/versions/-/specs/-/artifacts/lint-spectral", "$resource.spec"),
+				entry("apis/-/versions/-/specs/-/artifacts/complexity", "$resource.spec"),
+			},
+			want: &entryOrder{stages: [][]int{{0, 1}}},
+		},
+		{
+			desc: "dependent entries",
+			entries: []*controller.GeneratedResource{
+				entry("apis/-/versions/-/specs/-/artifacts/score", "$resource.spec/artifacts/lint-spectral", "$resource.spec/artifacts/complexity"),
+				entry("apis/-/versions/-/artifacts/summary", "$resource.version/specs/-/artifacts/score"),
+				entry("apis/-/versions/-/specs/-/artifacts/lint-spectral", "$resource.spec"),
+				entry("apis/-/versions/-/specs/-/artifacts/complexity", "$resource.spec"),
+			},
+			want: &entryOrder{stages: [][]int{{2, 3}, {0}, {1}}},
+		},
+		{
+			desc: "absolute dependency patterns",
+			entries: []*controller.GeneratedResource{
+				entry("artifacts/index", "apis/-/versions/-/specs/-/artifacts/-"),
+				entry("apis/-/versions/-/specs/-/artifacts/complexity", "$resource.spec"),
+			},
+			want: &entryOrder{stages: [][]int{{1}, {0}}},
+		},
+		{
+			desc: "entries that match their own dependencies",
+			entries: []*controller.GeneratedResource{
+				entry("apis/-/artifacts/summary", "$resource.api/artifacts/-"),
+			},
+			want: &entryOrder{stages: [][]int{{0}}},
+		},
+		{
+			desc: "cycle",
+			entries: []*controller.GeneratedResource{
+				entry("apis/-/versions/-/specs/-/artifacts/complexity", "$resource.spec"),
+				entry("apis/-/versions/-/specs/-/artifacts/a", "$resource.spec/artifacts/b"),
+				entry("apis/-/versions/-/specs/-/artifacts/b", "$resource.spec/artifacts/a"),
+				entry("apis/-/versions/-/specs/-/artifacts/c", "$resource.spec/artifacts/b", "$resource.spec/artifacts/complexity"),
+			},
+			want: &entryOrder{stages: [][]int{{0}, {3}}, cycles: [][]int{{1, 2}}},
+		},
+		{
+			desc: "invalid entries",
+			entries: []*controller.GeneratedResource{
+				entry("apis/-/versions/-/specs/-/artifacts/-", "$resource.spec"),
+				entry("apis/-/versions/-/specs/-/artifacts/complexity", "$resource.spec/artifacts/lint"),
+			},
+			want: &entryOrder{stages: [][]int{{1}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := orderEntries("projects/p/locations/global", test.entries)
+			if diff := cmp.Diff(test.want, got, cmp.AllowUnexported(entryOrder{})); diff != "" {
+				t.Errorf("orderEntries() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateManifestCycle(t *testing.T) {
+	manifest := &controller.Manifest{
+		GeneratedResources: []*controller.GeneratedResource{
+			{
+				Pattern:      "apis/-/versions/-/specs/-/artifacts/a",
+				Dependencies: []*controller.Dependency{{Pattern: "$resource.spec/artifacts/b"}},
+				Action:       "registry compute a $resource.spec",
+			},
+			{
+				Pattern:      "apis/-/versions/-/specs/-/artifacts/b",
+				Dependencies: []*controller.Dependency{{Pattern: "$resource.spec/artifacts/a"}},
+				Action:       "registry compute b $resource.spec",
+			},
+		},
+	}
+	errs := ValidateManifest("projects/p/locations/global", manifest)
+	if len(errs) != 1 {
+		t.Fatalf("ValidateManifest() returned %d errors, want 1: %v", len(errs), errs)
+	}
+	want := "dependency cycle between generated resources: apis/-/versions/-/specs/-/artifacts/a -> apis/-/versions/-/specs/-/artifacts/b -> apis/-/versions/-/specs/-/artifacts/a"
+	if got := errs[0].Error(); !strings.Contains(got, want) {
+		t.Errorf("ValidateManifest() returned %q, want %q", got, want)
+	}
+}
+
+func TestManifestStagesWarnsOfCycles(t *testing.T) {
+	logger, rec := log.NewWithRecorder()
+	ctx := log.NewContext(context.Background(), logger)
+	manifest := &controller.Manifest{
+		GeneratedResources: []*controller.GeneratedResource{
+			{
+				Pattern:      "apis/-/versions/-/specs/-/artifacts/a",
+				Dependencies: []*controller.Dependency{{Pattern: "$resource.spec/artifacts/b"}},
+				Action:       "registry compute a $resource.spec",
+			},
+			{
+				Pattern:      "apis/-/versions/-/specs/-/artifacts/b",
+				Dependencies: []*controller.Dependency{{Pattern: "$resource.spec/artifacts/a"}},
+				Action:       "registry compute b $resource.spec",
+			},
+		},
+	}
+	if stages := ManifestStages(ctx, "p", manifest); len(stages) != 0 {
+		t.Errorf("ManifestStages() returned %d stages, want 0", len(stages))
+	}
+	entries := rec.LogEntries
+	if len(entries) != 1 {
+		t.Fatalf("ManifestStages() logged %d entries, want 1", len(entries))
+	}
+	if got := entries[0].Level.String(); got != "warn" {
+		t.Errorf("ManifestStages() logged at level %q, want %q", got, "warn")
+	}
+	if got := fmt.Sprint(entries[0].Fields.Get("error")); !strings.Contains(got, "dependency cycle") {
+		t.Errorf("ManifestStages() logged error %q, want a dependency cycle", got)
+	}
+}
+
+func TestPatternsOverlap(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"apis/-/versions/-/specs/-", "apis/petstore/versions/-/specs/openapi", true},
+		{"apis/-/versions/-/specs/-@-", "apis/-/versions/-/specs/openapi", true},
+		{"apis/-/versions/-/specs/-/artifacts/a", "apis/-/versions/-/specs/-/artifacts/b", false},
+		{"apis/-/versions/-/specs/-", "apis/-/versions/-/specs/-/artifacts/-", false},
+	}
+	for _, test := range tests {
+		if got := patternsOverlap(test.a, test.b); got != test.want {
+			t.Errorf("patternsOverlap(%q, %q) returned %t, want %t", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestProcessManifestOrder(t *testing.T) {
+	ctx := context.Background()
+	client := new(fakeLister)
+	old := timestamppb.New(time.Now().Add(-time.Hour))
+	seed := []seeder.RegistryResource{
+		&rpc.ApiSpec{
+			Name:               "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi",
+			MimeType:           gzipOpenAPIv3,
+			RevisionUpdateTime: timestamppb.Now(),
+		},
+		&rpc.Artifact{
+			Name:       "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/complexity",
+			UpdateTime: old,
+		},
+		&rpc.Artifact{
+			Name:       "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/score",
+			UpdateTime: old,
+		},
+	}
+	if err := seeder.SeedRegistry(ctx, client, seed...); err != nil {
+		t.Fatalf("Setup: failed to seed registry: %s", err)
+	}
+	manifest := &controller.Manifest{
+		Id: "controller-test",
+		GeneratedResources: []*controller.GeneratedResource{
+			{
+				Pattern:      "apis/-/versions/-/specs/-/artifacts/score",
+				Dependencies: []*controller.Dependency{{Pattern: "$resource.spec/artifacts/complexity"}},
+				Action:       "registry compute score $resource.spec/artifacts/complexity",
+			},
+			{
+				Pattern:      "apis/-/versions/-/specs/-/artifacts/complexity",
+				Dependencies: []*controller.Dependency{{Pattern: "$resource.spec"}},
+				Action:       "registry compute complexity $resource.spec",
+			},
+		},
+	}
+	want := []*Action{
+		{
+			Command:           "registry compute complexity projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi",
+			GeneratedResource: "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/complexity",
+		},
+		{
+			Command:           "registry compute score projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/complexity",
+			GeneratedResource: "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/score",
+		},
+	}
+	actions := ProcessManifest(ctx, client, "controller-test", manifest, 10)
+	if diff := cmp.Diff(want, actions, cmpopts.IgnoreFields(Action{}, "Inputs")); diff != "" {
+		t.Errorf("ProcessManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
+	}
+
+	stages := ManifestStages(ctx, "controller-test", manifest)
+	if len(stages) != 2 {
+		t.Fatalf("ManifestStages() returned %d stages, want 2", len(stages))
+	}
+	for i, want := range []string{"apis/-/versions/-/specs/-/artifacts/complexity", "apis/-/versions/-/specs/-/artifacts/score"} {
+		if got := stages[i].GeneratedResources[0].Pattern; got != want {
+			t.Errorf("ManifestStages() stage %d is %q, want %q", i+1, got, want)
+		}
+	}
+}
+
+func TestAffectedEntries(t *testing.T) {
+	const parent = "projects/p/locations/global"
+	entry := func(pattern string, dependencies ...string) *controller.GeneratedResource {
+		r := &controller.GeneratedResource{
+			Pattern: pattern,
+			Action:  "registry compute something",
+		}
+		for _, d := range dependencies {
+			r.Dependencies = append(r.Dependencies, &controller.Dependency{Pattern: d})
+		}
+		return r
+	}
+	manifest := &controller.Manifest{
+		GeneratedResources: []*controller.GeneratedResource{
+			entry("apis/-/versions/-/specs/-/artifacts/complexity", "$resource.spec"),
+			entry("apis/-/artifacts/summary", "$resource.api/versions/-/specs/-/artifacts/complexity"),
+			entry("artifacts/index", "apis/-/artifacts/summary"),
+			entry("apis/-/versions/-/specs/-/artifacts/lint", "$resource.spec", "artifacts/lint-config"),
+		},
+	}
+	tests := []struct {
+		desc    string
+		changed []string
+		want    []string
+	}{
+		{
+			desc:    "changed dependency",
+			changed: []string{parent + "/apis/a/versions/v1/specs/openapi@1234"},
+			want: []string{
+				"apis/a/versions/v1/specs/openapi/artifacts/complexity",
+				"apis/a/versions/v1/specs/openapi/artifacts/lint",
+			},
+		},
+		{
+			desc:    "changed dependency of a parent",
+			changed: []string{parent + "/apis/a/versions/v1/specs/openapi/artifacts/complexity"},
+			want: []string{
+				"apis/a/versions/v1/specs/openapi/artifacts/complexity",
+				"apis/a/artifacts/summary",
+			},
+		},
+		{
+			desc:    "changed dependency without a reference",
+			changed: []string{parent + "/apis/a/artifacts/summary"},
+			want: []string{
+				"apis/a/artifacts/summary",
+				"artifacts/index",
+			},
+		},
+		{
+			desc: "changes to several targets",
+			changed: []string{
+				parent + "/apis/a/versions/v1/specs/openapi",
+				parent + "/apis/b/versions/v1/specs/openapi",
+				parent + "/artifacts/lint-config",
+			},
+			want: []string{
+				"apis/a/versions/v1/specs/openapi/artifacts/complexity",
+				"apis/b/versions/v1/specs/openapi/artifacts/complexity",
+				"apis/-/versions/-/specs/-/artifacts/lint",
+			},
+		},
+		{
+			desc:    "unrelated change",
+			changed: []string{parent + "/apis/a/deployments/prod"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var got []string
+			for _, e := range affectedEntries("p", manifest, test.changed).GeneratedResources {
+				got = append(got, e.Pattern)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("affectedEntries(%v) returned unexpected diff (-want +got):\n%s", test.changed, diff)
+			}
+		})
+	}
+}
+
+func TestNarrowPattern(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          string
+		ok            bool
+	}{
+		{"apis/-/versions/-/specs/-/artifacts/a", "apis/x/versions/v1", "apis/x/versions/v1/specs/-/artifacts/a", true},
+		{"apis/-/versions/-/specs/-/artifacts/a", "apis/x/versions/v1/specs/s@123", "apis/x/versions/v1/specs/s/artifacts/a", true},
+		{"apis/x/artifacts/a", "apis/y", "", false},
+		{"apis/-", "apis/x/versions/v1", "", false},
+	}
+	for _, test := range tests {
+		got, ok := narrowPattern(test.pattern, test.name)
+		if got != test.want || ok != test.ok {
+			t.Errorf("narrowPattern(%q, %q) returned (%q, %t), want (%q, %t)", test.pattern, test.name, got, ok, test.want, test.ok)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/apigee/registry/cmd/registry/cmd/check"
+	"github.com/apigee/registry/cmd/registry/cmd/compute/complexity"
+	"github.com/apigee/registry/cmd/registry/cmd/compute/conformance"
+	"github.com/apigee/registry/cmd/registry/cmd/compute/lint"
+	"github.com/apigee/registry/cmd/registry/cmd/compute/score"
+	"github.com/apigee/registry/cmd/registry/cmd/compute/scorecard"
+	"github.com/apigee/registry/cmd/registry/cmd/compute/vocabulary"
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/spf13/cobra"
+)
+
+// inProcessCommands returns a command tree containing the first-party
+// commands that actions can run without starting a new process.
+// Commands keep their flag values, so a new tree is needed for each action.
+func inProcessCommands() *cobra.Command {
+	compute := &cobra.Command{Use: "compute"}
+	compute.AddCommand(complexity.Command())
+	compute.AddCommand(conformance.Command())
+	compute.AddCommand(lint.Command())
+	compute.AddCommand(score.Command())
+	compute.AddCommand(scorecard.Command())
+	compute.AddCommand(vocabulary.Command())
+
+	cmd := &cobra.Command{
+		Use:           "registry",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	cmd.AddCommand(compute)
+	cmd.AddCommand(check.Command())
+	return cmd
+}
+
+// inProcessCommand returns a command that runs an action in-process, or nil
+// if the action must be executed as an external command.
+func inProcessCommand(action string) *cobra.Command {
+	args := strings.Fields(action)
+	if len(args) < 2 || args[0] != "registry" {
+		return nil
+	}
+	root := inProcessCommands()
+	cmd, _, err := root.Find(args[1:])
+	if err != nil || !cmd.Runnable() {
+		return nil
+	}
+	root.SetArgs(args[1:])
+	return root
+}
+
+// runInProcess runs an in-process command using the specified clients. Nil
+// clients are created from the active configuration when they are needed.
+func runInProcess(ctx context.Context, cmd *cobra.Command, registryClient connection.RegistryClient, adminClient connection.AdminClient, out io.Writer) error {
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	return cmd.ExecuteContext(connection.WithClients(ctx, registryClient, adminClient))
+}
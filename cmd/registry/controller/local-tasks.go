@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -25,10 +26,10 @@ import (
 	"github.com/apigee/registry/pkg/application/controller"
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/log"
-	"github.com/apigee/registry/pkg/mime"
 	"github.com/apigee/registry/pkg/visitor"
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Implement io.Writer interface https://pkg.go.dev/io#Writer
@@ -41,9 +42,36 @@ func (w logWriter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// tailBuffer keeps the end of the output written to it.
+type tailBuffer struct {
+	limit int
+	buf   []byte
+}
+
+func (b *tailBuffer) Write(p []byte) (n int, err error) {
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		b.buf = b.buf[len(b.buf)-b.limit:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	return string(b.buf)
+}
+
+// maxReceiptOutput is the maximum length of output stored in a receipt.
+const maxReceiptOutput = 16 * 1024
+
+// ExecCommandTask runs an action. First-party commands that can run
+// in-process do so using RegistryClient and AdminClient, which are created
+// from the active configuration if they are nil. Other commands are executed
+// as external commands.
 type ExecCommandTask struct {
-	Action *Action
-	TaskID string
+	Action         *Action
+	TaskID         string
+	RegistryClient connection.RegistryClient
+	AdminClient    connection.AdminClient
 }
 
 func (task *ExecCommandTask) String() string {
@@ -64,13 +92,66 @@ func (task *ExecCommandTask) Run(ctx context.Context) error {
 		return errors.New("'registry resolve' not allowed in action")
 	}
 
-	// first party registry commands
-	if strings.HasPrefix(task.Action.Command, "registry") {
-		fullCmd := strings.Fields(task.Action.Command)
+	client := task.RegistryClient
+	if client == nil {
+		var err error
+		client, err = connection.NewRegistryClient(ctx)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Fatal("Failed to get client")
+		}
+	}
+
+	receipt := &controller.Receipt{
+		Action:    task.Action.Command,
+		Inputs:    task.Action.Inputs,
+		StartTime: timestamppb.Now(),
+	}
+	runErr := task.run(ctx, logger, receipt)
+	receipt.EndTime = timestamppb.Now()
+	if runErr != nil {
+		receipt.Outcome = controller.Receipt_FAILED
+		receipt.Failures = previousFailures(ctx, client, receiptName(task.Action)) + 1
+	} else {
+		receipt.Outcome = controller.Receipt_SUCCEEDED
+	}
 
+	// Receipts are stored for all actions, so failures can be found in the registry.
+	if err := storeReceipt(ctx, client, receiptName(task.Action), receipt); err != nil {
+		logger.WithError(err).Debug("Failed Execution: failed uploading receipt")
+		if runErr == nil {
+			return errors.New("failed uploading receipt")
+		}
+	}
+
+	if runErr != nil {
+		logger.WithError(runErr).Debug("Failed Execution: failed running command")
+		return errors.New("failed running command")
+	}
+	logger.Debug("Successful Execution:")
+	return nil
+}
+
+// run runs the action and records its output and exit code in the receipt.
+func (task *ExecCommandTask) run(ctx context.Context, logger log.Logger, receipt *controller.Receipt) error {
+	stderr := &tailBuffer{limit: maxReceiptOutput}
+	if cmd := inProcessCommand(task.Action.Command); cmd != nil {
+		// first party registry commands that can run in-process
+		out := io.MultiWriter(&logWriter{logger: logger}, stderr)
+		if err := runInProcess(ctx, cmd, task.RegistryClient, task.AdminClient, out); err != nil {
+			fmt.Fprintln(stderr, err)
+			receipt.Stderr, receipt.ExitCode = stderr.String(), 1
+			return err
+		}
+		return nil
+	}
+
+	fullCmd := strings.Fields(task.Action.Command)
+	var out, errOut io.Writer
+	if strings.HasPrefix(task.Action.Command, "registry") {
 		// force the exec-ed registry tool to use the same server configuration as the controller
 		config, err := connection.ActiveConfig()
 		if err != nil {
+			receipt.Stderr, receipt.ExitCode = err.Error(), -1
 			return err
 		}
 		if config.Insecure {
@@ -80,50 +161,53 @@ func (task *ExecCommandTask) Run(ctx context.Context) error {
 			fullCmd = append(fullCmd, "--registry.address")
 			fullCmd = append(fullCmd, config.Address)
 		}
-		cmd := exec.Command(fullCmd[0], fullCmd[1:]...)
-		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
-
-		if err := cmd.Run(); err != nil {
-			logger.WithError(err).Debug("Failed Execution: failed running command")
-			return errors.New("failed running command")
-		}
+		out, errOut = os.Stdout, os.Stderr
 	} else { //third party commands
-		fullCmd := strings.Fields(task.Action.Command)
+		// redirect the output of the subcommands to the logger
 		cmdLogger := &logWriter{
 			logger: logger,
 		}
-
-		cmd := exec.Command(fullCmd[0], fullCmd[1:]...)
-		// redirect the output of the subcommands to the logger
-		cmd.Stdout, cmd.Stderr = cmdLogger, cmdLogger
-
-		if err := cmd.Run(); err != nil {
-			logger.WithError(err).Debug("Failed Execution: failed running command")
-			return errors.New("failed running command")
-		}
+		out, errOut = cmdLogger, cmdLogger
 	}
 
-	if task.Action.RequiresReceipt {
-		if err := touchArtifact(ctx, task.Action.GeneratedResource, task.Action.Command); err != nil {
-			logger.WithError(err).Debug("Failed Execution: failed uploading receipt")
-			return errors.New("failed uploading receipt")
+	// keep the end of the output for the receipt
+	stdout := &tailBuffer{limit: maxReceiptOutput}
+	cmd := exec.CommandContext(ctx, fullCmd[0], fullCmd[1:]...)
+	cmd.Stdout, cmd.Stderr = io.MultiWriter(out, stdout), io.MultiWriter(errOut, stderr)
+	err := cmd.Run()
+	receipt.Stdout, receipt.Stderr = stdout.String(), stderr.String()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		receipt.ExitCode = int32(exitErr.ExitCode())
+	} else if err != nil {
+		// The command couldn't be started.
+		receipt.ExitCode = -1
+		if receipt.Stderr == "" {
+			receipt.Stderr = err.Error()
 		}
 	}
-
-	logger.Debug("Successful Execution:")
-	return nil
+	return err
 }
 
-func touchArtifact(ctx context.Context, artifactName, action string) error {
-	client, err := connection.NewRegistryClient(ctx)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Fatal("Failed to get client")
+// previousFailures returns the number of consecutive failures recorded in a
+// receipt, or zero if it doesn't exist or records a success.
+func previousFailures(ctx context.Context, client connection.RegistryClient, name string) int32 {
+	body, err := client.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name})
+	if err != nil || body.GetContentType() != receiptMimeType {
+		return 0
 	}
+	receipt := &controller.Receipt{}
+	if err := proto.Unmarshal(body.GetData(), receipt); err != nil || receipt.GetOutcome() != controller.Receipt_FAILED {
+		return 0
+	}
+	return receipt.GetFailures()
+}
 
-	messageData, _ := proto.Marshal(&controller.Receipt{Action: action})
+func storeReceipt(ctx context.Context, client connection.RegistryClient, name string, receipt *controller.Receipt) error {
+	messageData, _ := proto.Marshal(receipt)
 	return visitor.SetArtifact(ctx, client, &rpc.Artifact{
-		Name:     artifactName,
-		MimeType: mime.MimeTypeForMessageType("google.cloud.apigeeregistry.v1.controller.Receipt"),
+		Name:     name,
+		MimeType: receiptMimeType,
 		Contents: messageData,
 	})
 }
@@ -16,7 +16,14 @@ package controller
 
 import (
 	"context"
+	"sync"
 	"testing"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/protobuf/proto"
 )
 
 // Test the error scenario
@@ -33,3 +40,190 @@ func TestErrorCases(t *testing.T) {
 		t.Errorf("Expected GetCommand() to return error.")
 	}
 }
+
+func TestInProcessCommand(t *testing.T) {
+	tests := []struct {
+		action    string
+		inProcess bool
+	}{
+		{"registry compute lint projects/p/locations/global/apis/a/versions/v/specs/s --linter gnostic", true},
+		{"registry compute complexity projects/p/locations/global/apis/a/versions/v/specs/s", true},
+		{"registry check projects/p/locations/global/apis/a", true},
+		{"registry compute lintstats projects/p/locations/global/apis/a", false},
+		{"registry compute", false},
+		{"registry", false},
+		{"echo registry compute lint", false},
+	}
+	for _, test := range tests {
+		if got := inProcessCommand(test.action) != nil; got != test.inProcess {
+			t.Errorf("inProcessCommand(%q) != nil = %t, want %t", test.action, got, test.inProcess)
+		}
+	}
+}
+
+func TestRunInProcess(t *testing.T) {
+	const spec = "projects/in-process-test/locations/global/apis/a/versions/v/specs/openapi"
+	ctx := context.Background()
+	registryClient, adminClient := grpctest.SetupRegistry(ctx, t, "in-process-test", []seeder.RegistryResource{
+		&rpc.ApiSpec{
+			Name:     spec,
+			MimeType: "application/x.openapi;version=3.0.0",
+			Contents: []byte("openapi: 3.0.0\ninfo:\n  title: a\n  version: v\npaths: {}\n"),
+		},
+	})
+
+	task := &ExecCommandTask{
+		Action: &Action{
+			Command:           "registry compute complexity " + spec,
+			GeneratedResource: spec + "/artifacts/complexity",
+		},
+		TaskID:         "task0",
+		RegistryClient: registryClient,
+		AdminClient:    adminClient,
+	}
+	if err := task.Run(ctx); err != nil {
+		t.Fatalf("Run() returned error: %s", err)
+	}
+	if _, err := registryClient.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: spec + "/artifacts/complexity"}); err != nil {
+		t.Errorf("GetArtifact() returned error: %s", err)
+	}
+}
+
+func TestRunInProcessConcurrently(t *testing.T) {
+	const root = "projects/concurrent-test/locations/global"
+	ctx := context.Background()
+	registryClient, adminClient := grpctest.SetupRegistry(ctx, t, "concurrent-test", []seeder.RegistryResource{
+		&rpc.ApiSpec{
+			Name:     root + "/apis/a/versions/v/specs/bad",
+			MimeType: "application/html",
+			Contents: []byte("some text"),
+		},
+	})
+
+	// The spec has a warning, so only the strict check fails. Each check
+	// must use its own flags when both run at the same time.
+	strict := &ExecCommandTask{
+		Action: &Action{
+			Command:           "registry check projects/concurrent-test --error-level WARNING",
+			GeneratedResource: root + "/artifacts/strict",
+		},
+		TaskID:         "strict",
+		RegistryClient: registryClient,
+		AdminClient:    adminClient,
+	}
+	lenient := &ExecCommandTask{
+		Action: &Action{
+			Command:           "registry check projects/concurrent-test",
+			GeneratedResource: root + "/artifacts/lenient",
+		},
+		TaskID:         "lenient",
+		RegistryClient: registryClient,
+		AdminClient:    adminClient,
+	}
+	for i := 0; i < 5; i++ {
+		var wg sync.WaitGroup
+		var strictErr, lenientErr error
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			strictErr = strict.Run(ctx)
+		}()
+		go func() {
+			defer wg.Done()
+			lenientErr = lenient.Run(ctx)
+		}()
+		wg.Wait()
+		if strictErr == nil {
+			t.Errorf("Run(%q) succeeded, want error", strict.Action.Command)
+		}
+		if lenientErr != nil {
+			t.Errorf("Run(%q) returned error: %s", lenient.Action.Command, lenientErr)
+		}
+	}
+}
+
+func TestExecReceipt(t *testing.T) {
+	const receipt = "projects/exec-test/locations/global/artifacts/receipt"
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "exec-test", nil)
+
+	task := &ExecCommandTask{
+		Action: &Action{
+			Command:           "echo hello",
+			GeneratedResource: receipt,
+			RequiresReceipt:   true,
+		},
+		TaskID:         "task0",
+		RegistryClient: registryClient,
+	}
+	if err := task.Run(ctx); err != nil {
+		t.Fatalf("Run() returned error: %s", err)
+	}
+	contents, err := registryClient.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: receipt})
+	if err != nil {
+		t.Fatalf("GetArtifactContents() returned error: %s", err)
+	}
+	got := &controller.Receipt{}
+	if err := proto.Unmarshal(contents.GetData(), got); err != nil {
+		t.Fatal(err)
+	}
+	if got.GetAction() != "echo hello" || got.GetStdout() != "hello\n" || got.GetStderr() != "" {
+		t.Errorf("receipt = %v, want action and stdout of the command", got)
+	}
+}
+
+func TestTailBuffer(t *testing.T) {
+	b := &tailBuffer{limit: 5}
+	for _, s := range []string{"abc", "def", "g"} {
+		if n, err := b.Write([]byte(s)); n != len(s) || err != nil {
+			t.Fatalf("Write(%q) = %d, %v", s, n, err)
+		}
+	}
+	if got := b.String(); got != "cdefg" {
+		t.Errorf("String() = %q, want %q", got, "cdefg")
+	}
+}
+
+func TestFailureReceipt(t *testing.T) {
+	const (
+		generated = "projects/failure-test/locations/global/artifacts/out"
+		receipt   = generated + "-receipt"
+	)
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "failure-test", nil)
+
+	task := &ExecCommandTask{
+		Action: &Action{
+			Command:           "ls /nonexistent",
+			GeneratedResource: generated,
+			Inputs:            []string{"projects/failure-test/locations/global/apis/a"},
+		},
+		TaskID:         "task0",
+		RegistryClient: registryClient,
+	}
+	for i := int32(1); i <= 2; i++ {
+		if err := task.Run(ctx); err == nil {
+			t.Fatalf("Run() succeeded, want error")
+		}
+		contents, err := registryClient.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: receipt})
+		if err != nil {
+			t.Fatalf("GetArtifactContents() returned error: %s", err)
+		}
+		got := &controller.Receipt{}
+		if err := proto.Unmarshal(contents.GetData(), got); err != nil {
+			t.Fatal(err)
+		}
+		if got.GetOutcome() != controller.Receipt_FAILED || got.GetFailures() != i {
+			t.Errorf("receipt has outcome %s after %d failures, want FAILED after %d", got.GetOutcome(), got.GetFailures(), i)
+		}
+		if got.GetExitCode() <= 0 || got.GetStderr() == "" {
+			t.Errorf("receipt has exit code %d and stderr %q, want the command's", got.GetExitCode(), got.GetStderr())
+		}
+		if got.GetStartTime() == nil || got.GetEndTime().AsTime().Before(got.GetStartTime().AsTime()) {
+			t.Errorf("receipt has start time %v and end time %v", got.GetStartTime(), got.GetEndTime())
+		}
+		if len(got.GetInputs()) != 1 || got.GetInputs()[0] != task.Action.Inputs[0] {
+			t.Errorf("receipt has inputs %v, want %v", got.GetInputs(), task.Action.Inputs)
+		}
+	}
+}
@@ -31,6 +31,9 @@ func ValidateManifest(parent string, manifest *controller.Manifest) []error {
 			totalErrors = append(totalErrors, fmt.Errorf("invalid entry: %v, %s", resource, err))
 		}
 	}
+	for _, cycle := range orderEntries(parent, manifest.GeneratedResources).cycles {
+		totalErrors = append(totalErrors, cycleError(manifest.GeneratedResources, cycle))
+	}
 	return totalErrors
 }
 
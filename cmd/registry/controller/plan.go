@@ -0,0 +1,174 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/apigee/registry/cmd/registry/patterns"
+	"github.com/apigee/registry/pkg/application/controller"
+)
+
+// Actions of the targets in a plan.
+const (
+	PlanCreate = "create"
+	PlanUpdate = "update"
+	PlanNone   = "none"
+)
+
+// Plan explains the actions that a manifest generates.
+type Plan struct {
+	Entries []*PlanEntry `json:"entries"`
+}
+
+// Count returns the number of targets in the plan with an action.
+func (p *Plan) Count(action string) int {
+	n := 0
+	for _, e := range p.Entries {
+		for _, t := range e.Targets {
+			if t.Action == action {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Invalid returns the number of invalid entries in the plan.
+func (p *Plan) Invalid() int {
+	n := 0
+	for _, e := range p.Entries {
+		if len(e.Errors) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// PlanEntry explains the actions generated by an entry of a manifest.
+// Entries that are invalid have errors and no targets. Entries that are
+// skipped, usually because none of their dependencies exist, have a reason.
+// Entries are processed in stages, starting with 1, after the entries that
+// generate their dependencies. Since a plan doesn't perform any actions, the
+// targets of later stages don't reflect the actions of earlier ones.
+type PlanEntry struct {
+	Pattern string        `json:"pattern"`
+	Stage   int           `json:"stage,omitempty"`
+	Errors  []string      `json:"errors,omitempty"`
+	Skipped string        `json:"skipped,omitempty"`
+	Targets []*PlanTarget `json:"targets,omitempty"`
+}
+
+// PlanTarget explains whether a resource matched by a manifest entry needs
+// an action. Resources that don't exist have no update time.
+type PlanTarget struct {
+	Resource     string            `json:"resource"`
+	UpdateTime   *time.Time        `json:"updateTime,omitempty"`
+	Dependencies []*PlanDependency `json:"dependencies,omitempty"`
+	Refresh      bool              `json:"refresh,omitempty"`
+	Action       string            `json:"action"`
+	Reason       string            `json:"reason"`
+	Command      string            `json:"command,omitempty"`
+}
+
+// PlanDependency describes the resources that match a dependency of a target
+// and the latest time that they were updated.
+type PlanDependency struct {
+	Pattern    string     `json:"pattern"`
+	Resources  []string   `json:"resources,omitempty"`
+	UpdateTime *time.Time `json:"updateTime,omitempty"`
+}
+
+// PlanManifest explains the actions that a manifest generates. Unlike
+// ProcessManifest, it reports invalid entries and doesn't limit the number
+// of actions.
+func PlanManifest(ctx context.Context, client listingClient, projectID string, manifest *controller.Manifest) *Plan {
+	plan := &Plan{}
+	parent := fmt.Sprintf("projects/%s/locations/global", projectID)
+	order := orderEntries(parent, manifest.GeneratedResources)
+	stages := make(map[int]int)
+	for n, stage := range order.stages {
+		for _, i := range stage {
+			stages[i] = n + 1
+		}
+	}
+	cycles := make(map[int]error)
+	for _, cycle := range order.cycles {
+		err := cycleError(manifest.GeneratedResources, cycle)
+		for _, i := range cycle {
+			cycles[i] = err
+		}
+	}
+	for i, resource := range manifest.GeneratedResources {
+		entry := &PlanEntry{Pattern: resource.Pattern, Stage: stages[i]}
+		plan.Entries = append(plan.Entries, entry)
+		if errs := validateGeneratedResourceEntry(parent, resource); len(errs) > 0 {
+			for _, err := range errs {
+				entry.Errors = append(entry.Errors, fmt.Sprintf("generated_resources[%d]: %s", i, err))
+			}
+			continue
+		}
+		if err, ok := cycles[i]; ok {
+			entry.Errors = append(entry.Errors, fmt.Sprintf("generated_resources[%d]: %s", i, err))
+			continue
+		}
+		if _, err := planManifestResource(ctx, client, projectID, resource, entry); err != nil {
+			entry.Skipped = err.Error()
+		}
+	}
+	return plan
+}
+
+// addTarget records the decision for a target resource. It does nothing if
+// the entry is nil, so that plans are only built when they are requested.
+func (e *PlanEntry) addTarget(
+	targetResourceName patterns.ResourceName,
+	updateTime *time.Time,
+	dependencyMaps []map[string]*dependencyGroup,
+	generatedResource *controller.GeneratedResource,
+	d decision,
+	a *Action) {
+	if e == nil {
+		return
+	}
+	t := &PlanTarget{
+		Resource:   targetResourceName.String(),
+		UpdateTime: updateTime,
+		Refresh:    d.refresh,
+		Action:     PlanNone,
+		Reason:     d.reason,
+	}
+	if a != nil {
+		t.Command = a.Command
+		if updateTime == nil {
+			t.Action = PlanCreate
+		} else {
+			t.Action = PlanUpdate
+		}
+	}
+	for i, dependency := range generatedResource.Dependencies {
+		pd := &PlanDependency{Pattern: dependency.Pattern}
+		if entityKey, err := patterns.GetReferenceEntityValue(dependency.Pattern, targetResourceName); err == nil {
+			if group, ok := dependencyMaps[i][entityKey]; ok {
+				updated := group.updated
+				pd.Resources, pd.UpdateTime = group.resources, &updated
+			}
+		}
+		t.Dependencies = append(t.Dependencies, pd)
+	}
+	e.Targets = append(e.Targets, t)
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPlanManifest(t *testing.T) {
+	ctx := context.Background()
+	client := new(fakeLister)
+	now := time.Now()
+	seed := []seeder.RegistryResource{
+		&rpc.ApiSpec{
+			Name:               "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi",
+			MimeType:           gzipOpenAPIv3,
+			RevisionUpdateTime: timestamppb.New(now),
+		},
+		&rpc.Artifact{
+			Name:       "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/lint-gnostic",
+			UpdateTime: timestamppb.New(now.Add(3 * time.Second)),
+		},
+		&rpc.ApiSpec{
+			Name:               "projects/controller-test/locations/global/apis/petstore/versions/1.0.1/specs/openapi",
+			MimeType:           gzipOpenAPIv3,
+			RevisionUpdateTime: timestamppb.New(now),
+		},
+		&rpc.Artifact{
+			Name:       "projects/controller-test/locations/global/apis/petstore/versions/1.0.1/specs/openapi/artifacts/lint-gnostic",
+			UpdateTime: timestamppb.New(now.Add(-time.Hour)),
+		},
+		&rpc.ApiSpec{
+			Name:               "projects/controller-test/locations/global/apis/petstore/versions/1.1.0/specs/openapi",
+			MimeType:           gzipOpenAPIv3,
+			RevisionUpdateTime: timestamppb.New(now),
+		},
+	}
+	if err := seeder.SeedRegistry(ctx, client, seed...); err != nil {
+		t.Fatalf("Setup: failed to seed registry: %s", err)
+	}
+
+	manifest := &controller.Manifest{
+		Id: "controller-test",
+		GeneratedResources: []*controller.GeneratedResource{
+			{
+				Pattern: "apis/-/versions/-/specs/-/artifacts/lint-gnostic",
+				Dependencies: []*controller.Dependency{
+					{
+						Pattern: "$resource.spec",
+						Filter:  "mime_type.contains('openapi')",
+					},
+				},
+				Action: "registry compute lint $resource.spec --linter gnostic",
+			},
+			{
+				Pattern: "apis/-/versions/-/specs/-/artifacts/-",
+				Dependencies: []*controller.Dependency{
+					{
+						Pattern: "$resource.spec",
+					},
+				},
+				Action: "registry compute lint $resource.spec",
+			},
+		},
+	}
+	want := &Plan{
+		Entries: []*PlanEntry{
+			{
+				Pattern: "apis/-/versions/-/specs/-/artifacts/lint-gnostic",
+				Stage:   1,
+				Targets: []*PlanTarget{
+					{
+						Resource: "projects/controller-test/locations/global/apis/petstore/versions/1.0.0/specs/openapi/artifacts/lint-gnostic",
+						Action:   PlanNone,
+						Reason:   "up to date",
+					},
+					{
+						Resource: "projects/controller-test/locations/global/apis/petstore/versions/1.0.1/specs/openapi/artifacts/lint-gnostic",
+						Action:   PlanUpdate,
+						Reason:   "dependency $resource.spec was updated less than 2s before the target",
+						Command:  "registry compute lint projects/controller-test/locations/global/apis/petstore/versions/1.0.1/specs/openapi --linter gnostic",
+					},
+					{
+						Resource: "projects/controller-test/locations/global/apis/petstore/versions/1.1.0/specs/openapi/artifacts/lint-gnostic",
+						Action:   PlanCreate,
+						Reason:   "target does not exist",
+						Command:  "registry compute lint projects/controller-test/locations/global/apis/petstore/versions/1.1.0/specs/openapi --linter gnostic",
+					},
+				},
+			},
+			{
+				Pattern: "apis/-/versions/-/specs/-/artifacts/-",
+				Errors: []string{
+					`generated_resources[1]: invalid generatedResource pattern: "apis/-/versions/-/specs/-/artifacts/-", it should end with a name and not a "-"`,
+				},
+			},
+		},
+	}
+	got := PlanManifest(ctx, client, "controller-test", manifest)
+	opts := cmp.Options{
+		cmpopts.IgnoreFields(PlanTarget{}, "UpdateTime", "Dependencies"),
+		cmpopts.SortSlices(func(a, b *PlanTarget) bool { return a.Resource < b.Resource }),
+	}
+	if diff := cmp.Diff(want, got, opts); diff != "" {
+		t.Errorf("PlanManifest(%+v) returned unexpected diff (-want +got):\n%s", manifest, diff)
+	}
+	if n := got.Count(PlanCreate); n != 1 {
+		t.Errorf("Count(%q) returned %d, want 1", PlanCreate, n)
+	}
+	if n := got.Invalid(); n != 1 {
+		t.Errorf("Invalid() returned %d, want 1", n)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/cmd/registry/patterns"
+)
+
+// workQueue holds actions waiting to be executed. Actions are keyed by the
+// resources that they generate, so an action that is added again before it
+// runs is only run once, and an action isn't added while it is running or if
+// it was generated before the last run finished.
+// Actions that succeed are held for a short delay, because their results
+// aren't considered current until they are newer than their dependencies by
+// patterns.ResourceUpdateThreshold. Actions that fail aren't held: their
+// receipts decide when they are generated again (see needsRetry).
+type workQueue struct {
+	hold time.Duration
+
+	mu       sync.Mutex
+	order    []string // keys of queued actions, in the order they were added
+	queued   map[string]*Action
+	active   map[string]bool
+	notUntil map[string]time.Time // when finished actions may run again
+	finished map[string]time.Time // when actions last finished
+	changed  chan struct{}
+}
+
+func newWorkQueue() *workQueue {
+	return &workQueue{
+		hold:     patterns.ResourceUpdateThreshold,
+		queued:   make(map[string]*Action),
+		active:   make(map[string]bool),
+		notUntil: make(map[string]time.Time),
+		finished: make(map[string]time.Time),
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+// add queues an action that was generated from the state of the registry at
+// a given time and reports whether it was added.
+func (q *workQueue) add(a *Action, generated time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	key := a.GeneratedResource
+	if q.active[key] || q.finished[key].After(generated) {
+		// Running the action changes the registry, which causes the
+		// action to be reconsidered when it finishes.
+		return false
+	}
+	if _, ok := q.queued[key]; !ok {
+		q.order = append(q.order, key)
+	}
+	q.queued[key] = a
+	q.signal()
+	return true
+}
+
+// len returns the number of queued actions.
+func (q *workQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.queued)
+}
+
+// get waits for an action that is ready to run and marks it as active.
+// It returns nil when ctx is done.
+func (q *workQueue) get(ctx context.Context) *Action {
+	for {
+		a, wait := q.next(time.Now())
+		if a != nil {
+			return a
+		}
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			expired = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-q.changed:
+		case <-expired:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// next removes and returns the first action that is ready to run. If none
+// are ready, it returns the time until one will be, or zero if none are
+// held.
+func (q *workQueue) next(now time.Time) (*Action, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var wait time.Duration
+	for i, key := range q.order {
+		if until := q.notUntil[key]; until.After(now) {
+			if d := until.Sub(now); wait == 0 || d < wait {
+				wait = d
+			}
+			continue
+		}
+		a := q.queued[key]
+		q.order = append(q.order[:i:i], q.order[i+1:]...)
+		delete(q.queued, key)
+		q.active[key] = true
+		return a, 0
+	}
+	return nil, wait
+}
+
+// done records the result of running an action, which holds the action if it
+// succeeded.
+func (q *workQueue) done(a *Action, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	key := a.GeneratedResource
+	now := time.Now()
+	delete(q.active, key)
+	q.finished[key] = now
+	if err == nil {
+		q.notUntil[key] = now.Add(q.hold)
+	} else {
+		delete(q.notUntil, key)
+	}
+	q.signal()
+}
+
+func (q *workQueue) signal() {
+	select {
+	case q.changed <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWorkQueueDeduplicates(t *testing.T) {
+	q := newWorkQueue()
+	a := &Action{Command: "first", GeneratedResource: "a"}
+	if !q.add(a, time.Now()) {
+		t.Fatal("add() = false, want true")
+	}
+	if !q.add(&Action{Command: "second", GeneratedResource: "a"}, time.Now()) {
+		t.Fatal("add() = false, want true")
+	}
+	q.add(&Action{Command: "third", GeneratedResource: "b"}, time.Now())
+	if got := q.len(); got != 2 {
+		t.Fatalf("len() = %d, want 2", got)
+	}
+
+	got, _ := q.next(time.Now())
+	if got == nil || got.Command != "second" {
+		t.Fatalf("next() = %v, want the latest action for a", got)
+	}
+	if q.add(a, time.Now()) {
+		t.Error("add() = true for an active action, want false")
+	}
+	generated := time.Now()
+	q.done(got, nil)
+	if q.add(a, generated) {
+		t.Error("add() = true for an action generated before the last run finished, want false")
+	}
+	if !q.add(a, time.Now()) {
+		t.Error("add() = false after the action finished, want true")
+	}
+}
+
+func TestWorkQueueHold(t *testing.T) {
+	q := newWorkQueue()
+	a := &Action{GeneratedResource: "a"}
+	q.add(a, time.Now())
+	got, _ := q.next(time.Now())
+	// Receipts decide when failed actions are retried, so they aren't held.
+	q.done(got, errors.New("failed"))
+	q.add(a, time.Now())
+	if got, _ := q.next(time.Now()); got != a {
+		t.Errorf("next() after failure = %v, want %v", got, a)
+	}
+	q.done(a, nil)
+	q.add(a, time.Now())
+	if got, wait := q.next(time.Now()); got != nil || wait <= 0 || wait > q.hold {
+		t.Errorf("next() after success = (%v, %s), want (nil, <= %s)", got, wait, q.hold)
+	}
+	if got, _ := q.next(time.Now().Add(q.hold)); got != a {
+		t.Errorf("next() after hold = %v, want %v", got, a)
+	}
+}
+
+func TestWorkQueueGet(t *testing.T) {
+	q := newWorkQueue()
+	ctx, cancel := context.WithCancel(context.Background())
+	got := make(chan *Action)
+	go func() {
+		got <- q.get(ctx)
+	}()
+	a := &Action{GeneratedResource: "a"}
+	q.add(a, time.Now())
+	if g := <-got; g != a {
+		t.Errorf("get() = %v, want %v", g, a)
+	}
+
+	go func() {
+		got <- q.get(ctx)
+	}()
+	cancel()
+	if g := <-got; g != nil {
+		t.Errorf("get() with a cancelled context = %v, want nil", g)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/apigee/registry/cmd/registry/patterns"
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/pkg/names"
+	"google.golang.org/protobuf/proto"
+)
+
+var receiptMimeType = mime.MimeTypeForMessageType("google.cloud.apigeeregistry.v1.controller.Receipt")
+
+// receiptSuffix returns the suffix that is added to the name of a generated
+// resource (or resource pattern) to get the name of its receipt.
+// Actions that require receipts store them as the generated resource.
+func receiptSuffix(generatedResource string, requiresReceipt bool) string {
+	if requiresReceipt {
+		return ""
+	}
+	if _, err := names.ParseArtifact(generatedResource); err == nil {
+		return "-receipt"
+	}
+	return "/artifacts/receipt"
+}
+
+// receiptName returns the name of the receipt of an action.
+func receiptName(a *Action) string {
+	return a.GeneratedResource + receiptSuffix(a.GeneratedResource, a.RequiresReceipt)
+}
+
+// listReceipts returns the receipts of the resources that match a pattern,
+// keyed by the names of the resources.
+func listReceipts(ctx context.Context, client listingClient, resourcePattern string, requiresReceipt bool) (map[string]*controller.Receipt, error) {
+	suffix := receiptSuffix(resourcePattern, requiresReceipt)
+	list, err := listResources(ctx, client, resourcePattern+suffix, "")
+	if err != nil {
+		return nil, err
+	}
+	receipts := make(map[string]*controller.Receipt)
+	for _, r := range list {
+		a, ok := r.(patterns.ArtifactResource)
+		if !ok || a.Artifact.GetMimeType() != receiptMimeType {
+			continue
+		}
+		receipt := &controller.Receipt{}
+		if err := proto.Unmarshal(a.Artifact.GetContents(), receipt); err != nil {
+			continue
+		}
+		receipts[strings.TrimSuffix(a.ResourceName().String(), suffix)] = receipt
+	}
+	return receipts, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	for failures, want := range map[int32]time.Duration{
+		0:  initialRetryBackoff,
+		1:  initialRetryBackoff,
+		2:  2 * initialRetryBackoff,
+		3:  4 * initialRetryBackoff,
+		50: maxRetryBackoff,
+	} {
+		if got := retryBackoff(failures); got != want {
+			t.Errorf("retryBackoff(%d) = %s, want %s", failures, got, want)
+		}
+	}
+}
+
+func TestNeedsRetry(t *testing.T) {
+	end := time.Now()
+	receipt := &controller.Receipt{
+		StartTime: timestamppb.New(end.Add(-time.Minute)),
+		EndTime:   timestamppb.New(end),
+		Outcome:   controller.Receipt_FAILED,
+		Failures:  2,
+	}
+	for _, test := range []struct {
+		now  time.Time
+		want bool
+	}{
+		{end, false},
+		{end.Add(retryBackoff(2) - time.Second), false},
+		{end.Add(retryBackoff(2)), true},
+	} {
+		d, err := needsRetry(nil, nil, &controller.GeneratedResource{}, receipt, test.now)
+		if err != nil {
+			t.Fatalf("needsRetry() returned error: %s", err)
+		}
+		if got := d.take; got != test.want {
+			t.Errorf("needsRetry() %s after failure = %t, want %t", test.now.Sub(end), got, test.want)
+		}
+	}
+}
+
+func TestFailedReceipts(t *testing.T) {
+	const (
+		projectID = "receipt-test"
+		spec      = "projects/receipt-test/locations/global/apis/a/versions/v/specs/s"
+	)
+	manifest := &controller.Manifest{
+		Id: "receipt-test",
+		GeneratedResources: []*controller.GeneratedResource{
+			{
+				Pattern: "apis/-/versions/-/specs/-/artifacts/lint",
+				Dependencies: []*controller.Dependency{
+					{Pattern: "$resource.spec"},
+				},
+				Action: "registry compute lint $resource.spec",
+			},
+		},
+	}
+	failed := func(start time.Time, failures int32) *controller.Receipt {
+		return &controller.Receipt{
+			Action:    "registry compute lint " + spec,
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start),
+			Outcome:   controller.Receipt_FAILED,
+			Failures:  failures,
+		}
+	}
+
+	tests := []struct {
+		desc    string
+		receipt func(specTime time.Time) *controller.Receipt
+		want    bool
+	}{
+		{
+			desc:    "no receipt",
+			receipt: func(time.Time) *controller.Receipt { return nil },
+			want:    true,
+		},
+		{
+			desc: "recent failure",
+			receipt: func(specTime time.Time) *controller.Receipt {
+				return failed(time.Now(), 1)
+			},
+			want: false,
+		},
+		{
+			desc: "failure before the dependency changed",
+			receipt: func(specTime time.Time) *controller.Receipt {
+				return failed(specTime.Add(-time.Second), 10)
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			registryClient, _ := grpctest.SetupRegistry(ctx, t, projectID, []seeder.RegistryResource{
+				&rpc.ApiSpec{Name: spec},
+			})
+			s, err := registryClient.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec})
+			if err != nil {
+				t.Fatalf("GetApiSpec() returned error: %s", err)
+			}
+			generated := spec + "@" + s.GetRevisionId() + "/artifacts/lint"
+			if r := test.receipt(s.GetRevisionUpdateTime().AsTime()); r != nil {
+				if err := storeReceipt(ctx, registryClient, generated+"-receipt", r); err != nil {
+					t.Fatalf("storeReceipt() returned error: %s", err)
+				}
+			}
+
+			actions := ProcessManifest(ctx, &RegistryLister{RegistryClient: registryClient}, projectID, manifest, 10)
+			if got := len(actions) == 1; got != test.want {
+				t.Fatalf("ProcessManifest() returned %d actions, want action: %t", len(actions), test.want)
+			}
+			if !test.want {
+				return
+			}
+			if a := actions[0]; a.GeneratedResource != generated || len(a.Inputs) != 1 || a.Inputs[0] != spec+"@"+s.GetRevisionId() {
+				t.Errorf("ProcessManifest() returned action for %s with inputs %v, want %s with inputs [%s@%s]", a.GeneratedResource, a.Inputs, generated, spec, s.GetRevisionId())
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/cmd/registry/tasks"
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/encoding"
@@ -32,16 +33,25 @@ import (
 )
 
 func Apply(ctx context.Context, client connection.RegistryClient, adminClient connection.AdminClient, in io.Reader, project string, recursive bool, jobs int, paths ...string) error {
+	patches, err := readPatches(client, adminClient, in, project, recursive, paths...)
+	if err != nil {
+		return err
+	}
+	return patches.run(ctx, jobs)
+}
+
+// readPatches reads the patches in paths, or from in if the only path is "-".
+func readPatches(client connection.RegistryClient, adminClient connection.AdminClient, in io.Reader, project string, recursive bool, paths ...string) (*patchGroup, error) {
 	patches := &patchGroup{}
 	if paths[0] == "-" {
 		bytes, err := io.ReadAll(in)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := patches.parse(client, adminClient, bytes, "", project); err != nil {
-			return err
+			return nil, err
 		}
-		return patches.run(ctx, jobs)
+		return patches, nil
 	}
 
 	for _, p := range paths {
@@ -67,10 +77,19 @@ func Apply(ctx context.Context, client connection.RegistryClient, adminClient co
 				return err
 			})
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return patches.run(ctx, jobs)
+	return patches, nil
+}
+
+// flush applies the mutations of a patch. The mutations of a file are
+// applied in a single transaction unless the file is too large for one batch.
+func flush(ctx context.Context, b *batch.Mutations) error {
+	if err := b.Flush(ctx); err != nil {
+		return fmt.Errorf("BatchMutate: %s", err)
+	}
+	return nil
 }
 
 type patchGroup struct {
@@ -147,28 +166,42 @@ func (p *patchGroup) parse(client connection.RegistryClient, adminClient connect
 	return nil
 }
 
-func (p *patchGroup) run(ctx context.Context, jobs int) error {
-	// Apply each resource type independently in order of ownership (parents first).
-	for _, taskLists := range [][]tasks.Task{
+// lists returns the tasks of the group grouped by resource type, in order
+// of ownership (parents first).
+func (p *patchGroup) lists() [][]tasks.Task {
+	return [][]tasks.Task{
 		p.apiTasks,
 		p.versionTasks,
 		p.specTasks,
 		p.deploymentTasks,
 		p.artifactTasks,
-	} {
+	}
+}
+
+func (p *patchGroup) run(ctx context.Context, jobs int) error {
+	// Apply each resource type independently in order of ownership (parents first).
+	for _, taskLists := range p.lists() {
 		taskQueue, wait := tasks.WorkerPoolIgnoreError(ctx, jobs)
 		for _, task := range taskLists {
 			taskQueue <- task
 		}
 		wait()
 	}
+	if err := p.validate(); err != nil {
+		return err
+	}
+	log.FromContext(ctx).Infof("%d YAML file(s) applied (%d found, %d with 'apiVersion: %s')", p.filesApplied, p.filesRead, p.filesApplied, encoding.RegistryV1)
+	return nil
+}
+
+// validate returns an error if the group doesn't contain any patches.
+func (p *patchGroup) validate() error {
 	if p.filesRead == 0 {
 		return fmt.Errorf("no YAML files found")
 	}
 	if p.filesApplied == 0 {
 		return fmt.Errorf("no YAML files applied (%d found, none with 'apiVersion: %s')", p.filesRead, encoding.RegistryV1)
 	}
-	log.FromContext(ctx).Infof("%d YAML file(s) applied (%d found, %d with 'apiVersion: %s')", p.filesApplied, p.filesRead, p.filesApplied, encoding.RegistryV1)
 	return nil
 }
 
@@ -223,7 +256,11 @@ func (task *applyBytesTask) Run(ctx context.Context) error {
 		}
 	}
 
-	log.FromContext(ctx).Infof("Applying %s", task.resource())
+	if planning(ctx) != nil {
+		log.FromContext(ctx).Infof("Planning %s", task.resource())
+	} else {
+		log.FromContext(ctx).Infof("Applying %s", task.resource())
+	}
 	switch header.Kind {
 	case "Project":
 		return applyProjectPatchBytes(ctx, task.adminClient, task.bytes)
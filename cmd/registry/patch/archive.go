@@ -0,0 +1,80 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"context"
+	"io"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+)
+
+// archiveChunkSize is the size of the archive chunks sent to ImportProject.
+const archiveChunkSize = 1 << 20
+
+// ExportArchive writes an archive of a project that is made by the server.
+// Unlike ExportProject, it reads the project in a single call.
+func ExportArchive(ctx context.Context, client connection.AdminClient, projectName names.Project, format rpc.ExportProjectRequest_Format, w io.Writer) error {
+	stream, err := client.ExportProject(ctx, &rpc.ExportProjectRequest{
+		Name:   projectName.String(),
+		Format: format,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if _, err := w.Write(resp.GetData()); err != nil {
+			return err
+		}
+	}
+}
+
+// ImportArchive sends an archive written by ExportArchive to the server,
+// which applies its contents to a project in a single transaction.
+func ImportArchive(ctx context.Context, client connection.AdminClient, projectName names.Project, r io.Reader) (*rpc.ImportProjectResponse, error) {
+	stream, err := client.ImportProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, archiveChunkSize)
+	req := &rpc.ImportProjectRequest{Name: projectName.String()}
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			req.Data = buf[:n]
+			if err := stream.Send(req); err == io.EOF {
+				// The server closed the stream; its error is returned by CloseAndRecv.
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			req = &rpc.ImportProjectRequest{}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
@@ -16,13 +16,11 @@ package patch
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
-	"strings"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/gapic"
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/encoding"
@@ -45,46 +43,12 @@ func NewArtifact(ctx context.Context, client *gapic.RegistryClient, message *rpc
 		}
 		message.Contents = body.Data
 	}
-	artifactName, err := names.ParseArtifact(message.Name)
+	artifact, err := encoding.NewArtifact(message)
 	if err != nil {
 		return nil, err
 	}
-	var node *yaml.Node
-	if strings.HasPrefix(message.MimeType, "application/yaml") {
-		var doc yaml.Node
-		err = yaml.Unmarshal(message.Contents, &doc)
-		if err != nil {
-			return nil, err
-		}
-		// The top-level node is a "document" node. We need to marshal the node below it.
-		node = doc.Content[0]
-	} else {
-		m, err := mime.MessageForMimeType(message.MimeType)
-		if err != nil {
-			return nil, err
-		}
-		// Unmarshal the serialized protobuf containing the artifact content.
-		if err = proto.Unmarshal(message.Contents, m); err != nil {
-			return nil, err
-		}
-		if node, err = encoding.NodeForMessage(m); err != nil {
-			return nil, err
-		}
-	}
-	// Wrap the artifact for YAML export.
-	return &encoding.Artifact{
-		Header: encoding.Header{
-			ApiVersion: encoding.RegistryV1,
-			Kind:       mime.KindForMimeType(message.MimeType),
-			Metadata: encoding.Metadata{
-				Name:        artifactName.ArtifactID(),
-				Parent:      names.ExportableName(artifactName.Parent(), artifactName.ProjectID()),
-				Labels:      message.Labels,
-				Annotations: message.Annotations,
-			},
-		},
-		Data: *node,
-	}, nil
+	artifact.Metadata.Etag = exportedEtag(ctx, artifact.Metadata.Etag)
+	return artifact, nil
 }
 
 func applyArtifactPatchBytes(ctx context.Context, client connection.RegistryClient, bytes []byte, project string, filename string) error {
@@ -93,7 +57,11 @@ func applyArtifactPatchBytes(ctx context.Context, client connection.RegistryClie
 	if err != nil {
 		return err
 	}
-	return applyArtifactPatch(ctx, client, &artifact, project, filename)
+	b := newMutations(ctx, client, project)
+	if err := applyArtifactPatch(ctx, b, &artifact, project, filename); err != nil {
+		return err
+	}
+	return flush(ctx, b)
 }
 
 func artifactName(parent string, metadata encoding.Metadata) (names.Artifact, error) {
@@ -114,60 +82,11 @@ func storeArchivesAsYaml(ctx context.Context) bool {
 	return ctx.Value(yamlArchiveKey) != nil && ctx.Value(yamlArchiveKey).(bool)
 }
 
-func applyArtifactPatch(ctx context.Context, client connection.RegistryClient, content *encoding.Artifact, parent string, filename string) error {
-	// Restyle the YAML representation so that yaml.Marshal will marshal it as JSON.
-	encoding.StyleForJSON(&content.Data)
-	// Marshal the YAML representation into the JSON serialization.
-	j, err := yaml.Marshal(content.Data)
+func applyArtifactPatch(ctx context.Context, b *batch.Mutations, content *encoding.Artifact, parent string, filename string) error {
+	mimeType, bytes, err := encoding.ArtifactContents(content, storeArchivesAsYaml(ctx))
 	if err != nil {
 		return err
 	}
-	// Populate Id and Kind fields in the contents of the artifact
-	jWithIdAndKind, err := populateIdAndKind(j, content.Kind, content.Metadata.Name)
-	if err != nil {
-		return err
-	}
-	var mimeType string
-	var bytes []byte
-	// Unmarshal the JSON serialization into the message struct.
-	var m proto.Message
-	m, err = mime.MessageForKind(content.Kind)
-	if err == nil {
-		err = protojson.Unmarshal(jWithIdAndKind, m)
-		if err != nil {
-			if strings.Contains(err.Error(), "unknown field") {
-				// Try unmarshaling the original YAML (without the additional Id and Kind fields).
-				err = protojson.Unmarshal(j, m)
-				if err != nil {
-					return err
-				}
-			}
-		}
-		if storeArchivesAsYaml(ctx) {
-			mimeType = mime.YamlMimeTypeForKind(content.Kind)
-			encoding.StyleForYAML(&content.Data)
-			bytes, err = yaml.Marshal(content.Data)
-			if err != nil {
-				return err
-			}
-		} else {
-			mimeType = mime.MimeTypeForKind(content.Kind)
-			// Marshal the message struct to bytes.
-			bytes, err = proto.Marshal(m)
-			if err != nil {
-				return err
-			}
-		}
-	} else {
-		// If there was no struct defined for the type, marshal it struct as YAML
-		mimeType = mime.MimeTypeForKind(content.Kind)
-		encoding.StyleForYAML(&content.Data)
-		bytes, err = yaml.Marshal(content.Data)
-		if err != nil {
-			return err
-		}
-	}
-
 	name, err := artifactName(parent, content.Header.Metadata)
 	if err != nil {
 		return err
@@ -178,45 +97,19 @@ func applyArtifactPatch(ctx context.Context, client connection.RegistryClient, c
 		Contents:    bytes,
 		Labels:      content.Metadata.Labels,
 		Annotations: content.Metadata.Annotations,
+		Etag:        content.Metadata.Etag,
 	}
-	req := &rpc.CreateArtifactRequest{
-		Parent:     name.Parent(),
-		ArtifactId: name.ArtifactID(),
-		Artifact:   artifact,
-	}
-	_, err = client.CreateArtifact(ctx, req)
-	if err != nil {
-		req := &rpc.ReplaceArtifactRequest{
-			Artifact: artifact,
-		}
-		if _, err := client.ReplaceArtifact(ctx, req); err != nil {
-			return fmt.Errorf("ReplaceArtifact: %s", err)
-		}
+	// Artifacts with etags are only replaced if they haven't changed since
+	// they were exported. Missing artifacts are created.
+	if err := b.Add(ctx, &rpc.Mutation{
+		Operation:    &rpc.Mutation_ReplaceArtifact{ReplaceArtifact: &rpc.ReplaceArtifactRequest{Artifact: artifact}},
+		AllowMissing: true,
+	}); err != nil {
+		return fmt.Errorf("BatchMutate: %s", err)
 	}
 	return nil
 }
 
-// populateIdAndKind inserts the "id" and "kind" fields in the supplied json bytes.
-func populateIdAndKind(bytes []byte, kind, id string) ([]byte, error) {
-	var jsonData map[string]interface{}
-	err := json.Unmarshal(bytes, &jsonData)
-	if err != nil {
-		return nil, err
-	}
-	if jsonData == nil {
-		return nil, errors.New("missing data")
-	}
-	jsonData["id"] = id
-	jsonData["kind"] = kind
-
-	rBytes, err := json.Marshal(jsonData)
-	if err != nil {
-		return nil, err
-	}
-
-	return rBytes, nil
-}
-
 func UnmarshalContents(contents []byte, mimeType string, message proto.Message) error {
 	if !mime.IsYamlKind(mimeType) {
 		return proto.Unmarshal(contents, message)
@@ -17,27 +17,18 @@ package patch
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/gapic"
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/encoding"
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v3"
 )
 
-// relativeSpecRevisionName returns the versionid+specid if the spec is within the specified API
-func relativeSpecRevisionName(apiName names.Api, spec string) string {
-	if spec == "" {
-		return ""
-	}
-	if strings.HasPrefix(spec, apiName.String()) {
-		return strings.TrimPrefix(spec, apiName.String()+"/versions/")
-	}
-	return spec
-}
-
 // resolveSpecRevisionName returns a "full-resolved" spec revision name
 // that is relative to the root ("/") and includes a revision ID.
 func resolveSpecRevisionName(ctx context.Context, client *gapic.RegistryClient, deploymentName names.Deployment, subpath string) (string, error) {
@@ -65,40 +56,23 @@ func resolveSpecRevisionName(ctx context.Context, client *gapic.RegistryClient,
 
 // NewApiDeployment allows an API deployment to be individually exported as a YAML file.
 func NewApiDeployment(ctx context.Context, client *gapic.RegistryClient, message *rpc.ApiDeployment, nested bool) (*encoding.ApiDeployment, error) {
+	deployment, err := encoding.NewApiDeployment(message)
+	if err != nil {
+		return nil, err
+	}
+	deployment.Metadata.Etag = exportedEtag(ctx, deployment.Metadata.Etag)
+	if !nested {
+		return deployment, nil
+	}
 	deploymentName, err := names.ParseDeploymentRevision(message.Name)
 	if err != nil {
 		return nil, err
 	}
-	revisionName := relativeSpecRevisionName(deploymentName.Api(), message.ApiSpecRevision)
-	var artifacts []*encoding.Artifact
-	if nested {
-		artifacts, err = collectChildArtifacts(ctx, client, deploymentName.Artifact("-"))
-		if err != nil {
-			return nil, err
-		}
+	deployment.Data.Artifacts, err = collectChildArtifacts(ctx, client, deploymentName.Artifact("-"))
+	if err != nil {
+		return nil, err
 	}
-	return &encoding.ApiDeployment{
-		Header: encoding.Header{
-			ApiVersion: encoding.RegistryV1,
-			Kind:       "Deployment",
-			Metadata: encoding.Metadata{
-				Name:        deploymentName.DeploymentID,
-				Parent:      names.ExportableName(deploymentName.Parent(), deploymentName.ProjectID),
-				Labels:      message.Labels,
-				Annotations: message.Annotations,
-			},
-		},
-		Data: encoding.ApiDeploymentData{
-			DisplayName:        message.DisplayName,
-			Description:        message.Description,
-			EndpointURI:        message.EndpointUri,
-			ExternalChannelURI: message.ExternalChannelUri,
-			IntendedAudience:   message.IntendedAudience,
-			AccessGuidance:     message.AccessGuidance,
-			ApiSpecRevision:    revisionName,
-			Artifacts:          artifacts,
-		},
-	}, nil
+	return deployment, nil
 }
 
 func applyApiDeploymentPatchBytes(ctx context.Context, client connection.RegistryClient, bytes []byte, project string, filename string) error {
@@ -107,7 +81,11 @@ func applyApiDeploymentPatchBytes(ctx context.Context, client connection.Registr
 	if err != nil {
 		return err
 	}
-	return applyApiDeploymentPatch(ctx, client, &deployment, project, filename)
+	b := newMutations(ctx, client, project)
+	if err := applyApiDeploymentPatch(ctx, client, b, &deployment, project, filename); err != nil {
+		return err
+	}
+	return flush(ctx, b)
 }
 
 func deploymentName(parent string, metadata encoding.Metadata) (names.Deployment, error) {
@@ -124,6 +102,7 @@ func deploymentName(parent string, metadata encoding.Metadata) (names.Deployment
 func applyApiDeploymentPatch(
 	ctx context.Context,
 	client connection.RegistryClient,
+	b *batch.Mutations,
 	deployment *encoding.ApiDeployment,
 	parent string,
 	filename string) error {
@@ -142,19 +121,32 @@ func applyApiDeploymentPatch(
 			AccessGuidance:     deployment.Data.AccessGuidance,
 			Labels:             deployment.Metadata.Labels,
 			Annotations:        deployment.Metadata.Annotations,
+			Etag:               deployment.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
 	req.ApiDeployment.ApiSpecRevision, err = resolveSpecRevisionName(ctx, client, name, deployment.Data.ApiSpecRevision)
+	if status.Code(err) == codes.NotFound {
+		// The spec may be created by a queued mutation, so apply the
+		// queued mutations before looking for it again.
+		if err := flush(ctx, b); err != nil {
+			return err
+		}
+		req.ApiDeployment.ApiSpecRevision, err = resolveSpecRevisionName(ctx, client, name, deployment.Data.ApiSpecRevision)
+		if status.Code(err) == codes.NotFound && planning(ctx) != nil {
+			// The spec is created by the planned changes, so its
+			// revision isn't known yet.
+			req.ApiDeployment.ApiSpecRevision, err = name.Api().String()+"/versions/"+deployment.Data.ApiSpecRevision, nil
+		}
+	}
 	if err != nil {
 		return err
 	}
-	_, err = client.UpdateApiDeployment(ctx, req)
-	if err != nil {
-		return fmt.Errorf("UpdateApiDeployment: %s", err)
+	if err := b.Add(ctx, &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiDeployment{UpdateApiDeployment: req}}); err != nil {
+		return fmt.Errorf("BatchMutate: %s", err)
 	}
 	for _, artifactPatch := range deployment.Data.Artifacts {
-		err = applyArtifactPatch(ctx, client, artifactPatch, name.String(), filename)
+		err = applyArtifactPatch(ctx, b, artifactPatch, name.String(), filename)
 		if err != nil {
 			return err
 		}
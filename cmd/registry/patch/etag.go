@@ -0,0 +1,58 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"context"
+	"time"
+
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+)
+
+// etagOptions returns call options for requests that include an etag.
+// Requests with stale etags fail with Aborted, which the client otherwise
+// retries, so only unavailable servers are retried.
+func etagOptions(etag string) []gax.CallOption {
+	if etag == "" {
+		return nil
+	}
+	return []gax.CallOption{
+		gax.WithRetry(func() gax.Retryer {
+			return gax.OnCodes([]codes.Code{codes.Unavailable}, gax.Backoff{
+				Initial:    200 * time.Millisecond,
+				Max:        10000 * time.Millisecond,
+				Multiplier: 1.30,
+			})
+		}),
+	}
+}
+
+type etagsKey struct{}
+
+// SetExcludeEtags causes resources exported with ctx to omit their etags.
+// Etags are exported by default, so that re-applying an exported file only
+// updates resources that haven't changed since they were exported.
+func SetExcludeEtags(ctx context.Context) context.Context {
+	return context.WithValue(ctx, etagsKey{}, true)
+}
+
+// exportedEtag returns etag unless resources exported with ctx omit their etags.
+func exportedEtag(ctx context.Context, etag string) string {
+	if exclude, _ := ctx.Value(etagsKey{}).(bool); exclude {
+		return ""
+	}
+	return etag
+}
@@ -41,7 +41,7 @@ func TestExport(t *testing.T) {
 	}
 	for _, test := range tests {
 		project := names.Project{ProjectID: "patch-export-test"}
-		ctx := context.Background()
+		ctx := SetExcludeEtags(context.Background())
 		registryClient, adminClient := grpctest.SetupRegistry(ctx, t, project.ProjectID, nil)
 
 		// Set the configured registry.project to the test project.
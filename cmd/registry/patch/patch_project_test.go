@@ -72,7 +72,7 @@ func TestProjectImports(t *testing.T) {
 				t.Fatalf("Failed to get API: %s", err)
 			}
 
-			model, err := NewApi(ctx, registryClient, got, true)
+			model, err := NewApi(SetExcludeEtags(ctx), registryClient, got, true)
 			if err != nil {
 				t.Fatalf("NewApi(%+v) returned an error: %s", got, err)
 			}
@@ -101,7 +101,7 @@ func TestProjectImports(t *testing.T) {
 					t.Fatalf("Failed to verify artifact existence: %s", err)
 				}
 
-				model, err := NewArtifact(ctx, registryClient, message)
+				model, err := NewArtifact(SetExcludeEtags(ctx), registryClient, message)
 				if err != nil {
 					t.Fatalf("NewArtifact(%+v) returned an error: %s", message, err)
 				}
@@ -39,6 +39,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // TestMain will set up a local RegistryServer and grpc.Server for all
@@ -89,14 +90,14 @@ func TestProjectPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time", "etag"),
 			}
 			err = visitor.GetProject(ctx, adminClient, projectName, nil,
 				func(ctx context.Context, project *rpc.Project) error {
 					if !cmp.Equal(test.message, project, opts) {
 						t.Errorf("GetDiff returned unexpected diff (-want +got):\n%s", cmp.Diff(test.message, project, opts))
 					}
-					model, err := NewProject(ctx, registryClient, project)
+					model, err := NewProject(SetExcludeEtags(ctx), registryClient, project)
 					if err != nil {
 						t.Fatalf("%s", err)
 					}
@@ -194,14 +195,14 @@ func TestApiPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 			err = visitor.GetAPI(ctx, registryClient, apiName,
 				func(ctx context.Context, api *rpc.Api) error {
 					if !cmp.Equal(test.message, api, opts) {
 						t.Errorf("GetDiff returned unexpected diff (-want +got):\n%s", cmp.Diff(test.message, api, opts))
 					}
-					model, err := NewApi(ctx, registryClient, api, test.nested)
+					model, err := NewApi(SetExcludeEtags(ctx), registryClient, api, test.nested)
 					if err != nil {
 						t.Fatalf("%s", err)
 					}
@@ -234,6 +235,82 @@ func TestApiPatches(t *testing.T) {
 	}
 }
 
+func TestEtagPatches(t *testing.T) {
+	root := "projects/patch-etag-test/locations/global"
+	ctx := context.Background()
+	registryClient, _ := grpctest.SetupRegistry(ctx, t, "projects/patch-etag-test", nil)
+
+	b, err := os.ReadFile("testdata/resources/apis-registry.yaml")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if err := applyApiPatchBytes(ctx, registryClient, b, root, "patch.yaml"); err != nil {
+		t.Fatalf("%s", err)
+	}
+	api, err := registryClient.GetApi(ctx, &rpc.GetApiRequest{Name: root + "/apis/registry"})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	model, err := NewApi(SetExcludeEtags(ctx), registryClient, api, false)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if model.Metadata.Etag != "" {
+		t.Errorf("Etags should be omitted on request, got %s", model.Metadata.Etag)
+	}
+	model, err = NewApi(ctx, registryClient, api, false)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if model.Metadata.Etag != api.GetEtag() {
+		t.Errorf("Incorrect export etag. Wanted %s, got %s", api.GetEtag(), model.Metadata.Etag)
+	}
+	exported, err := encoding.EncodeYAML(model)
+	if err != nil {
+		t.Fatalf("encoding.EncodeYAML(%+v) returned an error: %s", model, err)
+	}
+	if err := applyApiPatchBytes(ctx, registryClient, exported, root, "patch.yaml"); err != nil {
+		t.Errorf("Applying an exported API returned an error: %s", err)
+	}
+	// The first application changed the API, so the etag of the export is stale.
+	if err := applyApiPatchBytes(ctx, registryClient, exported, root, "patch.yaml"); err == nil {
+		t.Errorf("Applying a stale export of an API succeeded, want error")
+	}
+	// An export can be applied to a registry where the API doesn't exist.
+	if err := registryClient.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api.GetName(), Force: true}); err != nil {
+		t.Fatalf("%s", err)
+	}
+	if err := applyApiPatchBytes(ctx, registryClient, exported, root, "patch.yaml"); err != nil {
+		t.Errorf("Applying an exported API to a registry without it returned an error: %s", err)
+	}
+
+	b, err = os.ReadFile("testdata/artifacts/lifecycle.yaml")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	if err := applyArtifactPatchBytes(ctx, registryClient, b, root, "patch.yaml"); err != nil {
+		t.Fatalf("%s", err)
+	}
+	artifact, err := registryClient.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: root + "/artifacts/lifecycle"})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	artifactModel, err := NewArtifact(ctx, registryClient, artifact)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	exported, err = encoding.EncodeYAML(artifactModel)
+	if err != nil {
+		t.Fatalf("encoding.EncodeYAML(%+v) returned an error: %s", artifactModel, err)
+	}
+	if err := applyArtifactPatchBytes(ctx, registryClient, exported, root, "patch.yaml"); err != nil {
+		t.Errorf("Applying an exported artifact returned an error: %s", err)
+	}
+	if err := applyArtifactPatchBytes(ctx, registryClient, exported, root, "patch.yaml"); err == nil {
+		t.Errorf("Applying a stale export of an artifact succeeded, want error")
+	}
+}
+
 func TestVersionPatches(t *testing.T) {
 	root := "projects/patch-version-test/locations/global"
 	tests := []struct {
@@ -283,14 +360,14 @@ func TestVersionPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 			err = visitor.GetVersion(ctx, registryClient, versionName,
 				func(ctx context.Context, version *rpc.ApiVersion) error {
 					if !cmp.Equal(test.message, version, opts) {
 						t.Errorf("GetDiff returned unexpected diff (-want +got):\n%s", cmp.Diff(test.message, version, opts))
 					}
-					model, err := NewApiVersion(ctx, registryClient, version, test.nested)
+					model, err := NewApiVersion(SetExcludeEtags(ctx), registryClient, version, test.nested)
 					if err != nil {
 						t.Fatalf("%s", err)
 					}
@@ -372,14 +449,14 @@ func TestSpecPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "hash", "size_bytes", "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "hash", "size_bytes", "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 			err = visitor.GetSpec(ctx, registryClient, specName, false,
 				func(ctx context.Context, spec *rpc.ApiSpec) error {
 					if !cmp.Equal(test.message, spec, opts) {
 						t.Errorf("GetDiff returned unexpected diff (-want +got):\n%s", cmp.Diff(test.message, spec, opts))
 					}
-					model, err := NewApiSpec(ctx, registryClient, spec, test.nested)
+					model, err := NewApiSpec(SetExcludeEtags(ctx), registryClient, spec, test.nested)
 					if err != nil {
 						t.Fatalf("%s", err)
 					}
@@ -464,14 +541,14 @@ func TestDeploymentPatches(t *testing.T) {
 			}
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 			err = visitor.GetDeployment(ctx, registryClient, deploymentName,
 				func(ctx context.Context, deployment *rpc.ApiDeployment) error {
 					if !cmp.Equal(test.message, deployment, opts) {
 						t.Errorf("GetDiff returned unexpected diff (-want +got):\n%s", cmp.Diff(test.message, deployment, opts))
 					}
-					model, err := NewApiDeployment(ctx, registryClient, deployment, test.nested)
+					model, err := NewApiDeployment(SetExcludeEtags(ctx), registryClient, deployment, test.nested)
 					if err != nil {
 						t.Fatalf("%s", err)
 					}
@@ -712,6 +789,10 @@ func TestMessageArtifactPatches(t *testing.T) {
 				Action:      "registry compute scorecard RESOURCE",
 				Description: "Description",
 				ResultUri:   "https://example.com",
+				StartTime:   &timestamppb.Timestamp{Seconds: 1672531200},
+				EndTime:     &timestamppb.Timestamp{Seconds: 1672531205},
+				Outcome:     controller.Receipt_SUCCEEDED,
+				Inputs:      []string{"apis/a/versions/v/specs/s@1234"},
 			},
 		},
 		{
@@ -1064,7 +1145,7 @@ func TestMessageArtifactPatches(t *testing.T) {
 							if !cmp.Equal(test.message, contents, opts) {
 								t.Errorf("GetDiff returned unexpected diff (-want +got):\n%s", cmp.Diff(test.message, contents, opts))
 							}
-							model, err := NewArtifact(ctx, registryClient, artifact)
+							model, err := NewArtifact(SetExcludeEtags(ctx), registryClient, artifact)
 							if err != nil {
 								t.Fatalf("%s", err)
 							}
@@ -1142,7 +1223,7 @@ func TestYamlArtifactPatches(t *testing.T) {
 			}
 			err = visitor.GetArtifact(ctx, registryClient, artifactName, true,
 				func(ctx context.Context, artifact *rpc.Artifact) error {
-					model, err := NewArtifact(ctx, registryClient, artifact)
+					model, err := NewArtifact(SetExcludeEtags(ctx), registryClient, artifact)
 					if err != nil {
 						t.Fatalf("%s", err)
 					}
@@ -1304,6 +1385,9 @@ func TestDeploymentImports(t *testing.T) {
 				Parent: project.Api("registry").String(),
 			})
 			for d, err := it.Next(); err != iterator.Done; d, err = it.Next() {
+				if err != nil {
+					t.Fatalf("ListApiDeployments() returned error: %s", err)
+				}
 				specName, err := names.ParseSpecRevision(d.ApiSpecRevision)
 				if err != nil {
 					t.Errorf("failed to parse spec name %s", d.ApiSpecRevision)
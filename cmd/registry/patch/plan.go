@@ -0,0 +1,378 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/apigee/registry/cmd/registry/batch"
+	"github.com/apigee/registry/cmd/registry/compress"
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/rpc"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Actions of the changes in a plan.
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionUnchanged = "unchanged"
+	ActionDelete    = "delete"
+)
+
+// Plan describes the changes that applying a set of files would make.
+type Plan struct {
+	Changes []*Change `json:"changes"`
+}
+
+// Count returns the number of changes in the plan with an action.
+func (p *Plan) Count(action string) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// Change describes the change to a single resource.
+type Change struct {
+	Name   string         `json:"name"`
+	Action string         `json:"action"`
+	Fields []*FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange describes a field that is changed by an update. Fields of maps
+// are named with their keys, such as "labels.owner". Changes to contents
+// have sizes as their old and new values and, for text, a unified diff.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+	Diff  string `json:"diff,omitempty"`
+}
+
+// PlanApply returns the changes that Apply would make by applying the files
+// at paths, without changing anything. If prune is set, the plan also
+// includes the resources that ApplyWithPrune would delete.
+func PlanApply(ctx context.Context, client connection.RegistryClient, adminClient connection.AdminClient, in io.Reader, project string, recursive bool, prune *PruneOptions, paths ...string) (*Plan, error) {
+	var selector labelSelector
+	if prune != nil {
+		var err error
+		if selector, err = prune.selector(); err != nil {
+			return nil, err
+		}
+	}
+	patches, err := readPatches(client, adminClient, in, project, recursive, paths...)
+	if err != nil {
+		return nil, err
+	}
+	if err := patches.validate(); err != nil {
+		return nil, err
+	}
+
+	// Collect the changes that the patches would make, in the order that
+	// they would be applied.
+	r := &planRecorder{}
+	planCtx := context.WithValue(ctx, planKey{}, r)
+	for _, list := range patches.lists() {
+		for _, task := range list {
+			if err := task.Run(planCtx); err != nil {
+				return nil, fmt.Errorf("%s: %w", task, err)
+			}
+		}
+	}
+
+	plan := &Plan{}
+	for _, p := range r.projects {
+		c, err := planProject(ctx, adminClient, p)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, c)
+	}
+	resources, err := r.resources()
+	if err != nil {
+		return nil, err
+	}
+	for _, desired := range resources {
+		c, err := planResource(ctx, client, desired)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, c)
+	}
+	if prune != nil {
+		applied, err := patches.names(project)
+		if err != nil {
+			return nil, err
+		}
+		candidates, err := pruneCandidates(ctx, client, project, selector, applied)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range candidates.names() {
+			plan.Changes = append(plan.Changes, &Change{Name: name, Action: ActionDelete})
+		}
+	}
+	return plan, nil
+}
+
+type planKey struct{}
+
+// planRecorder collects the changes that patches would make.
+type planRecorder struct {
+	mu        sync.Mutex
+	mutations []*rpc.Mutation
+	projects  []*rpc.Project
+}
+
+func (r *planRecorder) record(ctx context.Context, mutations []*rpc.Mutation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mutations = append(r.mutations, mutations...)
+	return nil
+}
+
+func (r *planRecorder) recordProject(project *rpc.Project) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.projects = append(r.projects, project)
+}
+
+type namedMessage interface {
+	proto.Message
+	GetName() string
+}
+
+// resources returns the desired states of the resources that the recorded
+// mutations would update. If a resource is updated more than once, the last
+// update wins.
+func (r *planRecorder) resources() ([]namedMessage, error) {
+	var resources []namedMessage
+	index := make(map[string]int)
+	for _, m := range r.mutations {
+		var desired namedMessage
+		switch op := m.GetOperation().(type) {
+		case *rpc.Mutation_UpdateApi:
+			desired = op.UpdateApi.GetApi()
+		case *rpc.Mutation_UpdateApiVersion:
+			desired = op.UpdateApiVersion.GetApiVersion()
+		case *rpc.Mutation_UpdateApiSpec:
+			desired = op.UpdateApiSpec.GetApiSpec()
+		case *rpc.Mutation_UpdateApiDeployment:
+			desired = op.UpdateApiDeployment.GetApiDeployment()
+		case *rpc.Mutation_ReplaceArtifact:
+			desired = op.ReplaceArtifact.GetArtifact()
+		default:
+			return nil, fmt.Errorf("unexpected mutation %T", op)
+		}
+		if i, ok := index[desired.GetName()]; ok {
+			resources[i] = desired
+			continue
+		}
+		index[desired.GetName()] = len(resources)
+		resources = append(resources, desired)
+	}
+	return resources, nil
+}
+
+// planning returns the recorder of the plan that ctx is computing, if any.
+func planning(ctx context.Context) *planRecorder {
+	r, _ := ctx.Value(planKey{}).(*planRecorder)
+	return r
+}
+
+// newMutations returns a batch for the mutations of a patch. When a plan is
+// being computed, the mutations are recorded instead of applied.
+func newMutations(ctx context.Context, client connection.RegistryClient, parent string) *batch.Mutations {
+	if r := planning(ctx); r != nil {
+		return batch.NewRecorder(r.record)
+	}
+	return batch.NewMutations(client, parent)
+}
+
+func planProject(ctx context.Context, client connection.AdminClient, desired *rpc.Project) (*Change, error) {
+	c := &Change{Name: desired.GetName()}
+	current, err := client.GetProject(ctx, &rpc.GetProjectRequest{Name: desired.GetName()})
+	if status.Code(err) == codes.NotFound {
+		c.Action = ActionCreate
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+	c.Fields = diffFields(current, desired)
+	c.setUpdateAction()
+	return c, nil
+}
+
+func planResource(ctx context.Context, client connection.RegistryClient, desired namedMessage) (*Change, error) {
+	c := &Change{Name: desired.GetName()}
+	var current proto.Message
+	var err error
+	switch desired := desired.(type) {
+	case *rpc.Api:
+		current, err = client.GetApi(ctx, &rpc.GetApiRequest{Name: desired.Name})
+	case *rpc.ApiVersion:
+		current, err = client.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: desired.Name})
+	case *rpc.ApiSpec:
+		current, err = client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: desired.Name})
+	case *rpc.ApiDeployment:
+		current, err = client.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: desired.Name})
+	case *rpc.Artifact:
+		current, err = client.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: desired.Name})
+	default:
+		return nil, fmt.Errorf("unexpected resource %T", desired)
+	}
+	if status.Code(err) == codes.NotFound {
+		c.Action = ActionCreate
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+	c.Fields = diffFields(current, desired)
+
+	switch desired := desired.(type) {
+	case *rpc.ApiSpec:
+		// Specs without contents in the patch keep their current contents.
+		if desired.Contents != nil {
+			contents := desired.Contents
+			if mime.IsGZipCompressed(desired.MimeType) {
+				if contents, err = compress.GUnzippedBytes(contents); err != nil {
+					return nil, err
+				}
+			}
+			body, err := client.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: desired.Name})
+			if err != nil {
+				return nil, err
+			}
+			if f := diffContents(desired.Name, body.GetData(), contents, desired.MimeType); f != nil {
+				c.Fields = append(c.Fields, f)
+			}
+		}
+	case *rpc.Artifact:
+		body, err := client.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: desired.Name})
+		if err != nil {
+			return nil, err
+		}
+		if f := diffContents(desired.Name, body.GetData(), desired.Contents, desired.MimeType); f != nil {
+			c.Fields = append(c.Fields, f)
+		}
+	}
+	c.setUpdateAction()
+	return c, nil
+}
+
+func (c *Change) setUpdateAction() {
+	if len(c.Fields) == 0 {
+		c.Action = ActionUnchanged
+	} else {
+		c.Action = ActionUpdate
+	}
+}
+
+// ignoredFields are set by the server and aren't changed by patches.
+var ignoredFields = map[protoreflect.Name]bool{
+	"name":        true,
+	"etag":        true,
+	"revision_id": true,
+	"hash":        true,
+	"size_bytes":  true,
+}
+
+// diffFields returns the scalar and map fields of desired that differ from
+// current. Contents are compared separately.
+func diffFields(current, desired proto.Message) []*FieldChange {
+	var changes []*FieldChange
+	cm, dm := current.ProtoReflect(), desired.ProtoReflect()
+	fields := dm.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case ignoredFields[fd.Name()]:
+		case fd.IsMap():
+			changes = append(changes, diffMap(fd.JSONName(), cm.Get(fd).Map(), dm.Get(fd).Map())...)
+		case fd.IsList(), fd.Kind() == protoreflect.MessageKind, fd.Kind() == protoreflect.BytesKind:
+			// Lists and messages are set by the server.
+		default:
+			if before, after := cm.Get(fd).String(), dm.Get(fd).String(); before != after {
+				changes = append(changes, &FieldChange{Field: fd.JSONName(), Old: before, New: after})
+			}
+		}
+	}
+	return changes
+}
+
+func diffMap(field string, current, desired protoreflect.Map) []*FieldChange {
+	keys := make(map[string]bool)
+	for _, m := range []protoreflect.Map{current, desired} {
+		m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys[k.String()] = true
+			return true
+		})
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	var changes []*FieldChange
+	for _, k := range sorted {
+		key := protoreflect.ValueOfString(k).MapKey()
+		var before, after string
+		if current.Has(key) {
+			before = current.Get(key).String()
+		}
+		if desired.Has(key) {
+			after = desired.Get(key).String()
+		}
+		if before != after || current.Has(key) != desired.Has(key) {
+			changes = append(changes, &FieldChange{Field: field + "." + k, Old: before, New: after})
+		}
+	}
+	return changes
+}
+
+// diffContents compares the current and desired contents of a resource.
+// Text contents are compared with a unified diff.
+func diffContents(name string, current, desired []byte, mimeType string) *FieldChange {
+	if bytes.Equal(current, desired) {
+		return nil
+	}
+	f := &FieldChange{
+		Field: "contents",
+		Old:   fmt.Sprintf("%d bytes", len(current)),
+		New:   fmt.Sprintf("%d bytes", len(desired)),
+	}
+	if !mime.IsZipArchive(mimeType) && utf8.Valid(current) && utf8.Valid(desired) {
+		edits := myers.ComputeEdits(span.URIFromPath(name), string(current), string(desired))
+		f.Diff = fmt.Sprint(gotextdiff.ToUnified(name, name, string(current), edits))
+	}
+	return f
+}
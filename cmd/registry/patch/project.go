@@ -36,23 +36,12 @@ import (
 
 // NewProject gets a serialized representation of a project.
 func NewProject(ctx context.Context, client *gapic.RegistryClient, message *rpc.Project) (*encoding.Project, error) {
-	projectName, err := names.ParseProject(message.Name)
+	project, err := encoding.NewProject(message)
 	if err != nil {
 		return nil, err
 	}
-	return &encoding.Project{
-		Header: encoding.Header{
-			ApiVersion: encoding.RegistryV1,
-			Kind:       "Project",
-			Metadata: encoding.Metadata{
-				Name: projectName.ProjectID,
-			},
-		},
-		Data: encoding.ProjectData{
-			DisplayName: message.DisplayName,
-			Description: message.Description,
-		},
-	}, err
+	project.Metadata.Etag = exportedEtag(ctx, project.Metadata.Etag)
+	return project, nil
 }
 
 func applyProjectPatchBytes(ctx context.Context, client connection.AdminClient, bytes []byte) error {
@@ -66,10 +55,15 @@ func applyProjectPatchBytes(ctx context.Context, client connection.AdminClient,
 			Name:        "projects/" + project.Metadata.Name,
 			DisplayName: project.Data.DisplayName,
 			Description: project.Data.Description,
+			Etag:        project.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
-	_, err = client.UpdateProject(ctx, req)
+	if r := planning(ctx); r != nil {
+		r.recordProject(req.Project)
+		return nil
+	}
+	_, err = client.UpdateProject(ctx, req, etagOptions(req.Project.Etag)...)
 	return err
 }
 
@@ -0,0 +1,401 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/encoding"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/pkg/visitor"
+	"github.com/apigee/registry/rpc"
+	"gopkg.in/yaml.v3"
+)
+
+// PruneOptions configures the deletion of resources that are missing from
+// the applied files.
+type PruneOptions struct {
+	// Selector is a label selector that limits pruning to resources that
+	// match it, such as "owner=pipeline-a". It is required so that pruning
+	// never removes resources that are managed in other ways.
+	Selector string
+}
+
+// ApplyWithPrune applies the files at paths like Apply and then deletes the
+// resources under project that match the selector of opts and that are not
+// described by any of the files. It returns the names of the resources that
+// were pruned.
+func ApplyWithPrune(ctx context.Context, client connection.RegistryClient, adminClient connection.AdminClient, in io.Reader, project string, recursive bool, jobs int, opts PruneOptions, paths ...string) ([]string, error) {
+	selector, err := opts.selector()
+	if err != nil {
+		return nil, err
+	}
+	patches, err := readPatches(client, adminClient, in, project, recursive, paths...)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := patches.names(project)
+	if err != nil {
+		return nil, err
+	}
+	if err := patches.run(ctx, jobs); err != nil {
+		return nil, err
+	}
+	candidates, err := pruneCandidates(ctx, client, project, selector, applied)
+	if err != nil {
+		return nil, err
+	}
+	return candidates.delete(ctx, client)
+}
+
+func (opts PruneOptions) selector() (labelSelector, error) {
+	selector, err := parseLabelSelector(opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) == 0 {
+		return nil, errors.New("pruning requires a label selector")
+	}
+	return selector, nil
+}
+
+// names returns the names of the resources described by the patches and of
+// their ancestors, without revision IDs.
+func (p *patchGroup) names(project string) (map[string]bool, error) {
+	projectName, err := names.ParseProjectWithLocation(project)
+	if err != nil {
+		return nil, err
+	}
+	c := appliedNames{}
+	for _, list := range p.lists() {
+		for _, t := range list {
+			task := t.(*applyBytesTask)
+			if err := c.addBytes(projectName, project, task.kind, task.bytes); err != nil {
+				return nil, fmt.Errorf("%s: %w", task.path, err)
+			}
+		}
+	}
+	return c, nil
+}
+
+// appliedNames is the set of resource names described by a group of patches.
+type appliedNames map[string]bool
+
+func (c appliedNames) add(name string) {
+	name = withoutRevisions(name)
+	// Ancestors of applied resources are also applied. Collection names
+	// alternate with IDs, so each even number of segments past the
+	// project and location is the name of a resource.
+	parts := strings.Split(name, "/")
+	for i := 6; i <= len(parts); i += 2 {
+		c[strings.Join(parts[:i], "/")] = true
+	}
+}
+
+func (c appliedNames) addBytes(project names.Project, parent string, kind string, bytes []byte) error {
+	switch kind {
+	case "Project":
+		return nil
+	case "API":
+		var api encoding.Api
+		if err := yaml.Unmarshal(bytes, &api); err != nil {
+			return err
+		}
+		return c.addApi(project, &api)
+	case "Version":
+		var version encoding.ApiVersion
+		if err := yaml.Unmarshal(bytes, &version); err != nil {
+			return err
+		}
+		return c.addVersion(parent, &version)
+	case "Spec":
+		var spec encoding.ApiSpec
+		if err := yaml.Unmarshal(bytes, &spec); err != nil {
+			return err
+		}
+		return c.addSpec(parent, &spec)
+	case "Deployment":
+		var deployment encoding.ApiDeployment
+		if err := yaml.Unmarshal(bytes, &deployment); err != nil {
+			return err
+		}
+		return c.addDeployment(parent, &deployment)
+	default:
+		var artifact encoding.Artifact
+		if err := yaml.Unmarshal(bytes, &artifact); err != nil {
+			return err
+		}
+		return c.addArtifact(parent, &artifact)
+	}
+}
+
+func (c appliedNames) addApi(project names.Project, api *encoding.Api) error {
+	name := project.Api(api.Metadata.Name).String()
+	c.add(name)
+	for _, v := range api.Data.ApiVersions {
+		if err := c.addVersion(name, v); err != nil {
+			return err
+		}
+	}
+	for _, d := range api.Data.ApiDeployments {
+		if err := c.addDeployment(name, d); err != nil {
+			return err
+		}
+	}
+	return c.addArtifacts(name, api.Data.Artifacts)
+}
+
+func (c appliedNames) addVersion(parent string, version *encoding.ApiVersion) error {
+	name, err := versionName(parent, version.Metadata)
+	if err != nil {
+		return err
+	}
+	c.add(name.String())
+	for _, s := range version.Data.ApiSpecs {
+		if err := c.addSpec(name.String(), s); err != nil {
+			return err
+		}
+	}
+	return c.addArtifacts(name.String(), version.Data.Artifacts)
+}
+
+func (c appliedNames) addSpec(parent string, spec *encoding.ApiSpec) error {
+	name, err := specName(parent, spec.Metadata)
+	if err != nil {
+		return err
+	}
+	c.add(name.String())
+	return c.addArtifacts(name.String(), spec.Data.Artifacts)
+}
+
+func (c appliedNames) addDeployment(parent string, deployment *encoding.ApiDeployment) error {
+	name, err := deploymentName(parent, deployment.Metadata)
+	if err != nil {
+		return err
+	}
+	c.add(name.String())
+	return c.addArtifacts(name.String(), deployment.Data.Artifacts)
+}
+
+func (c appliedNames) addArtifacts(parent string, artifacts []*encoding.Artifact) error {
+	for _, a := range artifacts {
+		if err := c.addArtifact(parent, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c appliedNames) addArtifact(parent string, artifact *encoding.Artifact) error {
+	name, err := artifactName(parent, artifact.Metadata)
+	if err != nil {
+		return err
+	}
+	c.add(name.String())
+	return nil
+}
+
+var revisionPattern = regexp.MustCompile(`@[^/]*`)
+
+// withoutRevisions removes revision IDs from name, so that artifacts of a
+// spec or deployment match regardless of the revision they are attached to.
+func withoutRevisions(name string) string {
+	return revisionPattern.ReplaceAllString(name, "")
+}
+
+// pruneList holds the names of resources to prune, grouped by type.
+type pruneList struct {
+	apis        []string
+	versions    []string
+	specs       []string
+	deployments []string
+	artifacts   []string
+}
+
+func pruneCandidates(ctx context.Context, client connection.RegistryClient, project string, selector labelSelector, applied map[string]bool) (*pruneList, error) {
+	projectName, err := names.ParseProjectWithLocation(project)
+	if err != nil {
+		return nil, err
+	}
+	p := &pruneList{}
+	candidate := func(name string, labels map[string]string) bool {
+		return selector.matches(labels) && !applied[withoutRevisions(name)]
+	}
+
+	api := projectName.Api("-")
+	if err := visitor.ListAPIs(ctx, client, api, 0, "", func(ctx context.Context, message *rpc.Api) error {
+		if candidate(message.Name, message.Labels) {
+			p.apis = append(p.apis, message.Name)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	version := api.Version("-")
+	if err := visitor.ListVersions(ctx, client, version, 0, "", func(ctx context.Context, message *rpc.ApiVersion) error {
+		if candidate(message.Name, message.Labels) {
+			p.versions = append(p.versions, message.Name)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	spec := version.Spec("-")
+	if err := visitor.ListSpecs(ctx, client, spec, 0, "", false, func(ctx context.Context, message *rpc.ApiSpec) error {
+		if candidate(message.Name, message.Labels) {
+			p.specs = append(p.specs, message.Name)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	deployment := api.Deployment("-")
+	if err := visitor.ListDeployments(ctx, client, deployment, 0, "", func(ctx context.Context, message *rpc.ApiDeployment) error {
+		if candidate(message.Name, message.Labels) {
+			p.deployments = append(p.deployments, message.Name)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	for _, pattern := range []names.Artifact{
+		projectName.Artifact("-"),
+		api.Artifact("-"),
+		version.Artifact("-"),
+		spec.Artifact("-"),
+		deployment.Artifact("-"),
+	} {
+		if err := visitor.ListArtifacts(ctx, client, pattern, 0, "", false, func(ctx context.Context, message *rpc.Artifact) error {
+			if candidate(message.Name, message.Labels) {
+				p.artifacts = append(p.artifacts, message.Name)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// names returns the names of the resources to prune, sorted.
+func (p *pruneList) names() []string {
+	var all []string
+	for _, list := range [][]string{p.apis, p.versions, p.specs, p.deployments, p.artifacts} {
+		all = append(all, list...)
+	}
+	sort.Strings(all)
+	return all
+}
+
+// delete deletes the resources to prune, children first. Deletion continues
+// past failures, which are returned together with the names of the
+// resources that were deleted. Resources are not deleted with force, so a
+// resource with children that are not pruned is kept.
+func (p *pruneList) delete(ctx context.Context, client connection.RegistryClient) ([]string, error) {
+	var deleted []string
+	var errs []error
+	run := func(names []string, fn func(name string) error) {
+		sort.Strings(names)
+		for _, name := range names {
+			log.FromContext(ctx).Infof("Pruning %s", name)
+			if err := fn(name); err != nil {
+				errs = append(errs, fmt.Errorf("failed to prune %s: %w", name, err))
+				continue
+			}
+			deleted = append(deleted, name)
+		}
+	}
+	run(p.artifacts, func(name string) error {
+		return client.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: name})
+	})
+	run(p.deployments, func(name string) error {
+		return client.DeleteApiDeployment(ctx, &rpc.DeleteApiDeploymentRequest{Name: name})
+	})
+	run(p.specs, func(name string) error {
+		return client.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: name})
+	})
+	run(p.versions, func(name string) error {
+		return client.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: name})
+	})
+	run(p.apis, func(name string) error {
+		return client.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: name})
+	})
+	return deleted, errors.Join(errs...)
+}
+
+// labelSelector is a list of requirements on the labels of a resource.
+type labelSelector []labelRequirement
+
+type labelRequirement struct {
+	key    string
+	value  string
+	exists bool // the requirement is only on the presence of key
+	negate bool
+}
+
+// parseLabelSelector parses a comma-separated list of requirements in the
+// style of Kubernetes label selectors: "key=value", "key!=value", "key"
+// (the label is set) and "!key" (the label is not set).
+func parseLabelSelector(s string) (labelSelector, error) {
+	var selector labelSelector
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		var r labelRequirement
+		switch {
+		case strings.Contains(term, "!="):
+			k, v, _ := strings.Cut(term, "!=")
+			r = labelRequirement{key: strings.TrimSpace(k), value: strings.TrimSpace(v), negate: true}
+		case strings.Contains(term, "="):
+			k, v, _ := strings.Cut(strings.Replace(term, "==", "=", 1), "=")
+			r = labelRequirement{key: strings.TrimSpace(k), value: strings.TrimSpace(v)}
+		case strings.HasPrefix(term, "!"):
+			r = labelRequirement{key: strings.TrimSpace(term[1:]), exists: true, negate: true}
+		default:
+			r = labelRequirement{key: term, exists: true}
+		}
+		if r.key == "" || strings.ContainsAny(r.key, "!= ") {
+			return nil, fmt.Errorf("invalid label selector %q", s)
+		}
+		selector = append(selector, r)
+	}
+	return selector, nil
+}
+
+func (s labelSelector) matches(labels map[string]string) bool {
+	for _, r := range s {
+		v, ok := labels[r.key]
+		var match bool
+		if r.exists {
+			match = ok
+		} else {
+			match = ok && v == r.value
+		}
+		if match == r.negate {
+			return false
+		}
+	}
+	return true
+}
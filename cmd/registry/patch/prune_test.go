@@ -0,0 +1,67 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patch
+
+import "testing"
+
+func TestLabelSelector(t *testing.T) {
+	labels := map[string]string{"owner": "pipeline", "tier": "gold"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"owner=pipeline", true},
+		{"owner==pipeline", true},
+		{"owner=other", false},
+		{"owner!=other", true},
+		{"owner!=pipeline", false},
+		{"missing!=x", true},
+		{"owner", true},
+		{"missing", false},
+		{"!missing", true},
+		{"!owner", false},
+		{"owner=pipeline, tier=gold", true},
+		{"owner=pipeline,tier=silver", false},
+	}
+	for _, test := range tests {
+		t.Run(test.selector, func(t *testing.T) {
+			s, err := parseLabelSelector(test.selector)
+			if err != nil {
+				t.Fatalf("parseLabelSelector(%q) failed: %s", test.selector, err)
+			}
+			if got := s.matches(labels); got != test.want {
+				t.Errorf("matches(%v) = %t, want %t", labels, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLabelSelectorErrors(t *testing.T) {
+	for _, selector := range []string{"=x", "!=x", "!", "a b=c", "a=b,!=c"} {
+		t.Run(selector, func(t *testing.T) {
+			if _, err := parseLabelSelector(selector); err == nil {
+				t.Errorf("parseLabelSelector(%q) succeeded, expected error", selector)
+			}
+		})
+	}
+}
+
+func TestWithoutRevisions(t *testing.T) {
+	name := "projects/p/locations/global/apis/a/versions/v/specs/s@123/artifacts/x"
+	want := "projects/p/locations/global/apis/a/versions/v/specs/s/artifacts/x"
+	if got := withoutRevisions(name); got != want {
+		t.Errorf("withoutRevisions(%q) = %q, want %q", name, got, want)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/cmd/registry/compress"
 	"github.com/apigee/registry/gapic"
 	"github.com/apigee/registry/pkg/connection"
@@ -38,36 +39,23 @@ import (
 
 // NewApiSpec allows an API spec to be individually exported as a YAML file.
 func NewApiSpec(ctx context.Context, client *gapic.RegistryClient, message *rpc.ApiSpec, nested bool) (*encoding.ApiSpec, error) {
+	spec, err := encoding.NewApiSpec(message)
+	if err != nil {
+		return nil, err
+	}
+	spec.Metadata.Etag = exportedEtag(ctx, spec.Metadata.Etag)
+	if !nested {
+		return spec, nil
+	}
 	specName, err := names.ParseSpecRevision(message.Name)
 	if err != nil {
 		return nil, err
 	}
-	var artifacts []*encoding.Artifact
-	if nested {
-		artifacts, err = collectChildArtifacts(ctx, client, specName.Artifact("-"))
-		if err != nil {
-			return nil, err
-		}
+	spec.Data.Artifacts, err = collectChildArtifacts(ctx, client, specName.Artifact("-"))
+	if err != nil {
+		return nil, err
 	}
-	return &encoding.ApiSpec{
-		Header: encoding.Header{
-			ApiVersion: encoding.RegistryV1,
-			Kind:       "Spec",
-			Metadata: encoding.Metadata{
-				Name:        specName.SpecID,
-				Parent:      names.ExportableName(specName.Parent(), specName.ProjectID),
-				Labels:      message.Labels,
-				Annotations: message.Annotations,
-			},
-		},
-		Data: encoding.ApiSpecData{
-			FileName:    message.Filename,
-			Description: message.Description,
-			MimeType:    message.MimeType,
-			SourceURI:   message.SourceUri,
-			Artifacts:   artifacts,
-		},
-	}, nil
+	return spec, nil
 }
 
 func applyApiSpecPatchBytes(
@@ -81,7 +69,11 @@ func applyApiSpecPatchBytes(
 	if err != nil {
 		return err
 	}
-	return applyApiSpecPatch(ctx, client, &spec, project, filename)
+	b := newMutations(ctx, client, project)
+	if err := applyApiSpecPatch(ctx, b, &spec, project, filename); err != nil {
+		return err
+	}
+	return flush(ctx, b)
 }
 
 func specName(parent string, metadata encoding.Metadata) (names.Spec, error) {
@@ -97,7 +89,7 @@ func specName(parent string, metadata encoding.Metadata) (names.Spec, error) {
 
 func applyApiSpecPatch(
 	ctx context.Context,
-	client connection.RegistryClient,
+	b *batch.Mutations,
 	spec *encoding.ApiSpec,
 	parent string,
 	filename string) error {
@@ -114,6 +106,7 @@ func applyApiSpecPatch(
 			SourceUri:   spec.Data.SourceURI,
 			Labels:      spec.Metadata.Labels,
 			Annotations: spec.Metadata.Annotations,
+			Etag:        spec.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
@@ -217,12 +210,11 @@ func applyApiSpecPatch(
 			}
 		}
 	}
-	_, err = client.UpdateApiSpec(ctx, req)
-	if err != nil {
-		return fmt.Errorf("UpdateApiSpec: %s", err)
+	if err := b.Add(ctx, &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiSpec{UpdateApiSpec: req}}); err != nil {
+		return fmt.Errorf("BatchMutate: %s", err)
 	}
 	for _, artifactPatch := range spec.Data.Artifacts {
-		err = applyArtifactPatch(ctx, client, artifactPatch, name.String(), filename)
+		err = applyArtifactPatch(ctx, b, artifactPatch, name.String(), filename)
 		if err != nil {
 			return err
 		}
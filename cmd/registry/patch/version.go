@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/apigee/registry/cmd/registry/batch"
 	"github.com/apigee/registry/gapic"
 	"github.com/apigee/registry/pkg/connection"
 	"github.com/apigee/registry/pkg/encoding"
@@ -29,54 +30,40 @@ import (
 
 // NewApiVersion allows an API version to be individually exported as a YAML file.
 func NewApiVersion(ctx context.Context, client *gapic.RegistryClient, message *rpc.ApiVersion, nested bool) (*encoding.ApiVersion, error) {
+	version, err := encoding.NewApiVersion(message)
+	if err != nil {
+		return nil, err
+	}
+	version.Metadata.Etag = exportedEtag(ctx, version.Metadata.Etag)
+	if !nested {
+		return version, nil
+	}
 	versionName, err := names.ParseVersion(message.Name)
 	if err != nil {
 		return nil, err
 	}
-
-	var specs []*encoding.ApiSpec
-	var artifacts []*encoding.Artifact
-	if nested {
-		specs = make([]*encoding.ApiSpec, 0)
-		if err = visitor.ListSpecs(ctx, client, versionName.Spec("-"), 0, "", false, func(ctx context.Context, message *rpc.ApiSpec) error {
-			spec, err := NewApiSpec(ctx, client, message, true)
-			if err != nil {
-				return err
-			}
-			// unset these because they can be inferred
-			spec.ApiVersion = ""
-			spec.Kind = ""
-			spec.Metadata.Parent = ""
-			specs = append(specs, spec)
-			return nil
-		}); err != nil {
-			return nil, err
-		}
-		artifacts, err = collectChildArtifacts(ctx, client, versionName.Artifact("-"))
+	specs := make([]*encoding.ApiSpec, 0)
+	if err = visitor.ListSpecs(ctx, client, versionName.Spec("-"), 0, "", false, func(ctx context.Context, message *rpc.ApiSpec) error {
+		spec, err := NewApiSpec(ctx, client, message, true)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		// unset these because they can be inferred
+		spec.ApiVersion = ""
+		spec.Kind = ""
+		spec.Metadata.Parent = ""
+		specs = append(specs, spec)
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	return &encoding.ApiVersion{
-		Header: encoding.Header{
-			ApiVersion: encoding.RegistryV1,
-			Kind:       "Version",
-			Metadata: encoding.Metadata{
-				Name:        versionName.VersionID,
-				Parent:      names.ExportableName(versionName.Parent(), versionName.ProjectID),
-				Labels:      message.Labels,
-				Annotations: message.Annotations,
-			},
-		},
-		Data: encoding.ApiVersionData{
-			DisplayName: message.DisplayName,
-			Description: message.Description,
-			State:       message.State,
-			PrimarySpec: message.PrimarySpec,
-			ApiSpecs:    specs,
-			Artifacts:   artifacts,
-		},
-	}, nil
+	artifacts, err := collectChildArtifacts(ctx, client, versionName.Artifact("-"))
+	if err != nil {
+		return nil, err
+	}
+	version.Data.ApiSpecs = specs
+	version.Data.Artifacts = artifacts
+	return version, nil
 }
 
 func applyApiVersionPatchBytes(
@@ -90,7 +77,11 @@ func applyApiVersionPatchBytes(
 	if err != nil {
 		return err
 	}
-	return applyApiVersionPatch(ctx, client, &version, project, filename)
+	b := newMutations(ctx, client, project)
+	if err := applyApiVersionPatch(ctx, client, b, &version, project, filename); err != nil {
+		return err
+	}
+	return flush(ctx, b)
 }
 
 func versionName(parent string, metadata encoding.Metadata) (names.Version, error) {
@@ -107,6 +98,7 @@ func versionName(parent string, metadata encoding.Metadata) (names.Version, erro
 func applyApiVersionPatch(
 	ctx context.Context,
 	client connection.RegistryClient,
+	b *batch.Mutations,
 	version *encoding.ApiVersion,
 	parent string,
 	filename string) error {
@@ -123,21 +115,21 @@ func applyApiVersionPatch(
 			PrimarySpec: version.Data.PrimarySpec,
 			Labels:      version.Metadata.Labels,
 			Annotations: version.Metadata.Annotations,
+			Etag:        version.Metadata.Etag,
 		},
 		AllowMissing: true,
 	}
-	_, err = client.UpdateApiVersion(ctx, req)
-	if err != nil {
-		return fmt.Errorf("UpdateApiVersion: %s", err)
+	if err := b.Add(ctx, &rpc.Mutation{Operation: &rpc.Mutation_UpdateApiVersion{UpdateApiVersion: req}}); err != nil {
+		return fmt.Errorf("BatchMutate: %s", err)
 	}
 	for _, specPatch := range version.Data.ApiSpecs {
-		err := applyApiSpecPatch(ctx, client, specPatch, name.String(), filename)
+		err := applyApiSpecPatch(ctx, b, specPatch, name.String(), filename)
 		if err != nil {
 			return err
 		}
 	}
 	for _, artifactPatch := range version.Data.Artifacts {
-		err = applyArtifactPatch(ctx, client, artifactPatch, name.String(), filename)
+		err = applyArtifactPatch(ctx, b, artifactPatch, name.String(), filename)
 		if err != nil {
 			return err
 		}
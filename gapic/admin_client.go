@@ -50,6 +50,14 @@ type AdminCallOptions struct {
 	CreateProject   []gax.CallOption
 	UpdateProject   []gax.CallOption
 	DeleteProject   []gax.CallOption
+	UndeleteProject []gax.CallOption
+	ListAuditEvents []gax.CallOption
+	ExportProject   []gax.CallOption
+	ImportProject   []gax.CallOption
+	CreateSnapshot  []gax.CallOption
+	ListSnapshots   []gax.CallOption
+	DeleteSnapshot  []gax.CallOption
+	RestoreSnapshot []gax.CallOption
 }
 
 func defaultAdminGRPCClientOptions() []option.ClientOption {
@@ -74,6 +82,14 @@ func defaultAdminCallOptions() *AdminCallOptions {
 		CreateProject:   []gax.CallOption{},
 		UpdateProject:   []gax.CallOption{},
 		DeleteProject:   []gax.CallOption{},
+		UndeleteProject: []gax.CallOption{},
+		ListAuditEvents: []gax.CallOption{},
+		ExportProject:   []gax.CallOption{},
+		ImportProject:   []gax.CallOption{},
+		CreateSnapshot:  []gax.CallOption{},
+		ListSnapshots:   []gax.CallOption{},
+		DeleteSnapshot:  []gax.CallOption{},
+		RestoreSnapshot: []gax.CallOption{},
 	}
 }
 
@@ -91,6 +107,14 @@ type internalAdminClient interface {
 	CreateProject(context.Context, *rpcpb.CreateProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
 	UpdateProject(context.Context, *rpcpb.UpdateProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
 	DeleteProject(context.Context, *rpcpb.DeleteProjectRequest, ...gax.CallOption) error
+	UndeleteProject(context.Context, *rpcpb.UndeleteProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
+	ListAuditEvents(context.Context, *rpcpb.ListAuditEventsRequest, ...gax.CallOption) *AuditEventIterator
+	ExportProject(context.Context, *rpcpb.ExportProjectRequest, ...gax.CallOption) (rpcpb.Admin_ExportProjectClient, error)
+	ImportProject(context.Context, ...gax.CallOption) (rpcpb.Admin_ImportProjectClient, error)
+	CreateSnapshot(context.Context, *rpcpb.CreateSnapshotRequest, ...gax.CallOption) (*rpcpb.Snapshot, error)
+	ListSnapshots(context.Context, *rpcpb.ListSnapshotsRequest, ...gax.CallOption) *SnapshotIterator
+	DeleteSnapshot(context.Context, *rpcpb.DeleteSnapshotRequest, ...gax.CallOption) error
+	RestoreSnapshot(context.Context, *rpcpb.RestoreSnapshotRequest, ...gax.CallOption) (*rpcpb.RestoreSnapshotResponse, error)
 }
 
 // AdminClient is a client for interacting with .
@@ -201,6 +225,58 @@ func (c *AdminClient) DeleteProject(ctx context.Context, req *rpcpb.DeleteProjec
 	return c.internalClient.DeleteProject(ctx, req, opts...)
 }
 
+// UndeleteProject undeleteProject restores a deleted project and the resources that were
+// deleted with it. Deleted projects can be restored until they expire.
+func (c *AdminClient) UndeleteProject(ctx context.Context, req *rpcpb.UndeleteProjectRequest, opts ...gax.CallOption) (*rpcpb.Project, error) {
+	return c.internalClient.UndeleteProject(ctx, req, opts...)
+}
+
+// ListAuditEvents listAuditEvents returns the audit events recorded for changes to
+// registry resources, in the order they were recorded.
+// (– api-linter: core::0132::method-signature=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): audit events are not resources. –)
+func (c *AdminClient) ListAuditEvents(ctx context.Context, req *rpcpb.ListAuditEventsRequest, opts ...gax.CallOption) *AuditEventIterator {
+	return c.internalClient.ListAuditEvents(ctx, req, opts...)
+}
+
+// ExportProject exportProject streams an archive of a project and the resources that it
+// owns, in the YAML layout written by registry export. The resources are
+// read in a single transaction, so the archive is a consistent snapshot.
+func (c *AdminClient) ExportProject(ctx context.Context, req *rpcpb.ExportProjectRequest, opts ...gax.CallOption) (rpcpb.Admin_ExportProjectClient, error) {
+	return c.internalClient.ExportProject(ctx, req, opts...)
+}
+
+// ImportProject importProject creates or updates the project and resources in an archive
+// written by ExportProject. The resources are applied in a single
+// transaction, so if one of them can't be applied, none of them are.
+func (c *AdminClient) ImportProject(ctx context.Context, opts ...gax.CallOption) (rpcpb.Admin_ImportProjectClient, error) {
+	return c.internalClient.ImportProject(ctx, opts...)
+}
+
+// CreateSnapshot createSnapshot records a copy of a project and the resources that it owns.
+func (c *AdminClient) CreateSnapshot(ctx context.Context, req *rpcpb.CreateSnapshotRequest, opts ...gax.CallOption) (*rpcpb.Snapshot, error) {
+	return c.internalClient.CreateSnapshot(ctx, req, opts...)
+}
+
+// ListSnapshots listSnapshots returns the snapshots of a project, oldest first.
+func (c *AdminClient) ListSnapshots(ctx context.Context, req *rpcpb.ListSnapshotsRequest, opts ...gax.CallOption) *SnapshotIterator {
+	return c.internalClient.ListSnapshots(ctx, req, opts...)
+}
+
+// DeleteSnapshot deleteSnapshot removes a snapshot. The contents that it shares with
+// other snapshots and with the project are kept.
+func (c *AdminClient) DeleteSnapshot(ctx context.Context, req *rpcpb.DeleteSnapshotRequest, opts ...gax.CallOption) error {
+	return c.internalClient.DeleteSnapshot(ctx, req, opts...)
+}
+
+// RestoreSnapshot restoreSnapshot replaces a project and the resources that it owns with
+// the copies in a snapshot, creating the project if it doesn't exist.
+// The restore is applied in a single transaction and a notification is
+// sent for every resource that it creates, updates or deletes.
+func (c *AdminClient) RestoreSnapshot(ctx context.Context, req *rpcpb.RestoreSnapshotRequest, opts ...gax.CallOption) (*rpcpb.RestoreSnapshotResponse, error) {
+	return c.internalClient.RestoreSnapshot(ctx, req, opts...)
+}
+
 // adminGRPCClient is a client for interacting with  over gRPC transport.
 //
 // Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
@@ -451,6 +527,188 @@ func (c *adminGRPCClient) DeleteProject(ctx context.Context, req *rpcpb.DeletePr
 	return err
 }
 
+func (c *adminGRPCClient) UndeleteProject(ctx context.Context, req *rpcpb.UndeleteProjectRequest, opts ...gax.CallOption) (*rpcpb.Project, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteProject[0:len((*c.CallOptions).UndeleteProject):len((*c.CallOptions).UndeleteProject)], opts...)
+	var resp *rpcpb.Project
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.UndeleteProject(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) ListAuditEvents(ctx context.Context, req *rpcpb.ListAuditEventsRequest, opts ...gax.CallOption) *AuditEventIterator {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListAuditEvents[0:len((*c.CallOptions).ListAuditEvents):len((*c.CallOptions).ListAuditEvents)], opts...)
+	it := &AuditEventIterator{}
+	req = proto.Clone(req).(*rpcpb.ListAuditEventsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.AuditEvent, string, error) {
+		resp := &rpcpb.ListAuditEventsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.adminClient.ListAuditEvents(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetAuditEvents(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *adminGRPCClient) ExportProject(ctx context.Context, req *rpcpb.ExportProjectRequest, opts ...gax.CallOption) (rpcpb.Admin_ExportProjectClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Admin_ExportProjectClient
+	opts = append((*c.CallOptions).ExportProject[0:len((*c.CallOptions).ExportProject):len((*c.CallOptions).ExportProject)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.ExportProject(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) ImportProject(ctx context.Context, opts ...gax.CallOption) (rpcpb.Admin_ImportProjectClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Admin_ImportProjectClient
+	opts = append((*c.CallOptions).ImportProject[0:len((*c.CallOptions).ImportProject):len((*c.CallOptions).ImportProject)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.ImportProject(ctx, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) CreateSnapshot(ctx context.Context, req *rpcpb.CreateSnapshotRequest, opts ...gax.CallOption) (*rpcpb.Snapshot, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).CreateSnapshot[0:len((*c.CallOptions).CreateSnapshot):len((*c.CallOptions).CreateSnapshot)], opts...)
+	var resp *rpcpb.Snapshot
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.CreateSnapshot(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) ListSnapshots(ctx context.Context, req *rpcpb.ListSnapshotsRequest, opts ...gax.CallOption) *SnapshotIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).ListSnapshots[0:len((*c.CallOptions).ListSnapshots):len((*c.CallOptions).ListSnapshots)], opts...)
+	it := &SnapshotIterator{}
+	req = proto.Clone(req).(*rpcpb.ListSnapshotsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.Snapshot, string, error) {
+		resp := &rpcpb.ListSnapshotsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.adminClient.ListSnapshots(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetSnapshots(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *adminGRPCClient) DeleteSnapshot(ctx context.Context, req *rpcpb.DeleteSnapshotRequest, opts ...gax.CallOption) error {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).DeleteSnapshot[0:len((*c.CallOptions).DeleteSnapshot):len((*c.CallOptions).DeleteSnapshot)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		_, err = c.adminClient.DeleteSnapshot(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
+func (c *adminGRPCClient) RestoreSnapshot(ctx context.Context, req *rpcpb.RestoreSnapshotRequest, opts ...gax.CallOption) (*rpcpb.RestoreSnapshotResponse, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).RestoreSnapshot[0:len((*c.CallOptions).RestoreSnapshot):len((*c.CallOptions).RestoreSnapshot)], opts...)
+	var resp *rpcpb.RestoreSnapshotResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.RestoreSnapshot(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // MigrateDatabaseOperation manages a long-running operation from MigrateDatabase.
 type MigrateDatabaseOperation struct {
 	lro *longrunning.Operation
@@ -520,6 +778,53 @@ func (op *MigrateDatabaseOperation) Name() string {
 	return op.lro.Name()
 }
 
+// AuditEventIterator manages a stream of *rpcpb.AuditEvent.
+type AuditEventIterator struct {
+	items    []*rpcpb.AuditEvent
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.AuditEvent, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *AuditEventIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *AuditEventIterator) Next() (*rpcpb.AuditEvent, error) {
+	var item *rpcpb.AuditEvent
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *AuditEventIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *AuditEventIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
+
 // ProjectIterator manages a stream of *rpcpb.Project.
 type ProjectIterator struct {
 	items    []*rpcpb.Project
@@ -570,3 +875,50 @@ func (it *ProjectIterator) takeBuf() interface{} {
 func (c *AdminClient) GrpcClient() rpcpb.AdminClient {
 	return c.internalClient.(*adminGRPCClient).adminClient
 }
+
+// SnapshotIterator manages a stream of *rpcpb.Snapshot.
+type SnapshotIterator struct {
+	items    []*rpcpb.Snapshot
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.Snapshot, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *SnapshotIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *SnapshotIterator) Next() (*rpcpb.Snapshot, error) {
+	var item *rpcpb.Snapshot
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *SnapshotIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *SnapshotIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
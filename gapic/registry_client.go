@@ -45,17 +45,20 @@ type RegistryCallOptions struct {
 	CreateApi                   []gax.CallOption
 	UpdateApi                   []gax.CallOption
 	DeleteApi                   []gax.CallOption
+	UndeleteApi                 []gax.CallOption
 	ListApiVersions             []gax.CallOption
 	GetApiVersion               []gax.CallOption
 	CreateApiVersion            []gax.CallOption
 	UpdateApiVersion            []gax.CallOption
 	DeleteApiVersion            []gax.CallOption
+	UndeleteApiVersion          []gax.CallOption
 	ListApiSpecs                []gax.CallOption
 	GetApiSpec                  []gax.CallOption
 	GetApiSpecContents          []gax.CallOption
 	CreateApiSpec               []gax.CallOption
 	UpdateApiSpec               []gax.CallOption
 	DeleteApiSpec               []gax.CallOption
+	UndeleteApiSpec             []gax.CallOption
 	TagApiSpecRevision          []gax.CallOption
 	ListApiSpecRevisions        []gax.CallOption
 	RollbackApiSpec             []gax.CallOption
@@ -65,6 +68,7 @@ type RegistryCallOptions struct {
 	CreateApiDeployment         []gax.CallOption
 	UpdateApiDeployment         []gax.CallOption
 	DeleteApiDeployment         []gax.CallOption
+	UndeleteApiDeployment       []gax.CallOption
 	TagApiDeploymentRevision    []gax.CallOption
 	ListApiDeploymentRevisions  []gax.CallOption
 	RollbackApiDeployment       []gax.CallOption
@@ -75,6 +79,12 @@ type RegistryCallOptions struct {
 	CreateArtifact              []gax.CallOption
 	ReplaceArtifact             []gax.CallOption
 	DeleteArtifact              []gax.CallOption
+	UndeleteArtifact            []gax.CallOption
+	WatchResources              []gax.CallOption
+	SearchResources             []gax.CallOption
+	BatchCreateApiSpecs         []gax.CallOption
+	BatchUpdateArtifacts        []gax.CallOption
+	BatchMutate                 []gax.CallOption
 }
 
 func defaultRegistryGRPCClientOptions() []option.ClientOption {
@@ -161,6 +171,20 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApi: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		ListApiVersions: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -231,6 +255,20 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApiVersion: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		ListApiSpecs: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -315,6 +353,20 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApiSpec: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		TagApiSpecRevision: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -441,6 +493,20 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApiDeployment: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		TagApiDeploymentRevision: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -581,6 +647,77 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteArtifact: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		WatchResources: []gax.CallOption{},
+		SearchResources: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchCreateApiSpecs: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchUpdateArtifacts: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchMutate: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.DeadlineExceeded,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 	}
 }
 
@@ -594,17 +731,20 @@ type internalRegistryClient interface {
 	CreateApi(context.Context, *rpcpb.CreateApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
 	UpdateApi(context.Context, *rpcpb.UpdateApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
 	DeleteApi(context.Context, *rpcpb.DeleteApiRequest, ...gax.CallOption) error
+	UndeleteApi(context.Context, *rpcpb.UndeleteApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
 	ListApiVersions(context.Context, *rpcpb.ListApiVersionsRequest, ...gax.CallOption) *ApiVersionIterator
 	GetApiVersion(context.Context, *rpcpb.GetApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	CreateApiVersion(context.Context, *rpcpb.CreateApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	UpdateApiVersion(context.Context, *rpcpb.UpdateApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	DeleteApiVersion(context.Context, *rpcpb.DeleteApiVersionRequest, ...gax.CallOption) error
+	UndeleteApiVersion(context.Context, *rpcpb.UndeleteApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	ListApiSpecs(context.Context, *rpcpb.ListApiSpecsRequest, ...gax.CallOption) *ApiSpecIterator
 	GetApiSpec(context.Context, *rpcpb.GetApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	GetApiSpecContents(context.Context, *rpcpb.GetApiSpecContentsRequest, ...gax.CallOption) (*httpbodypb.HttpBody, error)
 	CreateApiSpec(context.Context, *rpcpb.CreateApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	UpdateApiSpec(context.Context, *rpcpb.UpdateApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	DeleteApiSpec(context.Context, *rpcpb.DeleteApiSpecRequest, ...gax.CallOption) error
+	UndeleteApiSpec(context.Context, *rpcpb.UndeleteApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	TagApiSpecRevision(context.Context, *rpcpb.TagApiSpecRevisionRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	ListApiSpecRevisions(context.Context, *rpcpb.ListApiSpecRevisionsRequest, ...gax.CallOption) *ApiSpecIterator
 	RollbackApiSpec(context.Context, *rpcpb.RollbackApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
@@ -614,6 +754,7 @@ type internalRegistryClient interface {
 	CreateApiDeployment(context.Context, *rpcpb.CreateApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	UpdateApiDeployment(context.Context, *rpcpb.UpdateApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	DeleteApiDeployment(context.Context, *rpcpb.DeleteApiDeploymentRequest, ...gax.CallOption) error
+	UndeleteApiDeployment(context.Context, *rpcpb.UndeleteApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	TagApiDeploymentRevision(context.Context, *rpcpb.TagApiDeploymentRevisionRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	ListApiDeploymentRevisions(context.Context, *rpcpb.ListApiDeploymentRevisionsRequest, ...gax.CallOption) *ApiDeploymentIterator
 	RollbackApiDeployment(context.Context, *rpcpb.RollbackApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
@@ -624,6 +765,12 @@ type internalRegistryClient interface {
 	CreateArtifact(context.Context, *rpcpb.CreateArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
 	ReplaceArtifact(context.Context, *rpcpb.ReplaceArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
 	DeleteArtifact(context.Context, *rpcpb.DeleteArtifactRequest, ...gax.CallOption) error
+	UndeleteArtifact(context.Context, *rpcpb.UndeleteArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
+	WatchResources(context.Context, *rpcpb.WatchResourcesRequest, ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error)
+	SearchResources(context.Context, *rpcpb.SearchResourcesRequest, ...gax.CallOption) *SearchResultIterator
+	BatchCreateApiSpecs(context.Context, *rpcpb.BatchCreateApiSpecsRequest, ...gax.CallOption) (*rpcpb.BatchCreateApiSpecsResponse, error)
+	BatchUpdateArtifacts(context.Context, *rpcpb.BatchUpdateArtifactsRequest, ...gax.CallOption) (*rpcpb.BatchUpdateArtifactsResponse, error)
+	BatchMutate(context.Context, *rpcpb.BatchMutateRequest, ...gax.CallOption) (*rpcpb.BatchMutateResponse, error)
 }
 
 // RegistryClient is a client for interacting with .
@@ -687,6 +834,12 @@ func (c *RegistryClient) DeleteApi(ctx context.Context, req *rpcpb.DeleteApiRequ
 	return c.internalClient.DeleteApi(ctx, req, opts...)
 }
 
+// UndeleteApi undeleteApi restores a deleted API and the resources that were deleted
+// with it. Deleted APIs can be restored until they expire.
+func (c *RegistryClient) UndeleteApi(ctx context.Context, req *rpcpb.UndeleteApiRequest, opts ...gax.CallOption) (*rpcpb.Api, error) {
+	return c.internalClient.UndeleteApi(ctx, req, opts...)
+}
+
 // ListApiVersions listApiVersions returns matching versions.
 func (c *RegistryClient) ListApiVersions(ctx context.Context, req *rpcpb.ListApiVersionsRequest, opts ...gax.CallOption) *ApiVersionIterator {
 	return c.internalClient.ListApiVersions(ctx, req, opts...)
@@ -713,6 +866,12 @@ func (c *RegistryClient) DeleteApiVersion(ctx context.Context, req *rpcpb.Delete
 	return c.internalClient.DeleteApiVersion(ctx, req, opts...)
 }
 
+// UndeleteApiVersion undeleteApiVersion restores a deleted version and the resources that were
+// deleted with it. Deleted versions can be restored until they expire.
+func (c *RegistryClient) UndeleteApiVersion(ctx context.Context, req *rpcpb.UndeleteApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+	return c.internalClient.UndeleteApiVersion(ctx, req, opts...)
+}
+
 // ListApiSpecs listApiSpecs returns matching specs.
 func (c *RegistryClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListApiSpecsRequest, opts ...gax.CallOption) *ApiSpecIterator {
 	return c.internalClient.ListApiSpecs(ctx, req, opts...)
@@ -747,6 +906,12 @@ func (c *RegistryClient) DeleteApiSpec(ctx context.Context, req *rpcpb.DeleteApi
 	return c.internalClient.DeleteApiSpec(ctx, req, opts...)
 }
 
+// UndeleteApiSpec undeleteApiSpec restores a deleted spec, its revisions and the resources
+// that were deleted with it. Deleted specs can be restored until they expire.
+func (c *RegistryClient) UndeleteApiSpec(ctx context.Context, req *rpcpb.UndeleteApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	return c.internalClient.UndeleteApiSpec(ctx, req, opts...)
+}
+
 // TagApiSpecRevision tagApiSpecRevision adds a tag to a specified revision of a spec.
 func (c *RegistryClient) TagApiSpecRevision(ctx context.Context, req *rpcpb.TagApiSpecRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
 	return c.internalClient.TagApiSpecRevision(ctx, req, opts...)
@@ -795,6 +960,13 @@ func (c *RegistryClient) DeleteApiDeployment(ctx context.Context, req *rpcpb.Del
 	return c.internalClient.DeleteApiDeployment(ctx, req, opts...)
 }
 
+// UndeleteApiDeployment undeleteApiDeployment restores a deleted deployment, its revisions and the
+// resources that were deleted with it. Deleted deployments can be restored
+// until they expire.
+func (c *RegistryClient) UndeleteApiDeployment(ctx context.Context, req *rpcpb.UndeleteApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	return c.internalClient.UndeleteApiDeployment(ctx, req, opts...)
+}
+
 // TagApiDeploymentRevision tagApiDeploymentRevision adds a tag to a specified revision of a
 // deployment.
 func (c *RegistryClient) TagApiDeploymentRevision(ctx context.Context, req *rpcpb.TagApiDeploymentRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
@@ -851,6 +1023,43 @@ func (c *RegistryClient) DeleteArtifact(ctx context.Context, req *rpcpb.DeleteAr
 	return c.internalClient.DeleteArtifact(ctx, req, opts...)
 }
 
+// UndeleteArtifact undeleteArtifact restores a deleted artifact. Deleted artifacts can be
+// restored until they expire.
+func (c *RegistryClient) UndeleteArtifact(ctx context.Context, req *rpcpb.UndeleteArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	return c.internalClient.UndeleteArtifact(ctx, req, opts...)
+}
+
+// WatchResources watchResources streams notifications of changes to resources.
+// Unless a resume token is provided, the stream begins with the first
+// change made after the call.
+func (c *RegistryClient) WatchResources(ctx context.Context, req *rpcpb.WatchResourcesRequest, opts ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error) {
+	return c.internalClient.WatchResources(ctx, req, opts...)
+}
+
+// SearchResources searchResources returns the APIs, specs and artifacts that match a query,
+// most relevant first.
+func (c *RegistryClient) SearchResources(ctx context.Context, req *rpcpb.SearchResourcesRequest, opts ...gax.CallOption) *SearchResultIterator {
+	return c.internalClient.SearchResources(ctx, req, opts...)
+}
+
+// BatchCreateApiSpecs batchCreateApiSpecs creates a list of specs in a single transaction.
+// If any spec can't be created, none of them are.
+func (c *RegistryClient) BatchCreateApiSpecs(ctx context.Context, req *rpcpb.BatchCreateApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApiSpecsResponse, error) {
+	return c.internalClient.BatchCreateApiSpecs(ctx, req, opts...)
+}
+
+// BatchUpdateArtifacts batchUpdateArtifacts replaces a list of artifacts in a single transaction.
+// If any artifact can't be replaced, none of them are.
+func (c *RegistryClient) BatchUpdateArtifacts(ctx context.Context, req *rpcpb.BatchUpdateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateArtifactsResponse, error) {
+	return c.internalClient.BatchUpdateArtifacts(ctx, req, opts...)
+}
+
+// BatchMutate batchMutate applies a list of creates, updates and deletes in order
+// in a single transaction. If any mutation fails, none of them are applied.
+func (c *RegistryClient) BatchMutate(ctx context.Context, req *rpcpb.BatchMutateRequest, opts ...gax.CallOption) (*rpcpb.BatchMutateResponse, error) {
+	return c.internalClient.BatchMutate(ctx, req, opts...)
+}
+
 // registryGRPCClient is a client for interacting with  over gRPC transport.
 //
 // Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
@@ -1061,6 +1270,28 @@ func (c *registryGRPCClient) DeleteApi(ctx context.Context, req *rpcpb.DeleteApi
 	return err
 }
 
+func (c *registryGRPCClient) UndeleteApi(ctx context.Context, req *rpcpb.UndeleteApiRequest, opts ...gax.CallOption) (*rpcpb.Api, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApi[0:len((*c.CallOptions).UndeleteApi):len((*c.CallOptions).UndeleteApi)], opts...)
+	var resp *rpcpb.Api
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApi(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *registryGRPCClient) ListApiVersions(ctx context.Context, req *rpcpb.ListApiVersionsRequest, opts ...gax.CallOption) *ApiVersionIterator {
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 
@@ -1190,6 +1421,28 @@ func (c *registryGRPCClient) DeleteApiVersion(ctx context.Context, req *rpcpb.De
 	return err
 }
 
+func (c *registryGRPCClient) UndeleteApiVersion(ctx context.Context, req *rpcpb.UndeleteApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApiVersion[0:len((*c.CallOptions).UndeleteApiVersion):len((*c.CallOptions).UndeleteApiVersion)], opts...)
+	var resp *rpcpb.ApiVersion
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApiVersion(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *registryGRPCClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListApiSpecsRequest, opts ...gax.CallOption) *ApiSpecIterator {
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 
@@ -1341,6 +1594,28 @@ func (c *registryGRPCClient) DeleteApiSpec(ctx context.Context, req *rpcpb.Delet
 	return err
 }
 
+func (c *registryGRPCClient) UndeleteApiSpec(ctx context.Context, req *rpcpb.UndeleteApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApiSpec[0:len((*c.CallOptions).UndeleteApiSpec):len((*c.CallOptions).UndeleteApiSpec)], opts...)
+	var resp *rpcpb.ApiSpec
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApiSpec(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *registryGRPCClient) TagApiSpecRevision(ctx context.Context, req *rpcpb.TagApiSpecRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
@@ -1581,6 +1856,28 @@ func (c *registryGRPCClient) DeleteApiDeployment(ctx context.Context, req *rpcpb
 	return err
 }
 
+func (c *registryGRPCClient) UndeleteApiDeployment(ctx context.Context, req *rpcpb.UndeleteApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApiDeployment[0:len((*c.CallOptions).UndeleteApiDeployment):len((*c.CallOptions).UndeleteApiDeployment)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApiDeployment(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *registryGRPCClient) TagApiDeploymentRevision(ctx context.Context, req *rpcpb.TagApiDeploymentRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
@@ -1843,6 +2140,154 @@ func (c *registryGRPCClient) DeleteArtifact(ctx context.Context, req *rpcpb.Dele
 	return err
 }
 
+func (c *registryGRPCClient) UndeleteArtifact(ctx context.Context, req *rpcpb.UndeleteArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteArtifact[0:len((*c.CallOptions).UndeleteArtifact):len((*c.CallOptions).UndeleteArtifact)], opts...)
+	var resp *rpcpb.Artifact
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteArtifact(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) WatchResources(ctx context.Context, req *rpcpb.WatchResourcesRequest, opts ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_WatchResourcesClient
+	opts = append((*c.CallOptions).WatchResources[0:len((*c.CallOptions).WatchResources):len((*c.CallOptions).WatchResources)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.WatchResources(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) SearchResources(ctx context.Context, req *rpcpb.SearchResourcesRequest, opts ...gax.CallOption) *SearchResultIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).SearchResources[0:len((*c.CallOptions).SearchResources):len((*c.CallOptions).SearchResources)], opts...)
+	it := &SearchResultIterator{}
+	req = proto.Clone(req).(*rpcpb.SearchResourcesRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.SearchResult, string, error) {
+		resp := &rpcpb.SearchResourcesResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.registryClient.SearchResources(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetResults(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *registryGRPCClient) BatchCreateApiSpecs(ctx context.Context, req *rpcpb.BatchCreateApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApiSpecsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchCreateApiSpecs[0:len((*c.CallOptions).BatchCreateApiSpecs):len((*c.CallOptions).BatchCreateApiSpecs)], opts...)
+	var resp *rpcpb.BatchCreateApiSpecsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchCreateApiSpecs(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) BatchUpdateArtifacts(ctx context.Context, req *rpcpb.BatchUpdateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateArtifactsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchUpdateArtifacts[0:len((*c.CallOptions).BatchUpdateArtifacts):len((*c.CallOptions).BatchUpdateArtifacts)], opts...)
+	var resp *rpcpb.BatchUpdateArtifactsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchUpdateArtifacts(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) BatchMutate(ctx context.Context, req *rpcpb.BatchMutateRequest, opts ...gax.CallOption) (*rpcpb.BatchMutateResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 60000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchMutate[0:len((*c.CallOptions).BatchMutate):len((*c.CallOptions).BatchMutate)], opts...)
+	var resp *rpcpb.BatchMutateResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchMutate(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // ApiDeploymentIterator manages a stream of *rpcpb.ApiDeployment.
 type ApiDeploymentIterator struct {
 	items    []*rpcpb.ApiDeployment
@@ -2081,3 +2526,50 @@ func (it *ArtifactIterator) takeBuf() interface{} {
 func (c *RegistryClient) GrpcClient() rpcpb.RegistryClient {
 	return c.internalClient.(*registryGRPCClient).registryClient
 }
+
+// SearchResultIterator manages a stream of *rpcpb.SearchResult.
+type SearchResultIterator struct {
+	items    []*rpcpb.SearchResult
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.SearchResult, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *SearchResultIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *SearchResultIterator) Next() (*rpcpb.SearchResult, error) {
+	var item *rpcpb.SearchResult
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *SearchResultIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *SearchResultIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
@@ -42,8 +42,8 @@ var (
 	ErrCannotDeleteActive = fmt.Errorf("cannot delete active configuration")
 	ErrReservedConfigName = fmt.Errorf("%q is reserved", ActivePointerFilename)
 
-	envBindings    = []string{"registry.address", "registry.insecure", "registry.token"}
-	envKeyReplacer = strings.NewReplacer(".", "_")
+	envBindings    = []string{"registry.address", "registry.insecure", "registry.token", "registry.ca-file", "registry.client-cert-file", "registry.client-key-file"}
+	envKeyReplacer = strings.NewReplacer(".", "_", "-", "_")
 )
 
 func init() {
@@ -63,6 +63,9 @@ func CreateFlagSet() *pflag.FlagSet {
 	flags.String("registry.location", "", "the API Registry location")
 	flags.String("registry.project", "", "the API Registry project")
 	flags.String("registry.token", "", "the token to use for authorization to the API Registry")
+	flags.String("registry.ca-file", "", "a PEM file of certificates that verify the API Registry (default: system certificates)")
+	flags.String("registry.client-cert-file", "", "a PEM file of a client certificate for mutual TLS with the API Registry")
+	flags.String("registry.client-key-file", "", "a PEM file of the private key of the client certificate")
 	return flags
 }
 
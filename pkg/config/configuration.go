@@ -34,11 +34,14 @@ type Configuration struct {
 }
 
 type Registry struct {
-	Address  string `mapstructure:"address" yaml:"address"`   // service address
-	Insecure bool   `mapstructure:"insecure" yaml:"insecure"` // if true, connect over HTTP
-	Location string `mapstructure:"location" yaml:"location"`
-	Project  string `mapstructure:"project" yaml:"project"`
-	Token    string `mapstructure:"token" yaml:"-"` // generated from TokenSource
+	Address        string `mapstructure:"address" yaml:"address"`   // service address
+	Insecure       bool   `mapstructure:"insecure" yaml:"insecure"` // if true, connect over HTTP
+	Location       string `mapstructure:"location" yaml:"location"`
+	Project        string `mapstructure:"project" yaml:"project"`
+	Token          string `mapstructure:"token" yaml:"-"`                           // generated from TokenSource
+	CAFile         string `mapstructure:"ca-file" yaml:"ca-file"`                   // PEM certificates that verify the service
+	ClientCertFile string `mapstructure:"client-cert-file" yaml:"client-cert-file"` // PEM certificate presented for mutual TLS
+	ClientKeyFile  string `mapstructure:"client-key-file" yaml:"client-key-file"`   // PEM key of the client certificate
 }
 
 // if a name is unqualified, attempt this namespace
@@ -112,14 +112,20 @@ func TestSettingsEnvVars(t *testing.T) {
 
 	want := config.Configuration{
 		Registry: config.Registry{
-			Address:  "localhost:8080",
-			Insecure: true,
-			Token:    "token",
+			Address:        "localhost:8080",
+			Insecure:       true,
+			Token:          "token",
+			CAFile:         "ca.crt",
+			ClientCertFile: "client.crt",
+			ClientKeyFile:  "client.key",
 		},
 	}
 	t.Setenv("REGISTRY_ADDRESS", want.Registry.Address)
 	t.Setenv("REGISTRY_INSECURE", strconv.FormatBool(want.Registry.Insecure))
 	t.Setenv("REGISTRY_TOKEN", want.Registry.Token)
+	t.Setenv("REGISTRY_CA_FILE", want.Registry.CAFile)
+	t.Setenv("REGISTRY_CLIENT_CERT_FILE", want.Registry.ClientCertFile)
+	t.Setenv("REGISTRY_CLIENT_KEY_FILE", want.Registry.ClientKeyFile)
 
 	got, err := config.Active()
 	if err != nil {
@@ -189,11 +195,14 @@ func TestSettingsFlags(t *testing.T) {
 
 	want := config.Configuration{
 		Registry: config.Registry{
-			Address:  "localhost:8080",
-			Insecure: true,
-			Location: "location",
-			Project:  "project",
-			Token:    "token",
+			Address:        "localhost:8080",
+			Insecure:       true,
+			Location:       "location",
+			Project:        "project",
+			Token:          "token",
+			CAFile:         "ca.crt",
+			ClientCertFile: "client.crt",
+			ClientKeyFile:  "client.key",
 		},
 	}
 	args := []string{
@@ -203,6 +212,9 @@ func TestSettingsFlags(t *testing.T) {
 		"--registry.location", want.Registry.Location,
 		"--registry.project", want.Registry.Project,
 		"--registry.token", want.Registry.Token,
+		"--registry.ca-file", want.Registry.CAFile,
+		"--registry.client-cert-file", want.Registry.ClientCertFile,
+		"--registry.client-key-file", want.Registry.ClientKeyFile,
 	}
 	config.Flags = config.CreateFlagSet()
 	defer func() { config.Flags = config.CreateFlagSet() }()
@@ -242,20 +254,26 @@ func TestManipulations(t *testing.T) {
 
 	c := config.Configuration{
 		Registry: config.Registry{
-			Address:  "address",
-			Insecure: true,
-			Location: "location",
-			Project:  "project",
-			Token:    "token",
+			Address:        "address",
+			Insecure:       true,
+			Location:       "location",
+			Project:        "project",
+			Token:          "token",
+			CAFile:         "ca.crt",
+			ClientCertFile: "client.crt",
+			ClientKeyFile:  "client.key",
 		},
 	}
 	want := map[string]interface{}{
-		"registry.address":  c.Registry.Address,
-		"registry.insecure": c.Registry.Insecure,
-		"registry.location": c.Registry.Location,
-		"registry.project":  c.Registry.Project,
-		"registry.token":    c.Registry.Token,
-		"token-source":      "",
+		"registry.address":          c.Registry.Address,
+		"registry.insecure":         c.Registry.Insecure,
+		"registry.location":         c.Registry.Location,
+		"registry.project":          c.Registry.Project,
+		"registry.token":            c.Registry.Token,
+		"registry.ca-file":          c.Registry.CAFile,
+		"registry.client-cert-file": c.Registry.ClientCertFile,
+		"registry.client-key-file":  c.Registry.ClientKeyFile,
+		"token-source":              "",
 	}
 	m, err := c.FlatMap()
 	if err != nil {
@@ -451,6 +469,9 @@ func TestProperies(t *testing.T) {
 	got := c.Properties()
 	want := []string{
 		"registry.address",
+		"registry.ca-file",
+		"registry.client-cert-file",
+		"registry.client-key-file",
 		"registry.insecure",
 		"registry.location",
 		"registry.project",
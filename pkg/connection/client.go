@@ -16,13 +16,18 @@ package connection
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"os"
 
 	"github.com/apigee/registry/gapic"
 	"golang.org/x/oauth2"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials/oauth"
 )
 
 func clientOptions(config Config) ([]option.ClientOption, error) {
@@ -32,7 +37,32 @@ func clientOptions(config Config) ([]option.ClientOption, error) {
 	}
 	opts = append(opts, option.WithEndpoint(config.Address))
 	if config.Insecure {
-		conn, err := grpc.Dial(config.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+		// Token sources are ignored for connections that are passed in,
+		// so tokens are sent by the connection itself.
+		if config.Token != "" {
+			dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(insecureToken(config.Token)))
+		}
+		conn, err := grpc.Dial(config.Address, dialOpts...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, option.WithGRPCConn(conn))
+	} else if config.CAFile != "" || config.ClientCertFile != "" {
+		tlsConfig, err := config.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+		if config.Token != "" {
+			dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(oauth.TokenSource{
+				TokenSource: oauth2.StaticTokenSource(&oauth2.Token{
+					AccessToken: config.Token,
+					TokenType:   "Bearer",
+				}),
+			}))
+		}
+		conn, err := grpc.Dial(config.Address, dialOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -48,9 +78,67 @@ func clientOptions(config Config) ([]option.ClientOption, error) {
 	return opts, nil
 }
 
+// tlsConfig returns the TLS configuration of connections that verify the
+// service with the configured CA certificates or present a client certificate.
+func (c Config) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", c.CAFile)
+		}
+	}
+	if c.ClientCertFile != "" || c.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %s", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// insecureToken sends a bearer token with calls over insecure connections,
+// which are used with local servers.
+type insecureToken string
+
+func (t insecureToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (insecureToken) RequireTransportSecurity() bool {
+	return false
+}
+
 // RegistryClient is a client of the Registry API
 type RegistryClient = *gapic.RegistryClient
 
+type sharedClientsKey struct{}
+
+type sharedClients struct {
+	registry RegistryClient
+	admin    AdminClient
+}
+
+// WithClients returns a context in which NewRegistryClient and NewAdminClient
+// (and their WithSettings variants) return the specified clients instead of
+// creating new ones. This allows commands that are run in-process to share a
+// connection. Nil clients are ignored.
+func WithClients(ctx context.Context, registry RegistryClient, admin AdminClient) context.Context {
+	return context.WithValue(ctx, sharedClientsKey{}, &sharedClients{registry: registry, admin: admin})
+}
+
+func shared(ctx context.Context) *sharedClients {
+	if c, ok := ctx.Value(sharedClientsKey{}).(*sharedClients); ok {
+		return c
+	}
+	return &sharedClients{}
+}
+
 // NewRegistryClient creates a new client using the active Config.
 func NewRegistryClient(ctx context.Context) (RegistryClient, error) {
 	c, err := ActiveConfig()
@@ -62,6 +150,9 @@ func NewRegistryClient(ctx context.Context) (RegistryClient, error) {
 
 // NewRegistryClientWithSettings creates a client with specified Config.
 func NewRegistryClientWithSettings(ctx context.Context, config Config) (RegistryClient, error) {
+	if c := shared(ctx).registry; c != nil {
+		return c, nil
+	}
 	opts, err := clientOptions(config)
 	if err != nil {
 		return nil, err
@@ -86,6 +177,9 @@ func NewAdminClient(ctx context.Context) (AdminClient, error) {
 
 // NewAdminClientWithSettings creates a client with specified Config.
 func NewAdminClientWithSettings(ctx context.Context, config Config) (AdminClient, error) {
+	if c := shared(ctx).admin; c != nil {
+		return c, nil
+	}
 	opts, err := clientOptions(config)
 	if err != nil {
 		return nil, err
@@ -75,3 +75,34 @@ func TestClientGoodConfig(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestClientShared(t *testing.T) {
+	t.Cleanup(test.CleanConfigDir(t))
+	t.Setenv("REGISTRY_ADDRESS", "localhost:8080")
+	t.Setenv("REGISTRY_INSECURE", "true")
+
+	ctx := context.Background()
+	registryClient, err := NewRegistryClient(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	adminClient, err := NewAdminClient(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Shared clients are returned even if the config is invalid.
+	ctx = WithClients(ctx, registryClient, adminClient)
+	if got, err := NewRegistryClientWithSettings(ctx, Config{}); err != nil || got != registryClient {
+		t.Errorf("NewRegistryClientWithSettings() = %v, %v; want the shared client", got, err)
+	}
+	if got, err := NewAdminClientWithSettings(ctx, Config{}); err != nil || got != adminClient {
+		t.Errorf("NewAdminClientWithSettings() = %v, %v; want the shared client", got, err)
+	}
+
+	// Clients that aren't shared are created.
+	ctx = WithClients(context.Background(), registryClient, nil)
+	if _, err := NewAdminClientWithSettings(ctx, Config{}); err == nil {
+		t.Errorf("NewAdminClientWithSettings() with an invalid config and no shared client succeeded, want error")
+	}
+}
@@ -24,11 +24,14 @@ import (
 
 // Config configures the client.
 type Config struct {
-	Address  string `mapstructure:"address"`  // service address
-	Insecure bool   `mapstructure:"insecure"` // if true, connect over HTTP
-	Location string `mapstructure:"location"` // optional
-	Project  string `mapstructure:"project"`  // optional
-	Token    string `mapstructure:"token"`    // bearer token
+	Address        string `mapstructure:"address"`          // service address
+	Insecure       bool   `mapstructure:"insecure"`         // if true, connect over HTTP
+	Location       string `mapstructure:"location"`         // optional
+	Project        string `mapstructure:"project"`          // optional
+	Token          string `mapstructure:"token"`            // bearer token
+	CAFile         string `mapstructure:"ca-file"`          // optional, PEM certificates that verify the service
+	ClientCertFile string `mapstructure:"client-cert-file"` // optional, PEM certificate for mutual TLS
+	ClientKeyFile  string `mapstructure:"client-key-file"`  // optional, PEM key of the client certificate
 }
 
 // If set, ActiveConfig() returns this configuration.
@@ -69,6 +72,10 @@ func ReadConfig(name string) (Config, error) {
 		Location: c.Registry.Location,
 		Project:  c.Registry.Project,
 		Token:    c.Registry.Token,
+
+		CAFile:         c.Registry.CAFile,
+		ClientCertFile: c.Registry.ClientCertFile,
+		ClientKeyFile:  c.Registry.ClientKeyFile,
 	}
 
 	return config, err
@@ -146,6 +146,7 @@ func SetupRegistry(ctx context.Context, t *testing.T, projectID string, seeds []
 // sqlite3 on a tmpDir is automatically created.
 // registry.address and registry.insecure configuration values are set
 // to cause the in-process client to connect to the created grpc service.
+// If rc.TLS is configured, clients must be configured to verify the server.
 // Call Close() when done to close server and clean up tmpDir as needed.
 // Example:
 //
@@ -196,7 +197,7 @@ func NewServer(rc registry.Config) (*Server, error) {
 			return nil, err
 		}
 	}
-	conf.Insecure = true
+	conf.Insecure = rc.TLS.CertFile == ""
 	conf.Address = addr
 	connection.SetConfig(conf)
 
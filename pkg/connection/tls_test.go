@@ -0,0 +1,171 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connection_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// writeCertificates writes a CA certificate and certificates that it
+// issued for a server and a client to dir.
+func writeCertificates(t *testing.T, dir string) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Setup: failed to generate key: %s", err)
+	}
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	write := func(name string, template *x509.Certificate, key *ecdsa.PrivateKey) {
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatalf("Setup: failed to create certificate: %s", err)
+		}
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			t.Fatalf("Setup: failed to marshal key: %s", err)
+		}
+		for file, block := range map[string]*pem.Block{
+			name + ".crt": {Type: "CERTIFICATE", Bytes: der},
+			name + ".key": {Type: "EC PRIVATE KEY", Bytes: keyDER},
+		} {
+			if err := os.WriteFile(filepath.Join(dir, file), pem.EncodeToMemory(block), 0600); err != nil {
+				t.Fatalf("Setup: failed to write %s: %s", file, err)
+			}
+		}
+	}
+	write("ca", ca, caKey)
+	for i, name := range []string{"server", "client"} {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("Setup: failed to generate key: %s", err)
+		}
+		usage := x509.ExtKeyUsageServerAuth
+		if name == "client" {
+			usage = x509.ExtKeyUsageClientAuth
+		}
+		write(name, &x509.Certificate{
+			SerialNumber:   big.NewInt(int64(i + 2)),
+			Subject:        pkix.Name{CommonName: name},
+			DNSNames:       []string{"localhost"},
+			EmailAddresses: []string{name + "@example.com"},
+			NotBefore:      time.Now().Add(-time.Hour),
+			NotAfter:       time.Now().Add(time.Hour),
+			KeyUsage:       x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:    []x509.ExtKeyUsage{usage},
+		}, key)
+	}
+}
+
+func TestClientWithTLS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writeCertificates(t, dir)
+	server, err := grpctest.NewServer(registry.Config{
+		TLS: registry.TLSConfig{
+			CertFile:     filepath.Join(dir, "server.crt"),
+			KeyFile:      filepath.Join(dir, "server.key"),
+			ClientCAFile: filepath.Join(dir, "ca.crt"),
+		},
+		Authenticators: []auth.Authenticator{auth.ClientCertificates{}},
+		Policy: &auth.Policy{Bindings: []auth.Binding{
+			{Principal: "client@example.com", Project: "my-project", Role: auth.Admin},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to start server: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	config, err := connection.ActiveConfig()
+	if err != nil {
+		t.Fatalf("Setup: failed to get config: %s", err)
+	}
+	if config.Insecure {
+		t.Fatalf("Setup: test server with TLS configured clients to be insecure")
+	}
+	config.CAFile = filepath.Join(dir, "ca.crt")
+	config.ClientCertFile = filepath.Join(dir, "client.crt")
+	config.ClientKeyFile = filepath.Join(dir, "client.key")
+
+	client, err := connection.NewAdminClientWithSettings(ctx, config)
+	if err != nil {
+		t.Fatalf("NewAdminClientWithSettings() returned error: %s", err)
+	}
+	defer client.Close()
+	// The principal of the client certificate is authorized in its project.
+	if _, err := client.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "my-project"}); err != nil {
+		t.Errorf("CreateProject() returned error: %s", err)
+	}
+	if _, err := client.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "other-project"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateProject() returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+
+	// Servers that require client certificates refuse clients without them.
+	config.ClientCertFile, config.ClientKeyFile = "", ""
+	client, err = connection.NewAdminClientWithSettings(ctx, config)
+	if err != nil {
+		t.Fatalf("NewAdminClientWithSettings() returned error: %s", err)
+	}
+	defer client.Close()
+	if _, err := client.GetStatus(ctx, &emptypb.Empty{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("GetStatus() without a client certificate returned status code %s, want %s", status.Code(err), codes.Unavailable)
+	}
+
+	// Servers are verified with the configured CA certificates.
+	config.CAFile = filepath.Join(dir, "client.crt")
+	config.ClientCertFile = filepath.Join(dir, "client.crt")
+	config.ClientKeyFile = filepath.Join(dir, "client.key")
+	client, err = connection.NewAdminClientWithSettings(ctx, config)
+	if err != nil {
+		t.Fatalf("NewAdminClientWithSettings() returned error: %s", err)
+	}
+	defer client.Close()
+	if _, err := client.GetStatus(ctx, &emptypb.Empty{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("GetStatus() with an untrusted server returned status code %s, want %s", status.Code(err), codes.Unavailable)
+	}
+
+	config.ClientKeyFile = filepath.Join(dir, "missing.key")
+	if _, err := connection.NewAdminClientWithSettings(ctx, config); err == nil {
+		t.Errorf("NewAdminClientWithSettings() with a missing key returned no error")
+	}
+}
@@ -25,6 +25,7 @@ type Metadata struct {
 	Parent      string            `yaml:"parent,omitempty"`
 	Labels      map[string]string `yaml:"labels,omitempty"`
 	Annotations map[string]string `yaml:"annotations,omitempty"`
+	Etag        string            `yaml:"etag,omitempty"`
 }
 
 const RegistryV1 = "apigeeregistry/v1"
@@ -0,0 +1,345 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// NewProject returns the YAML representation of a project.
+func NewProject(message *rpc.Project) (*Project, error) {
+	projectName, err := names.ParseProject(message.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &Project{
+		Header: Header{
+			ApiVersion: RegistryV1,
+			Kind:       "Project",
+			Metadata: Metadata{
+				Name: projectName.ProjectID,
+				Etag: message.Etag,
+			},
+		},
+		Data: ProjectData{
+			DisplayName: message.DisplayName,
+			Description: message.Description,
+		},
+	}, nil
+}
+
+// NewApi returns the YAML representation of an API without its children.
+func NewApi(message *rpc.Api) (*Api, error) {
+	apiName, err := names.ParseApi(message.Name)
+	if err != nil {
+		return nil, err
+	}
+	recommendedVersion, err := relativeVersionName(apiName, message.RecommendedVersion)
+	if err != nil {
+		return nil, err
+	}
+	recommendedDeployment, err := relativeDeploymentName(apiName, message.RecommendedDeployment)
+	if err != nil {
+		return nil, err
+	}
+	return &Api{
+		Header: Header{
+			ApiVersion: RegistryV1,
+			Kind:       "API",
+			Metadata: Metadata{
+				Name:        apiName.ApiID,
+				Labels:      message.Labels,
+				Annotations: message.Annotations,
+				Etag:        message.Etag,
+			},
+		},
+		Data: ApiData{
+			DisplayName:           message.DisplayName,
+			Description:           message.Description,
+			Availability:          message.Availability,
+			RecommendedVersion:    recommendedVersion,
+			RecommendedDeployment: recommendedDeployment,
+		},
+	}, nil
+}
+
+// NewApiVersion returns the YAML representation of an API version without its children.
+func NewApiVersion(message *rpc.ApiVersion) (*ApiVersion, error) {
+	versionName, err := names.ParseVersion(message.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &ApiVersion{
+		Header: Header{
+			ApiVersion: RegistryV1,
+			Kind:       "Version",
+			Metadata: Metadata{
+				Name:        versionName.VersionID,
+				Parent:      names.ExportableName(versionName.Parent(), versionName.ProjectID),
+				Labels:      message.Labels,
+				Annotations: message.Annotations,
+				Etag:        message.Etag,
+			},
+		},
+		Data: ApiVersionData{
+			DisplayName: message.DisplayName,
+			Description: message.Description,
+			State:       message.State,
+			PrimarySpec: message.PrimarySpec,
+		},
+	}, nil
+}
+
+// NewApiSpec returns the YAML representation of an API spec without its children.
+// The contents of the spec are not included.
+func NewApiSpec(message *rpc.ApiSpec) (*ApiSpec, error) {
+	specName, err := names.ParseSpecRevision(message.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &ApiSpec{
+		Header: Header{
+			ApiVersion: RegistryV1,
+			Kind:       "Spec",
+			Metadata: Metadata{
+				Name:        specName.SpecID,
+				Parent:      names.ExportableName(specName.Parent(), specName.ProjectID),
+				Labels:      message.Labels,
+				Annotations: message.Annotations,
+				Etag:        message.Etag,
+			},
+		},
+		Data: ApiSpecData{
+			FileName:    message.Filename,
+			Description: message.Description,
+			MimeType:    message.MimeType,
+			SourceURI:   message.SourceUri,
+		},
+	}, nil
+}
+
+// NewApiDeployment returns the YAML representation of an API deployment without its children.
+func NewApiDeployment(message *rpc.ApiDeployment) (*ApiDeployment, error) {
+	deploymentName, err := names.ParseDeploymentRevision(message.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &ApiDeployment{
+		Header: Header{
+			ApiVersion: RegistryV1,
+			Kind:       "Deployment",
+			Metadata: Metadata{
+				Name:        deploymentName.DeploymentID,
+				Parent:      names.ExportableName(deploymentName.Parent(), deploymentName.ProjectID),
+				Labels:      message.Labels,
+				Annotations: message.Annotations,
+				Etag:        message.Etag,
+			},
+		},
+		Data: ApiDeploymentData{
+			DisplayName:        message.DisplayName,
+			Description:        message.Description,
+			EndpointURI:        message.EndpointUri,
+			ExternalChannelURI: message.ExternalChannelUri,
+			IntendedAudience:   message.IntendedAudience,
+			AccessGuidance:     message.AccessGuidance,
+			ApiSpecRevision:    relativeSpecRevisionName(deploymentName.Api(), message.ApiSpecRevision),
+		},
+	}, nil
+}
+
+// NewArtifact returns the YAML representation of an artifact.
+// The artifact's contents must be set.
+func NewArtifact(message *rpc.Artifact) (*Artifact, error) {
+	artifactName, err := names.ParseArtifact(message.Name)
+	if err != nil {
+		return nil, err
+	}
+	var node *yaml.Node
+	if strings.HasPrefix(message.MimeType, "application/yaml") {
+		var doc yaml.Node
+		err = yaml.Unmarshal(message.Contents, &doc)
+		if err != nil {
+			return nil, err
+		}
+		// The top-level node is a "document" node. We need to marshal the node below it.
+		node = doc.Content[0]
+	} else {
+		m, err := mime.MessageForMimeType(message.MimeType)
+		if err != nil {
+			return nil, err
+		}
+		// Unmarshal the serialized protobuf containing the artifact content.
+		if err = proto.Unmarshal(message.Contents, m); err != nil {
+			return nil, err
+		}
+		if node, err = NodeForMessage(m); err != nil {
+			return nil, err
+		}
+	}
+	// Wrap the artifact for YAML export.
+	return &Artifact{
+		Header: Header{
+			ApiVersion: RegistryV1,
+			Kind:       mime.KindForMimeType(message.MimeType),
+			Metadata: Metadata{
+				Name:        artifactName.ArtifactID(),
+				Parent:      names.ExportableName(artifactName.Parent(), artifactName.ProjectID()),
+				Labels:      message.Labels,
+				Annotations: message.Annotations,
+				Etag:        message.Etag,
+			},
+		},
+		Data: *node,
+	}, nil
+}
+
+// ArtifactContents returns the MIME type and serialized contents of an
+// artifact read from YAML. If storeAsYaml is set, artifacts with message
+// types are stored as YAML instead of as serialized messages.
+func ArtifactContents(content *Artifact, storeAsYaml bool) (string, []byte, error) {
+	// Restyle the YAML representation so that yaml.Marshal will marshal it as JSON.
+	StyleForJSON(&content.Data)
+	// Marshal the YAML representation into the JSON serialization.
+	j, err := yaml.Marshal(content.Data)
+	if err != nil {
+		return "", nil, err
+	}
+	// Populate Id and Kind fields in the contents of the artifact
+	jWithIdAndKind, err := populateIdAndKind(j, content.Kind, content.Metadata.Name)
+	if err != nil {
+		return "", nil, err
+	}
+	var mimeType string
+	var bytes []byte
+	// Unmarshal the JSON serialization into the message struct.
+	var m proto.Message
+	m, err = mime.MessageForKind(content.Kind)
+	if err == nil {
+		err = protojson.Unmarshal(jWithIdAndKind, m)
+		if err != nil {
+			if strings.Contains(err.Error(), "unknown field") {
+				// Try unmarshaling the original YAML (without the additional Id and Kind fields).
+				err = protojson.Unmarshal(j, m)
+				if err != nil {
+					return "", nil, err
+				}
+			}
+		}
+		if storeAsYaml {
+			mimeType = mime.YamlMimeTypeForKind(content.Kind)
+			StyleForYAML(&content.Data)
+			bytes, err = yaml.Marshal(content.Data)
+			if err != nil {
+				return "", nil, err
+			}
+		} else {
+			mimeType = mime.MimeTypeForKind(content.Kind)
+			// Marshal the message struct to bytes.
+			bytes, err = proto.Marshal(m)
+			if err != nil {
+				return "", nil, err
+			}
+		}
+	} else {
+		// If there was no struct defined for the type, marshal it struct as YAML
+		mimeType = mime.MimeTypeForKind(content.Kind)
+		StyleForYAML(&content.Data)
+		bytes, err = yaml.Marshal(content.Data)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	return mimeType, bytes, nil
+}
+
+// populateIdAndKind inserts the "id" and "kind" fields in the supplied json bytes.
+func populateIdAndKind(bytes []byte, kind, id string) ([]byte, error) {
+	var jsonData map[string]interface{}
+	err := json.Unmarshal(bytes, &jsonData)
+	if err != nil {
+		return nil, err
+	}
+	if jsonData == nil {
+		return nil, errors.New("missing data")
+	}
+	jsonData["id"] = id
+	jsonData["kind"] = kind
+
+	rBytes, err := json.Marshal(jsonData)
+	if err != nil {
+		return nil, err
+	}
+
+	return rBytes, nil
+}
+
+// TODO: These functions assume that their arguments are valid names and fail the export if they aren't.
+// They are used to replace the absolute resource names stored by the API with more reusable relative
+// resource names that allow the exported files to be applied to arbitrary projects. This more
+// concise form is also more convenient for users to specify. But it requires additional validation.
+// Either we 1) only allow relative names in these fields in the YAML representation or
+// 2) allow both absolute and relative names in these fields and handle them appropriately.
+// We should probably support only one output format; for this, relative names seem preferable.
+
+// relativeVersionName returns the version id if the version is within the specified API
+func relativeVersionName(apiName names.Api, version string) (string, error) {
+	if version == "" {
+		return "", nil
+	}
+	versionName, err := names.ParseVersion(version)
+	if err != nil {
+		return "", err
+	}
+	if versionName.Api().String() == apiName.String() {
+		return versionName.VersionID, nil
+	}
+	return version, nil
+}
+
+// relativeDeploymentName returns the deployment id if the deployment is within the specified API
+func relativeDeploymentName(apiName names.Api, deployment string) (string, error) {
+	if deployment == "" {
+		return "", nil
+	}
+	deploymentName, err := names.ParseDeployment(deployment)
+	if err != nil {
+		return "", err
+	}
+	if deploymentName.Api().String() == apiName.String() {
+		return deploymentName.DeploymentID, nil
+	}
+	return deployment, nil
+}
+
+// relativeSpecRevisionName returns the versionid+specid if the spec is within the specified API
+func relativeSpecRevisionName(apiName names.Api, spec string) string {
+	if spec == "" {
+		return ""
+	}
+	if strings.HasPrefix(spec, apiName.String()) {
+		return strings.TrimPrefix(spec, apiName.String()+"/versions/")
+	}
+	return spec
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/apigee/registry/pkg/application/apihub"
 	"github.com/apigee/registry/pkg/application/controller"
+	"github.com/apigee/registry/pkg/application/diff"
 	"github.com/apigee/registry/pkg/application/scoring"
 	"github.com/apigee/registry/pkg/application/style"
 	metrics "github.com/google/gnostic/metrics"
@@ -189,6 +190,7 @@ var artifactMessageTypes map[string]messageFactory = map[string]messageFactory{
 	"google.cloud.apigeeregistry.v1.apihub.TaxonomyList":         func() proto.Message { return new(apihub.TaxonomyList) },
 	"google.cloud.apigeeregistry.v1.controller.Manifest":         func() proto.Message { return new(controller.Manifest) },
 	"google.cloud.apigeeregistry.v1.controller.Receipt":          func() proto.Message { return new(controller.Receipt) },
+	"google.cloud.apigeeregistry.v1.diff.Differences":            func() proto.Message { return new(diff.Differences) },
 	"google.cloud.apigeeregistry.v1.scoring.Score":               func() proto.Message { return new(scoring.Score) },
 	"google.cloud.apigeeregistry.v1.scoring.ScoreDefinition":     func() proto.Message { return new(scoring.ScoreDefinition) },
 	"google.cloud.apigeeregistry.v1.scoring.ScoreCard":           func() proto.Message { return new(scoring.ScoreCard) },
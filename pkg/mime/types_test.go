@@ -209,6 +209,11 @@ func TestProtobufMessageTypes(t *testing.T) {
 			messageType: "google.cloud.apigeeregistry.v1.controller.Receipt",
 			mimeType:    "application/octet-stream;type=google.cloud.apigeeregistry.v1.controller.Receipt",
 		},
+		{
+			kind:        "Differences",
+			messageType: "google.cloud.apigeeregistry.v1.diff.Differences",
+			mimeType:    "application/octet-stream;type=google.cloud.apigeeregistry.v1.diff.Differences",
+		},
 		{
 			kind:        "Score",
 			messageType: "google.cloud.apigeeregistry.v1.scoring.Score",
@@ -0,0 +1,76 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Snapshot represents a resource name for a project snapshot.
+type Snapshot struct {
+	ProjectID  string
+	SnapshotID string
+}
+
+// Validate returns an error if the resource name is invalid.
+// For backward compatibility, names should only be validated at creation time.
+func (s Snapshot) Validate() error {
+	if err := validateID(s.SnapshotID); err != nil {
+		return err
+	}
+
+	r := snapshotRegexp()
+	if name := s.String(); !r.MatchString(name) {
+		return fmt.Errorf("invalid snapshot name %q: must match %q", name, r)
+	}
+
+	return nil
+}
+
+// Project returns the name of the project that this snapshot was taken of.
+func (s Snapshot) Project() Project {
+	return Project{
+		ProjectID: s.ProjectID,
+	}
+}
+
+// Parent returns this resource's parent project resource name.
+func (s Snapshot) Parent() string {
+	return s.Project().String()
+}
+
+func (s Snapshot) String() string {
+	return normalize(fmt.Sprintf("projects/%s/snapshots/%s", s.ProjectID, s.SnapshotID))
+}
+
+// snapshotRegexp returns a regular expression that matches a snapshot resource name.
+func snapshotRegexp() *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf("^projects/%s/snapshots/%s$", identifier, identifier))
+}
+
+// ParseSnapshot parses the name of a snapshot.
+func ParseSnapshot(name string) (Snapshot, error) {
+	r := snapshotRegexp()
+	if !r.MatchString(name) {
+		return Snapshot{}, fmt.Errorf("invalid snapshot name %q: must match %q", name, r)
+	}
+
+	m := r.FindStringSubmatch(name)
+	return Snapshot{
+		ProjectID:  m[1],
+		SnapshotID: m[2],
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package names
+
+import (
+	"testing"
+)
+
+func TestSnapshotNames(t *testing.T) {
+	name, err := ParseSnapshot("projects/p/snapshots/s")
+	if err != nil {
+		t.Fatalf("ParseSnapshot() failed: %s", err)
+	}
+	if err := name.Validate(); err != nil {
+		t.Errorf("Validate() failed for name %s: %s", name, err)
+	}
+	if name.Project().String() != "projects/p" {
+		t.Errorf("%s Project() returned incorrect value %s", name, name.Project())
+	}
+	if name.Parent() != "projects/p" {
+		t.Errorf("%s Parent() returned incorrect value %s", name, name.Parent())
+	}
+	if name.String() != "projects/p/snapshots/s" {
+		t.Errorf("%s String() returned incorrect value %s", name, name.String())
+	}
+}
+
+func TestInvalidSnapshotNames(t *testing.T) {
+	for _, name := range []string{
+		"projects/p",
+		"projects/p/snapshots",
+		"projects/p/snapshots/s/x",
+		"projects/p/locations/global/snapshots/s",
+	} {
+		if _, err := ParseSnapshot(name); err == nil {
+			t.Errorf("ParseSnapshot(%q) succeeded and should have failed", name)
+		}
+	}
+	for _, name := range []Snapshot{
+		{ProjectID: "p", SnapshotID: "!!"},
+		{ProjectID: "p", SnapshotID: ""},
+	} {
+		if err := name.Validate(); err == nil {
+			t.Errorf("Validate() succeeded for %s and should have failed", name)
+		}
+	}
+}
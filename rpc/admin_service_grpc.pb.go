@@ -43,6 +43,14 @@ const (
 	Admin_CreateProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/CreateProject"
 	Admin_UpdateProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/UpdateProject"
 	Admin_DeleteProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/DeleteProject"
+	Admin_UndeleteProject_FullMethodName = "/google.cloud.apigeeregistry.v1.Admin/UndeleteProject"
+	Admin_ListAuditEvents_FullMethodName = "/google.cloud.apigeeregistry.v1.Admin/ListAuditEvents"
+	Admin_ExportProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/ExportProject"
+	Admin_ImportProject_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/ImportProject"
+	Admin_CreateSnapshot_FullMethodName  = "/google.cloud.apigeeregistry.v1.Admin/CreateSnapshot"
+	Admin_ListSnapshots_FullMethodName   = "/google.cloud.apigeeregistry.v1.Admin/ListSnapshots"
+	Admin_DeleteSnapshot_FullMethodName  = "/google.cloud.apigeeregistry.v1.Admin/DeleteSnapshot"
+	Admin_RestoreSnapshot_FullMethodName = "/google.cloud.apigeeregistry.v1.Admin/RestoreSnapshot"
 )
 
 // AdminClient is the client API for Admin service.
@@ -100,6 +108,35 @@ type AdminClient interface {
 	// DeleteProject removes a specified project and all of the resources that it
 	// owns.
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteProject restores a deleted project and the resources that were
+	// deleted with it. Deleted projects can be restored until they expire.
+	UndeleteProject(ctx context.Context, in *UndeleteProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	// ListAuditEvents returns the audit events recorded for changes to
+	// registry resources, in the order they were recorded.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: audit events are not resources. --)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// ExportProject streams an archive of a project and the resources that it
+	// owns, in the YAML layout written by `registry export`. The resources are
+	// read in a single transaction, so the archive is a consistent snapshot.
+	ExportProject(ctx context.Context, in *ExportProjectRequest, opts ...grpc.CallOption) (Admin_ExportProjectClient, error)
+	// ImportProject creates or updates the project and resources in an archive
+	// written by ExportProject. The resources are applied in a single
+	// transaction, so if one of them can't be applied, none of them are.
+	ImportProject(ctx context.Context, opts ...grpc.CallOption) (Admin_ImportProjectClient, error)
+	// CreateSnapshot records a copy of a project and the resources that it owns.
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error)
+	// ListSnapshots returns the snapshots of a project, oldest first.
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// DeleteSnapshot removes a snapshot. The contents that it shares with
+	// other snapshots and with the project are kept.
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RestoreSnapshot replaces a project and the resources that it owns with
+	// the copies in a snapshot, creating the project if it doesn't exist.
+	// The restore is applied in a single transaction and a notification is
+	// sent for every resource that it creates, updates or deletes.
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
 }
 
 type adminClient struct {
@@ -182,6 +219,126 @@ func (c *adminClient) DeleteProject(ctx context.Context, in *DeleteProjectReques
 	return out, nil
 }
 
+func (c *adminClient) UndeleteProject(ctx context.Context, in *UndeleteProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	out := new(Project)
+	err := c.cc.Invoke(ctx, Admin_UndeleteProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Admin_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ExportProject(ctx context.Context, in *ExportProjectRequest, opts ...grpc.CallOption) (Admin_ExportProjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[0], Admin_ExportProject_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminExportProjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_ExportProjectClient interface {
+	Recv() (*ExportProjectResponse, error)
+	grpc.ClientStream
+}
+
+type adminExportProjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminExportProjectClient) Recv() (*ExportProjectResponse, error) {
+	m := new(ExportProjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) ImportProject(ctx context.Context, opts ...grpc.CallOption) (Admin_ImportProjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[1], Admin_ImportProject_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminImportProjectClient{stream}
+	return x, nil
+}
+
+type Admin_ImportProjectClient interface {
+	Send(*ImportProjectRequest) error
+	CloseAndRecv() (*ImportProjectResponse, error)
+	grpc.ClientStream
+}
+
+type adminImportProjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminImportProjectClient) Send(m *ImportProjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *adminImportProjectClient) CloseAndRecv() (*ImportProjectResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportProjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error) {
+	out := new(Snapshot)
+	err := c.cc.Invoke(ctx, Admin_CreateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Admin_ListSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Admin_DeleteSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, Admin_RestoreSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 // All implementations must embed UnimplementedAdminServer
 // for forward compatibility
@@ -237,6 +394,35 @@ type AdminServer interface {
 	// DeleteProject removes a specified project and all of the resources that it
 	// owns.
 	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	// UndeleteProject restores a deleted project and the resources that were
+	// deleted with it. Deleted projects can be restored until they expire.
+	UndeleteProject(context.Context, *UndeleteProjectRequest) (*Project, error)
+	// ListAuditEvents returns the audit events recorded for changes to
+	// registry resources, in the order they were recorded.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: audit events are not resources. --)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ExportProject streams an archive of a project and the resources that it
+	// owns, in the YAML layout written by `registry export`. The resources are
+	// read in a single transaction, so the archive is a consistent snapshot.
+	ExportProject(*ExportProjectRequest, Admin_ExportProjectServer) error
+	// ImportProject creates or updates the project and resources in an archive
+	// written by ExportProject. The resources are applied in a single
+	// transaction, so if one of them can't be applied, none of them are.
+	ImportProject(Admin_ImportProjectServer) error
+	// CreateSnapshot records a copy of a project and the resources that it owns.
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*Snapshot, error)
+	// ListSnapshots returns the snapshots of a project, oldest first.
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// DeleteSnapshot removes a snapshot. The contents that it shares with
+	// other snapshots and with the project are kept.
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*emptypb.Empty, error)
+	// RestoreSnapshot replaces a project and the resources that it owns with
+	// the copies in a snapshot, creating the project if it doesn't exist.
+	// The restore is applied in a single transaction and a notification is
+	// sent for every resource that it creates, updates or deletes.
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
 	mustEmbedUnimplementedAdminServer()
 }
 
@@ -268,6 +454,30 @@ func (UnimplementedAdminServer) UpdateProject(context.Context, *UpdateProjectReq
 func (UnimplementedAdminServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedAdminServer) UndeleteProject(context.Context, *UndeleteProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteProject not implemented")
+}
+func (UnimplementedAdminServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAdminServer) ExportProject(*ExportProjectRequest, Admin_ExportProjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProject not implemented")
+}
+func (UnimplementedAdminServer) ImportProject(Admin_ImportProjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProject not implemented")
+}
+func (UnimplementedAdminServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*Snapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedAdminServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedAdminServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedAdminServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
 func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
 
 // UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -425,6 +635,161 @@ func _Admin_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_UndeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UndeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UndeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UndeleteProject(ctx, req.(*UndeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ExportProject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).ExportProject(m, &adminExportProjectServer{stream})
+}
+
+type Admin_ExportProjectServer interface {
+	Send(*ExportProjectResponse) error
+	grpc.ServerStream
+}
+
+type adminExportProjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminExportProjectServer) Send(m *ExportProjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_ImportProject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServer).ImportProject(&adminImportProjectServer{stream})
+}
+
+type Admin_ImportProjectServer interface {
+	SendAndClose(*ImportProjectResponse) error
+	Recv() (*ImportProjectRequest, error)
+	grpc.ServerStream
+}
+
+type adminImportProjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminImportProjectServer) SendAndClose(m *ImportProjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminImportProjectServer) Recv() (*ImportProjectRequest, error) {
+	m := new(ImportProjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Admin_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DeleteSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,7 +829,42 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _Admin_DeleteProject_Handler,
 		},
+		{
+			MethodName: "UndeleteProject",
+			Handler:    _Admin_UndeleteProject_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Admin_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Admin_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _Admin_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _Admin_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _Admin_RestoreSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportProject",
+			Handler:       _Admin_ExportProject_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportProject",
+			Handler:       _Admin_ImportProject_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "google/cloud/apigeeregistry/v1/admin_service.proto",
 }
@@ -40,17 +40,20 @@ const (
 	Registry_CreateApi_FullMethodName                   = "/google.cloud.apigeeregistry.v1.Registry/CreateApi"
 	Registry_UpdateApi_FullMethodName                   = "/google.cloud.apigeeregistry.v1.Registry/UpdateApi"
 	Registry_DeleteApi_FullMethodName                   = "/google.cloud.apigeeregistry.v1.Registry/DeleteApi"
+	Registry_UndeleteApi_FullMethodName                 = "/google.cloud.apigeeregistry.v1.Registry/UndeleteApi"
 	Registry_ListApiVersions_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/ListApiVersions"
 	Registry_GetApiVersion_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/GetApiVersion"
 	Registry_CreateApiVersion_FullMethodName            = "/google.cloud.apigeeregistry.v1.Registry/CreateApiVersion"
 	Registry_UpdateApiVersion_FullMethodName            = "/google.cloud.apigeeregistry.v1.Registry/UpdateApiVersion"
 	Registry_DeleteApiVersion_FullMethodName            = "/google.cloud.apigeeregistry.v1.Registry/DeleteApiVersion"
+	Registry_UndeleteApiVersion_FullMethodName          = "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiVersion"
 	Registry_ListApiSpecs_FullMethodName                = "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecs"
 	Registry_GetApiSpec_FullMethodName                  = "/google.cloud.apigeeregistry.v1.Registry/GetApiSpec"
 	Registry_GetApiSpecContents_FullMethodName          = "/google.cloud.apigeeregistry.v1.Registry/GetApiSpecContents"
 	Registry_CreateApiSpec_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/CreateApiSpec"
 	Registry_UpdateApiSpec_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/UpdateApiSpec"
 	Registry_DeleteApiSpec_FullMethodName               = "/google.cloud.apigeeregistry.v1.Registry/DeleteApiSpec"
+	Registry_UndeleteApiSpec_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiSpec"
 	Registry_TagApiSpecRevision_FullMethodName          = "/google.cloud.apigeeregistry.v1.Registry/TagApiSpecRevision"
 	Registry_ListApiSpecRevisions_FullMethodName        = "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecRevisions"
 	Registry_RollbackApiSpec_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/RollbackApiSpec"
@@ -60,6 +63,7 @@ const (
 	Registry_CreateApiDeployment_FullMethodName         = "/google.cloud.apigeeregistry.v1.Registry/CreateApiDeployment"
 	Registry_UpdateApiDeployment_FullMethodName         = "/google.cloud.apigeeregistry.v1.Registry/UpdateApiDeployment"
 	Registry_DeleteApiDeployment_FullMethodName         = "/google.cloud.apigeeregistry.v1.Registry/DeleteApiDeployment"
+	Registry_UndeleteApiDeployment_FullMethodName       = "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiDeployment"
 	Registry_TagApiDeploymentRevision_FullMethodName    = "/google.cloud.apigeeregistry.v1.Registry/TagApiDeploymentRevision"
 	Registry_ListApiDeploymentRevisions_FullMethodName  = "/google.cloud.apigeeregistry.v1.Registry/ListApiDeploymentRevisions"
 	Registry_RollbackApiDeployment_FullMethodName       = "/google.cloud.apigeeregistry.v1.Registry/RollbackApiDeployment"
@@ -70,6 +74,12 @@ const (
 	Registry_CreateArtifact_FullMethodName              = "/google.cloud.apigeeregistry.v1.Registry/CreateArtifact"
 	Registry_ReplaceArtifact_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/ReplaceArtifact"
 	Registry_DeleteArtifact_FullMethodName              = "/google.cloud.apigeeregistry.v1.Registry/DeleteArtifact"
+	Registry_UndeleteArtifact_FullMethodName            = "/google.cloud.apigeeregistry.v1.Registry/UndeleteArtifact"
+	Registry_WatchResources_FullMethodName              = "/google.cloud.apigeeregistry.v1.Registry/WatchResources"
+	Registry_SearchResources_FullMethodName             = "/google.cloud.apigeeregistry.v1.Registry/SearchResources"
+	Registry_BatchCreateApiSpecs_FullMethodName         = "/google.cloud.apigeeregistry.v1.Registry/BatchCreateApiSpecs"
+	Registry_BatchUpdateArtifacts_FullMethodName        = "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateArtifacts"
+	Registry_BatchMutate_FullMethodName                 = "/google.cloud.apigeeregistry.v1.Registry/BatchMutate"
 )
 
 // RegistryClient is the client API for Registry service.
@@ -87,6 +97,9 @@ type RegistryClient interface {
 	// DeleteApi removes a specified API and all of the resources that it
 	// owns.
 	DeleteApi(ctx context.Context, in *DeleteApiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApi restores a deleted API and the resources that were deleted
+	// with it. Deleted APIs can be restored until they expire.
+	UndeleteApi(ctx context.Context, in *UndeleteApiRequest, opts ...grpc.CallOption) (*Api, error)
 	// ListApiVersions returns matching versions.
 	ListApiVersions(ctx context.Context, in *ListApiVersionsRequest, opts ...grpc.CallOption) (*ListApiVersionsResponse, error)
 	// GetApiVersion returns a specified version.
@@ -98,6 +111,9 @@ type RegistryClient interface {
 	// DeleteApiVersion removes a specified version and all of the resources that
 	// it owns.
 	DeleteApiVersion(ctx context.Context, in *DeleteApiVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApiVersion restores a deleted version and the resources that were
+	// deleted with it. Deleted versions can be restored until they expire.
+	UndeleteApiVersion(ctx context.Context, in *UndeleteApiVersionRequest, opts ...grpc.CallOption) (*ApiVersion, error)
 	// ListApiSpecs returns matching specs.
 	ListApiSpecs(ctx context.Context, in *ListApiSpecsRequest, opts ...grpc.CallOption) (*ListApiSpecsResponse, error)
 	// GetApiSpec returns a specified spec.
@@ -114,6 +130,9 @@ type RegistryClient interface {
 	// DeleteApiSpec removes a specified spec, all revisions, and all child
 	// resources (e.g. artifacts).
 	DeleteApiSpec(ctx context.Context, in *DeleteApiSpecRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApiSpec restores a deleted spec, its revisions and the resources
+	// that were deleted with it. Deleted specs can be restored until they expire.
+	UndeleteApiSpec(ctx context.Context, in *UndeleteApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error)
 	// TagApiSpecRevision adds a tag to a specified revision of a spec.
 	TagApiSpecRevision(ctx context.Context, in *TagApiSpecRevisionRequest, opts ...grpc.CallOption) (*ApiSpec, error)
 	// ListApiSpecRevisions lists all revisions of a spec.
@@ -135,6 +154,10 @@ type RegistryClient interface {
 	// DeleteApiDeployment removes a specified deployment, all revisions, and all
 	// child resources (e.g. artifacts).
 	DeleteApiDeployment(ctx context.Context, in *DeleteApiDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApiDeployment restores a deleted deployment, its revisions and the
+	// resources that were deleted with it. Deleted deployments can be restored
+	// until they expire.
+	UndeleteApiDeployment(ctx context.Context, in *UndeleteApiDeploymentRequest, opts ...grpc.CallOption) (*ApiDeployment, error)
 	// TagApiDeploymentRevision adds a tag to a specified revision of a
 	// deployment.
 	TagApiDeploymentRevision(ctx context.Context, in *TagApiDeploymentRevisionRequest, opts ...grpc.CallOption) (*ApiDeployment, error)
@@ -161,6 +184,25 @@ type RegistryClient interface {
 	ReplaceArtifact(ctx context.Context, in *ReplaceArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
 	// DeleteArtifact removes a specified artifact.
 	DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteArtifact restores a deleted artifact. Deleted artifacts can be
+	// restored until they expire.
+	UndeleteArtifact(ctx context.Context, in *UndeleteArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
+	// WatchResources streams notifications of changes to resources.
+	// Unless a resume token is provided, the stream begins with the first
+	// change made after the call.
+	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (Registry_WatchResourcesClient, error)
+	// SearchResources returns the APIs, specs and artifacts that match a query,
+	// most relevant first.
+	SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error)
+	// BatchCreateApiSpecs creates a list of specs in a single transaction.
+	// If any spec can't be created, none of them are.
+	BatchCreateApiSpecs(ctx context.Context, in *BatchCreateApiSpecsRequest, opts ...grpc.CallOption) (*BatchCreateApiSpecsResponse, error)
+	// BatchUpdateArtifacts replaces a list of artifacts in a single transaction.
+	// If any artifact can't be replaced, none of them are.
+	BatchUpdateArtifacts(ctx context.Context, in *BatchUpdateArtifactsRequest, opts ...grpc.CallOption) (*BatchUpdateArtifactsResponse, error)
+	// BatchMutate applies a list of creates, updates and deletes in order
+	// in a single transaction. If any mutation fails, none of them are applied.
+	BatchMutate(ctx context.Context, in *BatchMutateRequest, opts ...grpc.CallOption) (*BatchMutateResponse, error)
 }
 
 type registryClient struct {
@@ -216,6 +258,15 @@ func (c *registryClient) DeleteApi(ctx context.Context, in *DeleteApiRequest, op
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApi(ctx context.Context, in *UndeleteApiRequest, opts ...grpc.CallOption) (*Api, error) {
+	out := new(Api)
+	err := c.cc.Invoke(ctx, Registry_UndeleteApi_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) ListApiVersions(ctx context.Context, in *ListApiVersionsRequest, opts ...grpc.CallOption) (*ListApiVersionsResponse, error) {
 	out := new(ListApiVersionsResponse)
 	err := c.cc.Invoke(ctx, Registry_ListApiVersions_FullMethodName, in, out, opts...)
@@ -261,6 +312,15 @@ func (c *registryClient) DeleteApiVersion(ctx context.Context, in *DeleteApiVers
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApiVersion(ctx context.Context, in *UndeleteApiVersionRequest, opts ...grpc.CallOption) (*ApiVersion, error) {
+	out := new(ApiVersion)
+	err := c.cc.Invoke(ctx, Registry_UndeleteApiVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) ListApiSpecs(ctx context.Context, in *ListApiSpecsRequest, opts ...grpc.CallOption) (*ListApiSpecsResponse, error) {
 	out := new(ListApiSpecsResponse)
 	err := c.cc.Invoke(ctx, Registry_ListApiSpecs_FullMethodName, in, out, opts...)
@@ -315,6 +375,15 @@ func (c *registryClient) DeleteApiSpec(ctx context.Context, in *DeleteApiSpecReq
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApiSpec(ctx context.Context, in *UndeleteApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error) {
+	out := new(ApiSpec)
+	err := c.cc.Invoke(ctx, Registry_UndeleteApiSpec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) TagApiSpecRevision(ctx context.Context, in *TagApiSpecRevisionRequest, opts ...grpc.CallOption) (*ApiSpec, error) {
 	out := new(ApiSpec)
 	err := c.cc.Invoke(ctx, Registry_TagApiSpecRevision_FullMethodName, in, out, opts...)
@@ -396,6 +465,15 @@ func (c *registryClient) DeleteApiDeployment(ctx context.Context, in *DeleteApiD
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApiDeployment(ctx context.Context, in *UndeleteApiDeploymentRequest, opts ...grpc.CallOption) (*ApiDeployment, error) {
+	out := new(ApiDeployment)
+	err := c.cc.Invoke(ctx, Registry_UndeleteApiDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) TagApiDeploymentRevision(ctx context.Context, in *TagApiDeploymentRevisionRequest, opts ...grpc.CallOption) (*ApiDeployment, error) {
 	out := new(ApiDeployment)
 	err := c.cc.Invoke(ctx, Registry_TagApiDeploymentRevision_FullMethodName, in, out, opts...)
@@ -486,6 +564,83 @@ func (c *registryClient) DeleteArtifact(ctx context.Context, in *DeleteArtifactR
 	return out, nil
 }
 
+func (c *registryClient) UndeleteArtifact(ctx context.Context, in *UndeleteArtifactRequest, opts ...grpc.CallOption) (*Artifact, error) {
+	out := new(Artifact)
+	err := c.cc.Invoke(ctx, Registry_UndeleteArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (Registry_WatchResourcesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[0], Registry_WatchResources_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryWatchResourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registry_WatchResourcesClient interface {
+	Recv() (*WatchResourcesResponse, error)
+	grpc.ClientStream
+}
+
+type registryWatchResourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryWatchResourcesClient) Recv() (*WatchResourcesResponse, error) {
+	m := new(WatchResourcesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error) {
+	out := new(SearchResourcesResponse)
+	err := c.cc.Invoke(ctx, Registry_SearchResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchCreateApiSpecs(ctx context.Context, in *BatchCreateApiSpecsRequest, opts ...grpc.CallOption) (*BatchCreateApiSpecsResponse, error) {
+	out := new(BatchCreateApiSpecsResponse)
+	err := c.cc.Invoke(ctx, Registry_BatchCreateApiSpecs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchUpdateArtifacts(ctx context.Context, in *BatchUpdateArtifactsRequest, opts ...grpc.CallOption) (*BatchUpdateArtifactsResponse, error) {
+	out := new(BatchUpdateArtifactsResponse)
+	err := c.cc.Invoke(ctx, Registry_BatchUpdateArtifacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchMutate(ctx context.Context, in *BatchMutateRequest, opts ...grpc.CallOption) (*BatchMutateResponse, error) {
+	out := new(BatchMutateResponse)
+	err := c.cc.Invoke(ctx, Registry_BatchMutate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistryServer is the server API for Registry service.
 // All implementations must embed UnimplementedRegistryServer
 // for forward compatibility
@@ -501,6 +656,9 @@ type RegistryServer interface {
 	// DeleteApi removes a specified API and all of the resources that it
 	// owns.
 	DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error)
+	// UndeleteApi restores a deleted API and the resources that were deleted
+	// with it. Deleted APIs can be restored until they expire.
+	UndeleteApi(context.Context, *UndeleteApiRequest) (*Api, error)
 	// ListApiVersions returns matching versions.
 	ListApiVersions(context.Context, *ListApiVersionsRequest) (*ListApiVersionsResponse, error)
 	// GetApiVersion returns a specified version.
@@ -512,6 +670,9 @@ type RegistryServer interface {
 	// DeleteApiVersion removes a specified version and all of the resources that
 	// it owns.
 	DeleteApiVersion(context.Context, *DeleteApiVersionRequest) (*emptypb.Empty, error)
+	// UndeleteApiVersion restores a deleted version and the resources that were
+	// deleted with it. Deleted versions can be restored until they expire.
+	UndeleteApiVersion(context.Context, *UndeleteApiVersionRequest) (*ApiVersion, error)
 	// ListApiSpecs returns matching specs.
 	ListApiSpecs(context.Context, *ListApiSpecsRequest) (*ListApiSpecsResponse, error)
 	// GetApiSpec returns a specified spec.
@@ -528,6 +689,9 @@ type RegistryServer interface {
 	// DeleteApiSpec removes a specified spec, all revisions, and all child
 	// resources (e.g. artifacts).
 	DeleteApiSpec(context.Context, *DeleteApiSpecRequest) (*emptypb.Empty, error)
+	// UndeleteApiSpec restores a deleted spec, its revisions and the resources
+	// that were deleted with it. Deleted specs can be restored until they expire.
+	UndeleteApiSpec(context.Context, *UndeleteApiSpecRequest) (*ApiSpec, error)
 	// TagApiSpecRevision adds a tag to a specified revision of a spec.
 	TagApiSpecRevision(context.Context, *TagApiSpecRevisionRequest) (*ApiSpec, error)
 	// ListApiSpecRevisions lists all revisions of a spec.
@@ -549,6 +713,10 @@ type RegistryServer interface {
 	// DeleteApiDeployment removes a specified deployment, all revisions, and all
 	// child resources (e.g. artifacts).
 	DeleteApiDeployment(context.Context, *DeleteApiDeploymentRequest) (*emptypb.Empty, error)
+	// UndeleteApiDeployment restores a deleted deployment, its revisions and the
+	// resources that were deleted with it. Deleted deployments can be restored
+	// until they expire.
+	UndeleteApiDeployment(context.Context, *UndeleteApiDeploymentRequest) (*ApiDeployment, error)
 	// TagApiDeploymentRevision adds a tag to a specified revision of a
 	// deployment.
 	TagApiDeploymentRevision(context.Context, *TagApiDeploymentRevisionRequest) (*ApiDeployment, error)
@@ -575,6 +743,25 @@ type RegistryServer interface {
 	ReplaceArtifact(context.Context, *ReplaceArtifactRequest) (*Artifact, error)
 	// DeleteArtifact removes a specified artifact.
 	DeleteArtifact(context.Context, *DeleteArtifactRequest) (*emptypb.Empty, error)
+	// UndeleteArtifact restores a deleted artifact. Deleted artifacts can be
+	// restored until they expire.
+	UndeleteArtifact(context.Context, *UndeleteArtifactRequest) (*Artifact, error)
+	// WatchResources streams notifications of changes to resources.
+	// Unless a resume token is provided, the stream begins with the first
+	// change made after the call.
+	WatchResources(*WatchResourcesRequest, Registry_WatchResourcesServer) error
+	// SearchResources returns the APIs, specs and artifacts that match a query,
+	// most relevant first.
+	SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error)
+	// BatchCreateApiSpecs creates a list of specs in a single transaction.
+	// If any spec can't be created, none of them are.
+	BatchCreateApiSpecs(context.Context, *BatchCreateApiSpecsRequest) (*BatchCreateApiSpecsResponse, error)
+	// BatchUpdateArtifacts replaces a list of artifacts in a single transaction.
+	// If any artifact can't be replaced, none of them are.
+	BatchUpdateArtifacts(context.Context, *BatchUpdateArtifactsRequest) (*BatchUpdateArtifactsResponse, error)
+	// BatchMutate applies a list of creates, updates and deletes in order
+	// in a single transaction. If any mutation fails, none of them are applied.
+	BatchMutate(context.Context, *BatchMutateRequest) (*BatchMutateResponse, error)
 	mustEmbedUnimplementedRegistryServer()
 }
 
@@ -597,6 +784,9 @@ func (UnimplementedRegistryServer) UpdateApi(context.Context, *UpdateApiRequest)
 func (UnimplementedRegistryServer) DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApi not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApi(context.Context, *UndeleteApiRequest) (*Api, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApi not implemented")
+}
 func (UnimplementedRegistryServer) ListApiVersions(context.Context, *ListApiVersionsRequest) (*ListApiVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApiVersions not implemented")
 }
@@ -612,6 +802,9 @@ func (UnimplementedRegistryServer) UpdateApiVersion(context.Context, *UpdateApiV
 func (UnimplementedRegistryServer) DeleteApiVersion(context.Context, *DeleteApiVersionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiVersion not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApiVersion(context.Context, *UndeleteApiVersionRequest) (*ApiVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApiVersion not implemented")
+}
 func (UnimplementedRegistryServer) ListApiSpecs(context.Context, *ListApiSpecsRequest) (*ListApiSpecsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApiSpecs not implemented")
 }
@@ -630,6 +823,9 @@ func (UnimplementedRegistryServer) UpdateApiSpec(context.Context, *UpdateApiSpec
 func (UnimplementedRegistryServer) DeleteApiSpec(context.Context, *DeleteApiSpecRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiSpec not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApiSpec(context.Context, *UndeleteApiSpecRequest) (*ApiSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApiSpec not implemented")
+}
 func (UnimplementedRegistryServer) TagApiSpecRevision(context.Context, *TagApiSpecRevisionRequest) (*ApiSpec, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TagApiSpecRevision not implemented")
 }
@@ -657,6 +853,9 @@ func (UnimplementedRegistryServer) UpdateApiDeployment(context.Context, *UpdateA
 func (UnimplementedRegistryServer) DeleteApiDeployment(context.Context, *DeleteApiDeploymentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiDeployment not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApiDeployment(context.Context, *UndeleteApiDeploymentRequest) (*ApiDeployment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApiDeployment not implemented")
+}
 func (UnimplementedRegistryServer) TagApiDeploymentRevision(context.Context, *TagApiDeploymentRevisionRequest) (*ApiDeployment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TagApiDeploymentRevision not implemented")
 }
@@ -687,6 +886,24 @@ func (UnimplementedRegistryServer) ReplaceArtifact(context.Context, *ReplaceArti
 func (UnimplementedRegistryServer) DeleteArtifact(context.Context, *DeleteArtifactRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtifact not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteArtifact(context.Context, *UndeleteArtifactRequest) (*Artifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteArtifact not implemented")
+}
+func (UnimplementedRegistryServer) WatchResources(*WatchResourcesRequest, Registry_WatchResourcesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResources not implemented")
+}
+func (UnimplementedRegistryServer) SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchResources not implemented")
+}
+func (UnimplementedRegistryServer) BatchCreateApiSpecs(context.Context, *BatchCreateApiSpecsRequest) (*BatchCreateApiSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateApiSpecs not implemented")
+}
+func (UnimplementedRegistryServer) BatchUpdateArtifacts(context.Context, *BatchUpdateArtifactsRequest) (*BatchUpdateArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateArtifacts not implemented")
+}
+func (UnimplementedRegistryServer) BatchMutate(context.Context, *BatchMutateRequest) (*BatchMutateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMutate not implemented")
+}
 func (UnimplementedRegistryServer) mustEmbedUnimplementedRegistryServer() {}
 
 // UnsafeRegistryServer may be embedded to opt out of forward compatibility for this service.
@@ -790,6 +1007,24 @@ func _Registry_DeleteApi_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_UndeleteApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_UndeleteApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApi(ctx, req.(*UndeleteApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Registry_ListApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListApiVersionsRequest)
 	if err := dec(in); err != nil {
@@ -880,6 +1115,24 @@ func _Registry_DeleteApiVersion_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_UndeleteApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApiVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_UndeleteApiVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApiVersion(ctx, req.(*UndeleteApiVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Registry_ListApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListApiSpecsRequest)
 	if err := dec(in); err != nil {
@@ -988,6 +1241,24 @@ func _Registry_DeleteApiSpec_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_UndeleteApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApiSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_UndeleteApiSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApiSpec(ctx, req.(*UndeleteApiSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Registry_TagApiSpecRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TagApiSpecRevisionRequest)
 	if err := dec(in); err != nil {
@@ -1150,6 +1421,24 @@ func _Registry_DeleteApiDeployment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_UndeleteApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApiDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_UndeleteApiDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApiDeployment(ctx, req.(*UndeleteApiDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Registry_TagApiDeploymentRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TagApiDeploymentRevisionRequest)
 	if err := dec(in); err != nil {
@@ -1330,6 +1619,117 @@ func _Registry_DeleteArtifact_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_UndeleteArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_UndeleteArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteArtifact(ctx, req.(*UndeleteArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_WatchResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistryServer).WatchResources(m, &registryWatchResourcesServer{stream})
+}
+
+type Registry_WatchResourcesServer interface {
+	Send(*WatchResourcesResponse) error
+	grpc.ServerStream
+}
+
+type registryWatchResourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryWatchResourcesServer) Send(m *WatchResourcesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Registry_SearchResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).SearchResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_SearchResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).SearchResources(ctx, req.(*SearchResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchCreateApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateApiSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchCreateApiSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_BatchCreateApiSpecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchCreateApiSpecs(ctx, req.(*BatchCreateApiSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchUpdateArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchUpdateArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_BatchUpdateArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchUpdateArtifacts(ctx, req.(*BatchUpdateArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchMutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMutateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchMutate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Registry_BatchMutate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchMutate(ctx, req.(*BatchMutateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Registry_ServiceDesc is the grpc.ServiceDesc for Registry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1357,6 +1757,10 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApi",
 			Handler:    _Registry_DeleteApi_Handler,
 		},
+		{
+			MethodName: "UndeleteApi",
+			Handler:    _Registry_UndeleteApi_Handler,
+		},
 		{
 			MethodName: "ListApiVersions",
 			Handler:    _Registry_ListApiVersions_Handler,
@@ -1377,6 +1781,10 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApiVersion",
 			Handler:    _Registry_DeleteApiVersion_Handler,
 		},
+		{
+			MethodName: "UndeleteApiVersion",
+			Handler:    _Registry_UndeleteApiVersion_Handler,
+		},
 		{
 			MethodName: "ListApiSpecs",
 			Handler:    _Registry_ListApiSpecs_Handler,
@@ -1401,6 +1809,10 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApiSpec",
 			Handler:    _Registry_DeleteApiSpec_Handler,
 		},
+		{
+			MethodName: "UndeleteApiSpec",
+			Handler:    _Registry_UndeleteApiSpec_Handler,
+		},
 		{
 			MethodName: "TagApiSpecRevision",
 			Handler:    _Registry_TagApiSpecRevision_Handler,
@@ -1437,6 +1849,10 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApiDeployment",
 			Handler:    _Registry_DeleteApiDeployment_Handler,
 		},
+		{
+			MethodName: "UndeleteApiDeployment",
+			Handler:    _Registry_UndeleteApiDeployment_Handler,
+		},
 		{
 			MethodName: "TagApiDeploymentRevision",
 			Handler:    _Registry_TagApiDeploymentRevision_Handler,
@@ -1477,7 +1893,33 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArtifact",
 			Handler:    _Registry_DeleteArtifact_Handler,
 		},
+		{
+			MethodName: "UndeleteArtifact",
+			Handler:    _Registry_UndeleteArtifact_Handler,
+		},
+		{
+			MethodName: "SearchResources",
+			Handler:    _Registry_SearchResources_Handler,
+		},
+		{
+			MethodName: "BatchCreateApiSpecs",
+			Handler:    _Registry_BatchCreateApiSpecs_Handler,
+		},
+		{
+			MethodName: "BatchUpdateArtifacts",
+			Handler:    _Registry_BatchUpdateArtifacts_Handler,
+		},
+		{
+			MethodName: "BatchMutate",
+			Handler:    _Registry_BatchMutate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResources",
+			Handler:       _Registry_WatchResources_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "google/cloud/apigeeregistry/v1/registry_service.proto",
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/grpc/codes"
@@ -37,15 +38,24 @@ func (s *RegistryServer) CreateApi(ctx context.Context, req *rpc.CreateApiReques
 	if err := name.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.Api
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createApi(ctx, db, name, req.GetApi())
-		return err
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -69,15 +79,68 @@ func (s *RegistryServer) DeleteApi(ctx context.Context, req *rpc.DeleteApiReques
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockApis(ctx).DeleteApi(ctx, name, req.GetForce())
+		db.LockApis(ctx)
+		if req.GetEtag() != "" {
+			api, err := db.GetApi(ctx, name)
+			if err != nil {
+				return err
+			}
+			if err := checkEtag(req.GetName(), req.GetEtag(), api.Etag()); err != nil {
+				return err
+			}
+		}
+		if err := db.DeleteApi(ctx, name, req.GetForce(), s.deleteExpireTime()); err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_DELETED, req.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, req.GetName(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApi handles the corresponding API request.
+func (s *RegistryServer) UndeleteApi(ctx context.Context, req *rpc.UndeleteApiRequest) (*rpc.Api, error) {
+	// API name must be valid.
+	name, err := names.ParseApi(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
+	var response *rpc.Api
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.LockApis(ctx).UndeleteApi(ctx, name); err != nil {
+			return err
+		}
+		api, err := db.GetApi(ctx, name)
+		if err != nil {
+			return err
+		}
+		response, err = api.Message()
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // GetApi handles the corresponding API request.
 func (s *RegistryServer) GetApi(ctx context.Context, req *rpc.GetApiRequest) (*rpc.Api, error) {
 	db, err := s.getStorageClient(ctx)
@@ -89,6 +152,10 @@ func (s *RegistryServer) GetApi(ctx context.Context, req *rpc.GetApiRequest) (*r
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	api, err := db.GetApi(ctx, name)
 	if err != nil {
@@ -122,12 +189,17 @@ func (s *RegistryServer) ListApis(ctx context.Context, req *rpc.ListApisRequest)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	listing, err := db.ListApis(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Order:  req.GetOrderBy(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -159,6 +231,10 @@ func (s *RegistryServer) UpdateApi(ctx context.Context, req *rpc.UpdateApiReques
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	// Update mask must be valid.
 	if err := models.ValidateMask(req.GetApi(), req.GetUpdateMask()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
@@ -166,8 +242,15 @@ func (s *RegistryServer) UpdateApi(ctx context.Context, req *rpc.UpdateApiReques
 	var response *rpc.Api
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		db.LockApis(ctx)
+		var before *rpc.Api
 		api, err := db.GetApi(ctx, name)
 		if err == nil {
+			if err := checkEtag(name.String(), req.GetApi().GetEtag(), api.Etag()); err != nil {
+				return err
+			}
+			if before, err = api.Message(); err != nil {
+				return err
+			}
 			if err := api.Update(req.GetApi(), models.ExpandMask(req.GetApi(), req.GetUpdateMask())); err != nil {
 				return err
 			}
@@ -175,16 +258,23 @@ func (s *RegistryServer) UpdateApi(ctx context.Context, req *rpc.UpdateApiReques
 				return err
 			}
 			response, err = api.Message()
-			return err
+			if err != nil {
+				return err
+			}
 		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
 			response, err = s.createApi(ctx, db, name, req.GetApi())
-			return err
+			if err != nil {
+				return err
+			}
 		} else {
 			return err
 		}
+		if err := s.notify(ctx, db, rpc.Notification_UPDATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
 	return response, nil
 }
@@ -105,7 +105,7 @@ func TestCreateApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -351,7 +351,7 @@ func TestGetApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -512,6 +512,77 @@ func TestListApis(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "label value filtering",
+			seed: []*rpc.Api{
+				{
+					Name:   "projects/my-project/locations/global/apis/api1",
+					Labels: map[string]string{"team": "a", "tier": "1"},
+				},
+				{
+					Name:   "projects/my-project/locations/global/apis/api2",
+					Labels: map[string]string{"team": "b", "tier": "1"},
+				},
+				{Name: "projects/my-project/locations/global/apis/api3"},
+			},
+			req: &rpc.ListApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Filter: "labels['team'] == 'b' && 'tier' in labels",
+			},
+			want: &rpc.ListApisResponse{
+				Apis: []*rpc.Api{
+					{
+						Name:   "projects/my-project/locations/global/apis/api2",
+						Labels: map[string]string{"team": "b", "tier": "1"},
+					},
+				},
+			},
+		},
+		{
+			desc: "name prefix and description substring filtering",
+			seed: []*rpc.Api{
+				{
+					Name:        "projects/my-project/locations/global/apis/api1",
+					Description: "Public Api",
+				},
+				{
+					Name:        "projects/my-project/locations/global/apis/api2",
+					Description: "public api",
+				},
+				{
+					Name:        "projects/my-project/locations/global/apis/other",
+					Description: "Public Api",
+				},
+			},
+			req: &rpc.ListApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Filter: "name.startsWith('projects/my-project/locations/global/apis/api') && description.contains('Public')",
+			},
+			want: &rpc.ListApisResponse{
+				Apis: []*rpc.Api{
+					{
+						Name:        "projects/my-project/locations/global/apis/api1",
+						Description: "Public Api",
+					},
+				},
+			},
+		},
+		{
+			desc: "create time filtering",
+			seed: []*rpc.Api{
+				{Name: "projects/my-project/locations/global/apis/api1"},
+				{Name: "projects/my-project/locations/global/apis/api2"},
+			},
+			req: &rpc.ListApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Filter: "create_time > timestamp('2021-01-01T00:00:00Z') && (api_id == 'api1' || api_id.endsWith('3'))",
+			},
+			want: &rpc.ListApisResponse{
+				Apis: []*rpc.Api{
+					{Name: "projects/my-project/locations/global/apis/api1"},
+				},
+			},
+		},
 		{
 			desc: "ordered by description",
 			seed: []*rpc.Api{
@@ -644,7 +715,7 @@ func TestListApis(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApisResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 				test.extraOpts,
 			}
 
@@ -861,7 +932,7 @@ func TestListApisSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.Api) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -1104,7 +1175,7 @@ func TestUpdateApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
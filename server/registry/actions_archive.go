@@ -0,0 +1,687 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/pkg/encoding"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// exportChunkSize is the size of the archive chunks sent by ExportProject.
+const exportChunkSize = 1 << 20
+
+// maxImportSize is the size of the largest archive accepted by ImportProject.
+const maxImportSize = 1 << 30
+
+// maxImportContentsSize is the largest total size of the files that
+// ImportProject reads from an archive after they are decompressed.
+const maxImportContentsSize = 1 << 30
+
+// errArchiveTooLarge is returned when the files in an archive are larger
+// than the limit passed to readArchive.
+var errArchiveTooLarge = errors.New("archive contents are too large")
+
+// ExportProject handles the corresponding API request.
+func (s *RegistryServer) ExportProject(req *rpc.ExportProjectRequest, stream rpc.Admin_ExportProjectServer) error {
+	ctx := stream.Context()
+	name, err := names.ParseProject(req.GetName())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+		return err
+	}
+	w := &chunkWriter{send: func(data []byte) error {
+		return stream.Send(&rpc.ExportProjectResponse{Data: data})
+	}}
+	var a archiveWriter
+	switch req.GetFormat() {
+	case rpc.ExportProjectRequest_FORMAT_UNSPECIFIED, rpc.ExportProjectRequest_TAR:
+		a = &tarWriter{w: tar.NewWriter(w), modTime: time.Now()}
+	case rpc.ExportProjectRequest_ZIP:
+		a = &zipWriter{w: zip.NewWriter(w)}
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported format %s", req.GetFormat())
+	}
+	if err := s.readTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		return s.exportProject(ctx, name, a)
+	}); err != nil {
+		return err
+	}
+	if err := a.Close(); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+// exportProject writes a project and its resources to an archive in the
+// layout written by `registry export`.
+func (s *RegistryServer) exportProject(ctx context.Context, name names.Project, a archiveWriter) error {
+	root := name.ProjectID
+	parent := name.String() + "/locations/global"
+	write := func(filename string, v interface{}) error {
+		b, err := encoding.EncodeYAML(v)
+		if err != nil {
+			return err
+		}
+		return a.WriteFile(filename, b)
+	}
+
+	project, err := s.GetProject(ctx, &rpc.GetProjectRequest{Name: name.String()})
+	if err != nil {
+		return err
+	}
+	if p, err := encoding.NewProject(project); err != nil {
+		return err
+	} else if err := write(root+"/info.yaml", p); err != nil {
+		return err
+	}
+
+	if err := listAll(func(token string) (string, error) {
+		resp, err := s.ListApis(ctx, &rpc.ListApisRequest{Parent: parent, PageSize: 1000, PageToken: token})
+		if err != nil {
+			return "", err
+		}
+		for _, m := range resp.GetApis() {
+			api, err := encoding.NewApi(m)
+			if err != nil {
+				return "", err
+			}
+			if err := write(path.Join(root, "apis", api.Metadata.Name, "info.yaml"), api); err != nil {
+				return "", err
+			}
+		}
+		return resp.GetNextPageToken(), nil
+	}); err != nil {
+		return err
+	}
+
+	if err := listAll(func(token string) (string, error) {
+		resp, err := s.ListApiVersions(ctx, &rpc.ListApiVersionsRequest{Parent: parent + "/apis/-", PageSize: 1000, PageToken: token})
+		if err != nil {
+			return "", err
+		}
+		for _, m := range resp.GetApiVersions() {
+			version, err := encoding.NewApiVersion(m)
+			if err != nil {
+				return "", err
+			}
+			if err := write(path.Join(root, version.Metadata.Parent, "versions", version.Metadata.Name, "info.yaml"), version); err != nil {
+				return "", err
+			}
+		}
+		return resp.GetNextPageToken(), nil
+	}); err != nil {
+		return err
+	}
+
+	if err := listAll(func(token string) (string, error) {
+		resp, err := s.ListApiSpecs(ctx, &rpc.ListApiSpecsRequest{Parent: parent + "/apis/-/versions/-", PageSize: 1000, PageToken: token})
+		if err != nil {
+			return "", err
+		}
+		for _, m := range resp.GetApiSpecs() {
+			spec, err := encoding.NewApiSpec(m)
+			if err != nil {
+				return "", err
+			}
+			dir := path.Join(root, spec.Metadata.Parent, "specs", spec.Metadata.Name)
+			if err := write(path.Join(dir, "info.yaml"), spec); err != nil {
+				return "", err
+			}
+			if m.GetFilename() == "" {
+				continue
+			}
+			body, err := s.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: m.GetName()})
+			if err != nil {
+				return "", err
+			}
+			contents := body.GetData()
+			if mime.IsGZipCompressed(body.GetContentType()) {
+				if contents, err = models.GUnzippedBytes(contents); err != nil {
+					return "", err
+				}
+			}
+			if err := a.WriteFile(path.Join(dir, path.Base(m.GetFilename())), contents); err != nil {
+				return "", err
+			}
+		}
+		return resp.GetNextPageToken(), nil
+	}); err != nil {
+		return err
+	}
+
+	if err := listAll(func(token string) (string, error) {
+		resp, err := s.ListApiDeployments(ctx, &rpc.ListApiDeploymentsRequest{Parent: parent + "/apis/-", PageSize: 1000, PageToken: token})
+		if err != nil {
+			return "", err
+		}
+		for _, m := range resp.GetApiDeployments() {
+			deployment, err := encoding.NewApiDeployment(m)
+			if err != nil {
+				return "", err
+			}
+			if err := write(path.Join(root, deployment.Metadata.Parent, "deployments", deployment.Metadata.Name, "info.yaml"), deployment); err != nil {
+				return "", err
+			}
+		}
+		return resp.GetNextPageToken(), nil
+	}); err != nil {
+		return err
+	}
+
+	for _, p := range []string{
+		parent,
+		parent + "/apis/-",
+		parent + "/apis/-/versions/-",
+		parent + "/apis/-/versions/-/specs/-",
+		parent + "/apis/-/deployments/-",
+	} {
+		if err := listAll(func(token string) (string, error) {
+			resp, err := s.ListArtifacts(ctx, &rpc.ListArtifactsRequest{Parent: p, PageSize: 1000, PageToken: token})
+			if err != nil {
+				return "", err
+			}
+			for _, m := range resp.GetArtifacts() {
+				body, err := s.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: m.GetName()})
+				if err != nil {
+					return "", err
+				}
+				m.Contents = body.GetData()
+				artifact, err := encoding.NewArtifact(m)
+				if err != nil {
+					log.FromContext(ctx).Warnf("Skipped %s: %s", m.GetName(), err)
+					continue
+				}
+				if artifact.Kind == "Artifact" { // "Artifact" is the generic artifact type
+					log.FromContext(ctx).Warnf("Skipped %s", m.GetName())
+					continue
+				}
+				if err := write(path.Join(root, artifact.Metadata.Parent, "artifacts", artifact.Metadata.Name+".yaml"), artifact); err != nil {
+					return "", err
+				}
+			}
+			return resp.GetNextPageToken(), nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listAll calls list with successive page tokens until it returns an empty token.
+func listAll(list func(token string) (string, error)) error {
+	var token string
+	for {
+		next, err := list(token)
+		if err != nil || next == "" {
+			return err
+		}
+		token = next
+	}
+}
+
+// ImportProject handles the corresponding API request.
+func (s *RegistryServer) ImportProject(stream rpc.Admin_ImportProjectServer) error {
+	ctx := stream.Context()
+	// The project is named by the first message, so the caller is authorized
+	// before the rest of the archive is received.
+	req, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	name, err := names.ParseProject(req.GetName())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return err
+	}
+	data := req.GetData()
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if len(data)+len(req.GetData()) > maxImportSize {
+			return status.Errorf(codes.ResourceExhausted, "archive is larger than %d bytes", maxImportSize)
+		}
+		data = append(data, req.GetData()...)
+	}
+	files, err := readArchive(data, maxImportContentsSize)
+	if errors.Is(err, errArchiveTooLarge) {
+		return status.Errorf(codes.ResourceExhausted, "archive contents are larger than %d bytes", maxImportContentsSize)
+	} else if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid archive: %s", err)
+	}
+	var response *rpc.ImportProjectResponse
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		var err error
+		response, err = s.importProject(ctx, name, files)
+		return err
+	}); err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+// importProject creates or updates the resources in the files of an archive.
+// Parents are applied before their children. Etags in the files are ignored.
+func (s *RegistryServer) importProject(ctx context.Context, name names.Project, files map[string][]byte) (*rpc.ImportProjectResponse, error) {
+	// Group the YAML files by kind.
+	var projects, apis, versions, specs, deployments, artifacts []string
+	for filename, b := range files {
+		if ext := path.Ext(filename); ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		var header encoding.Header
+		if err := yaml.Unmarshal(b, &header); err != nil || header.ApiVersion != encoding.RegistryV1 {
+			continue
+		}
+		switch header.Kind {
+		case "Project":
+			projects = append(projects, filename)
+		case "API":
+			apis = append(apis, filename)
+		case "Version":
+			versions = append(versions, filename)
+		case "Spec":
+			specs = append(specs, filename)
+		case "Deployment":
+			deployments = append(deployments, filename)
+		default: // for everything else, try an artifact type
+			artifacts = append(artifacts, filename)
+		}
+	}
+	for _, l := range [][]string{projects, apis, versions, specs, deployments, artifacts} {
+		sort.Strings(l)
+	}
+	if len(projects) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "archive contains %d projects", len(projects))
+	}
+	decode := func(filename string, v interface{}) error {
+		if err := yaml.Unmarshal(files[filename], v); err != nil {
+			return status.Errorf(codes.InvalidArgument, "%s: %s", filename, err)
+		}
+		return nil
+	}
+	nested := func(filename string) error {
+		return status.Errorf(codes.InvalidArgument, "%s: nested resources are not supported", filename)
+	}
+	response := &rpc.ImportProjectResponse{}
+
+	var project *rpc.Project
+	var err error
+	if len(projects) == 1 {
+		var p encoding.Project
+		if err := decode(projects[0], &p); err != nil {
+			return nil, err
+		}
+		project, err = s.UpdateProject(ctx, &rpc.UpdateProjectRequest{
+			Project: &rpc.Project{
+				Name:        name.String(),
+				DisplayName: p.Data.DisplayName,
+				Description: p.Data.Description,
+			},
+			AllowMissing: true,
+		})
+	} else if project, err = s.GetProject(ctx, &rpc.GetProjectRequest{Name: name.String()}); status.Code(err) == codes.NotFound {
+		project, err = s.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: name.ProjectID})
+	}
+	if err != nil {
+		return nil, err
+	}
+	response.Project = project
+	parent := name.String() + "/locations/global"
+
+	for _, filename := range apis {
+		var api encoding.Api
+		if err := decode(filename, &api); err != nil {
+			return nil, err
+		}
+		if len(api.Data.ApiVersions) > 0 || len(api.Data.ApiDeployments) > 0 || len(api.Data.Artifacts) > 0 {
+			return nil, nested(filename)
+		}
+		apiName := name.Api(api.Metadata.Name)
+		var recommendedVersion, recommendedDeployment string
+		if v := api.Data.RecommendedVersion; v != "" {
+			recommendedVersion = apiName.Version(v).String()
+		}
+		if d := api.Data.RecommendedDeployment; d != "" {
+			recommendedDeployment = apiName.Deployment(d).String()
+		}
+		if _, err := s.UpdateApi(ctx, &rpc.UpdateApiRequest{
+			Api: &rpc.Api{
+				Name:                  apiName.String(),
+				DisplayName:           api.Data.DisplayName,
+				Description:           api.Data.Description,
+				Availability:          api.Data.Availability,
+				RecommendedVersion:    recommendedVersion,
+				RecommendedDeployment: recommendedDeployment,
+				Labels:                api.Metadata.Labels,
+				Annotations:           api.Metadata.Annotations,
+			},
+			AllowMissing: true,
+		}); err != nil {
+			return nil, importError(filename, err)
+		}
+		response.ResourceCount++
+	}
+
+	for _, filename := range versions {
+		var version encoding.ApiVersion
+		if err := decode(filename, &version); err != nil {
+			return nil, err
+		}
+		if len(version.Data.ApiSpecs) > 0 || len(version.Data.Artifacts) > 0 {
+			return nil, nested(filename)
+		}
+		if _, err := s.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+			ApiVersion: &rpc.ApiVersion{
+				Name:        parent + "/" + version.Metadata.Parent + "/versions/" + version.Metadata.Name,
+				DisplayName: version.Data.DisplayName,
+				Description: version.Data.Description,
+				State:       version.Data.State,
+				PrimarySpec: version.Data.PrimarySpec,
+				Labels:      version.Metadata.Labels,
+				Annotations: version.Metadata.Annotations,
+			},
+			AllowMissing: true,
+		}); err != nil {
+			return nil, importError(filename, err)
+		}
+		response.ResourceCount++
+	}
+
+	// Imported specs get new revision IDs, so deployments that refer to
+	// their exported revisions are updated to refer to the new ones.
+	revisions := make(map[string]string)
+	for _, filename := range specs {
+		var spec encoding.ApiSpec
+		if err := decode(filename, &spec); err != nil {
+			return nil, err
+		}
+		if len(spec.Data.Artifacts) > 0 {
+			return nil, nested(filename)
+		}
+		var contents []byte
+		if spec.Data.FileName != "" {
+			contents = files[path.Join(path.Dir(filename), path.Base(spec.Data.FileName))]
+		}
+		if contents != nil && strings.Contains(spec.Data.MimeType, "+gzip") {
+			var err error
+			if contents, err = models.GZippedBytes(contents); err != nil {
+				return nil, importError(filename, err)
+			}
+		}
+		result, err := s.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+			ApiSpec: &rpc.ApiSpec{
+				Name:        parent + "/" + spec.Metadata.Parent + "/specs/" + spec.Metadata.Name,
+				Filename:    spec.Data.FileName,
+				Description: spec.Data.Description,
+				MimeType:    spec.Data.MimeType,
+				SourceUri:   spec.Data.SourceURI,
+				Contents:    contents,
+				Labels:      spec.Metadata.Labels,
+				Annotations: spec.Metadata.Annotations,
+			},
+			AllowMissing: true,
+		})
+		if err != nil {
+			return nil, importError(filename, err)
+		}
+		specName, err := names.ParseSpecRevision(result.GetName())
+		if err != nil {
+			return nil, err
+		}
+		revisions[specName.Spec().String()] = specName.Spec().Revision(result.GetRevisionId()).String()
+		response.ResourceCount++
+	}
+
+	for _, filename := range deployments {
+		var deployment encoding.ApiDeployment
+		if err := decode(filename, &deployment); err != nil {
+			return nil, err
+		}
+		if len(deployment.Data.Artifacts) > 0 {
+			return nil, nested(filename)
+		}
+		apiName, err := names.ParseApi(parent + "/" + deployment.Metadata.Parent)
+		if err != nil {
+			return nil, importError(filename, status.Error(codes.InvalidArgument, err.Error()))
+		}
+		specRevision := deployment.Data.ApiSpecRevision
+		if specRevision != "" && !strings.HasPrefix(specRevision, "projects/") {
+			specRevision = apiName.String() + "/versions/" + specRevision
+		}
+		spec, _, _ := strings.Cut(specRevision, "@")
+		if r, ok := revisions[spec]; ok {
+			specRevision = r
+		}
+		if _, err := s.UpdateApiDeployment(ctx, &rpc.UpdateApiDeploymentRequest{
+			ApiDeployment: &rpc.ApiDeployment{
+				Name:               apiName.Deployment(deployment.Metadata.Name).String(),
+				DisplayName:        deployment.Data.DisplayName,
+				Description:        deployment.Data.Description,
+				EndpointUri:        deployment.Data.EndpointURI,
+				ExternalChannelUri: deployment.Data.ExternalChannelURI,
+				IntendedAudience:   deployment.Data.IntendedAudience,
+				AccessGuidance:     deployment.Data.AccessGuidance,
+				ApiSpecRevision:    specRevision,
+				Labels:             deployment.Metadata.Labels,
+				Annotations:        deployment.Metadata.Annotations,
+			},
+			AllowMissing: true,
+		}); err != nil {
+			return nil, importError(filename, err)
+		}
+		response.ResourceCount++
+	}
+
+	for _, filename := range artifacts {
+		var artifact encoding.Artifact
+		if err := decode(filename, &artifact); err != nil {
+			return nil, err
+		}
+		mimeType, contents, err := encoding.ArtifactContents(&artifact, false)
+		if err != nil {
+			return nil, importError(filename, status.Error(codes.InvalidArgument, err.Error()))
+		}
+		artifactParent := parent
+		if artifact.Metadata.Parent != "" {
+			artifactParent += "/" + artifact.Metadata.Parent
+		}
+		if _, err := s.replaceOrCreateArtifact(ctx, &rpc.ReplaceArtifactRequest{
+			Artifact: &rpc.Artifact{
+				Name:        artifactParent + "/artifacts/" + artifact.Metadata.Name,
+				MimeType:    mimeType,
+				Contents:    contents,
+				Labels:      artifact.Metadata.Labels,
+				Annotations: artifact.Metadata.Annotations,
+			},
+		}, true); err != nil {
+			return nil, importError(filename, err)
+		}
+		response.ResourceCount++
+	}
+	return response, nil
+}
+
+// importError adds the name of the file that couldn't be imported to an error.
+func importError(filename string, err error) error {
+	return status.Errorf(status.Code(err), "%s: %s", filename, status.Convert(err).Message())
+}
+
+// readArchive returns the contents of the regular files in a tar or zip
+// archive, keyed by their paths below the archive's top-level directory.
+// It returns errArchiveTooLarge if the files total more than limit bytes.
+func readArchive(data []byte, limit int64) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	add := func(name string, r io.Reader) error {
+		_, filename, ok := strings.Cut(path.Clean(name), "/")
+		if !ok {
+			return nil // Skip files outside of the project directory.
+		}
+		b, err := io.ReadAll(io.LimitReader(r, limit+1))
+		if err != nil {
+			return err
+		}
+		if limit -= int64(len(b)); limit < 0 {
+			return errArchiveTooLarge
+		}
+		files[filename] = b
+		return nil
+	}
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range zr.File {
+			if !f.Mode().IsRegular() {
+				continue
+			}
+			r, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			err = add(f.Name, r)
+			r.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return files, nil
+	}
+	tr := tar.NewReader(bytes.NewReader(data))
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := add(h.Name, tr); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// archiveWriter writes files to an archive.
+type archiveWriter interface {
+	WriteFile(name string, contents []byte) error
+	Close() error
+}
+
+type tarWriter struct {
+	w       *tar.Writer
+	modTime time.Time
+}
+
+func (t *tarWriter) WriteFile(name string, contents []byte) error {
+	if err := t.w.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0644,
+		Size:     int64(len(contents)),
+		ModTime:  t.modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := t.w.Write(contents)
+	return err
+}
+
+func (t *tarWriter) Close() error {
+	return t.w.Close()
+}
+
+type zipWriter struct {
+	w *zip.Writer
+}
+
+func (z *zipWriter) WriteFile(name string, contents []byte) error {
+	f, err := z.w.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(contents)
+	return err
+}
+
+func (z *zipWriter) Close() error {
+	return z.w.Close()
+}
+
+// chunkWriter sends what is written to it in chunks of exportChunkSize bytes.
+type chunkWriter struct {
+	send func([]byte) error
+	buf  []byte
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		m := exportChunkSize - len(c.buf)
+		if m > len(p) {
+			m = len(p)
+		}
+		c.buf = append(c.buf, p[:m]...)
+		p = p[m:]
+		if len(c.buf) == exportChunkSize {
+			if err := c.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// flush sends any buffered bytes.
+func (c *chunkWriter) flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	err := c.send(c.buf)
+	c.buf = nil
+	return err
+}
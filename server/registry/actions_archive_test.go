@@ -0,0 +1,395 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/pkg/application/apihub"
+	"github.com/apigee/registry/pkg/mime"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// exportStream collects the archive sent on an ExportProject stream.
+type exportStream struct {
+	grpc.ServerStream
+	bytes.Buffer
+	chunks int
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(r *rpc.ExportProjectResponse) error {
+	s.chunks++
+	_, err := s.Write(r.GetData())
+	return err
+}
+
+// importStream sends an archive to ImportProject in small chunks.
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	name     string
+	data     []byte
+	response *rpc.ImportProjectResponse
+}
+
+func (s *importStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return context.Background()
+}
+
+func (s *importStream) Recv() (*rpc.ImportProjectRequest, error) {
+	if len(s.data) == 0 && s.name == "" {
+		return nil, io.EOF
+	}
+	n := 100
+	if n > len(s.data) {
+		n = len(s.data)
+	}
+	req := &rpc.ImportProjectRequest{Name: s.name, Data: s.data[:n]}
+	s.name, s.data = "", s.data[n:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(r *rpc.ImportProjectResponse) error {
+	s.response = r
+	return nil
+}
+
+func exportProject(t *testing.T, server TestServer, req *rpc.ExportProjectRequest) []byte {
+	t.Helper()
+	stream := &exportStream{}
+	if err := server.ExportProject(req, stream); err != nil {
+		t.Fatalf("ExportProject(%+v) returned error: %s", req, err)
+	}
+	return stream.Bytes()
+}
+
+func seedArchiveProject(ctx context.Context, t *testing.T, server TestServer) *apihub.Lifecycle {
+	t.Helper()
+	lifecycle := &apihub.Lifecycle{
+		Id:     "lifecycle",
+		Kind:   "Lifecycle",
+		Stages: []*apihub.Lifecycle_Stage{{Id: "design", DisplayName: "Design"}},
+	}
+	contents, err := proto.Marshal(lifecycle)
+	if err != nil {
+		t.Fatalf("Setup: failed to marshal artifact: %s", err)
+	}
+	gzipped, err := models.GZippedBytes([]byte("openapi: 3.0.0\n"))
+	if err != nil {
+		t.Fatalf("Setup: failed to compress spec: %s", err)
+	}
+	spec := &rpc.ApiSpec{
+		Name:     "projects/export/locations/global/apis/a/versions/v/specs/s",
+		Filename: "openapi.yaml",
+		MimeType: "application/x.openapi+gzip;version=3",
+		Contents: gzipped,
+	}
+	if err := seeder.SeedSpecs(ctx, server, spec); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	revision, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec.Name})
+	if err != nil {
+		t.Fatalf("Setup: GetApiSpec() returned error: %s", err)
+	}
+	if _, err := server.CreateApiDeployment(ctx, &rpc.CreateApiDeploymentRequest{
+		Parent:          "projects/export/locations/global/apis/a",
+		ApiDeploymentId: "d",
+		ApiDeployment:   &rpc.ApiDeployment{ApiSpecRevision: revision.GetName() + "@" + revision.GetRevisionId()},
+	}); err != nil {
+		t.Fatalf("Setup: CreateApiDeployment() returned error: %s", err)
+	}
+	if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     "projects/export/locations/global",
+		ArtifactId: "lifecycle",
+		Artifact:   &rpc.Artifact{MimeType: mime.MimeTypeForKind("Lifecycle"), Contents: contents},
+	}); err != nil {
+		t.Fatalf("Setup: CreateArtifact() returned error: %s", err)
+	}
+	// Generic artifacts can't be written as YAML, so they aren't exported.
+	if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     "projects/export/locations/global/apis/a",
+		ArtifactId: "generic",
+		Artifact:   &rpc.Artifact{MimeType: "text/plain", Contents: []byte("hello")},
+	}); err != nil {
+		t.Fatalf("Setup: CreateArtifact() returned error: %s", err)
+	}
+	return lifecycle
+}
+
+func TestExportProject(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedArchiveProject(ctx, t, server)
+
+	for _, format := range []rpc.ExportProjectRequest_Format{
+		rpc.ExportProjectRequest_FORMAT_UNSPECIFIED,
+		rpc.ExportProjectRequest_TAR,
+		rpc.ExportProjectRequest_ZIP,
+	} {
+		t.Run(format.String(), func(t *testing.T) {
+			data := exportProject(t, server, &rpc.ExportProjectRequest{Name: "projects/export", Format: format})
+			files, err := readArchive(data, maxImportContentsSize)
+			if err != nil {
+				t.Fatalf("readArchive() returned error: %s", err)
+			}
+			var got []string
+			for f := range files {
+				got = append(got, f)
+			}
+			sort.Strings(got)
+			want := []string{
+				"apis/a/deployments/d/info.yaml",
+				"apis/a/info.yaml",
+				"apis/a/versions/v/info.yaml",
+				"apis/a/versions/v/specs/s/info.yaml",
+				"apis/a/versions/v/specs/s/openapi.yaml",
+				"artifacts/lifecycle.yaml",
+				"info.yaml",
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("ExportProject() archive files returned unexpected diff (-want +got):\n%s", diff)
+			}
+			if got := string(files["apis/a/versions/v/specs/s/openapi.yaml"]); got != "openapi: 3.0.0\n" {
+				t.Errorf("ExportProject() spec contents = %q, want uncompressed contents", got)
+			}
+		})
+	}
+}
+
+func TestExportProjectChunks(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedSpecs(ctx, server, &rpc.ApiSpec{
+		Name:     "projects/export/locations/global/apis/a/versions/v/specs/s",
+		Filename: "large.txt",
+		MimeType: "text/plain",
+		Contents: bytes.Repeat([]byte("x"), 2*exportChunkSize+1),
+	}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	stream := &exportStream{}
+	if err := server.ExportProject(&rpc.ExportProjectRequest{Name: "projects/export"}, stream); err != nil {
+		t.Fatalf("ExportProject() returned error: %s", err)
+	}
+	if stream.chunks != 3 {
+		t.Errorf("ExportProject() sent %d chunks, want 3", stream.chunks)
+	}
+}
+
+func TestImportProject(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	lifecycle := seedArchiveProject(ctx, t, server)
+
+	for _, format := range []rpc.ExportProjectRequest_Format{
+		rpc.ExportProjectRequest_TAR,
+		rpc.ExportProjectRequest_ZIP,
+	} {
+		t.Run(format.String(), func(t *testing.T) {
+			data := exportProject(t, server, &rpc.ExportProjectRequest{Name: "projects/export", Format: format})
+			project := "projects/import-" + strings.ToLower(format.String())
+			stream := &importStream{name: project, data: data}
+			if err := server.ImportProject(stream); err != nil {
+				t.Fatalf("ImportProject() returned error: %s", err)
+			}
+			if got := stream.response.GetResourceCount(); got != 5 {
+				t.Errorf("ImportProject() imported %d resources, want 5", got)
+			}
+			if got := stream.response.GetProject().GetName(); got != project {
+				t.Errorf("ImportProject() returned project %q, want %q", got, project)
+			}
+
+			parent := project + "/locations/global"
+			spec, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: parent + "/apis/a/versions/v/specs/s"})
+			if err != nil {
+				t.Fatalf("GetApiSpec() returned error: %s", err)
+			}
+			contents, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec.GetName()})
+			if err != nil {
+				t.Fatalf("GetApiSpecContents() returned error: %s", err)
+			}
+			if got := string(contents.GetData()); got != "openapi: 3.0.0\n" {
+				t.Errorf("GetApiSpecContents() returned %q, want exported contents", got)
+			}
+			deployment, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: parent + "/apis/a/deployments/d"})
+			if err != nil {
+				t.Fatalf("GetApiDeployment() returned error: %s", err)
+			}
+			if got, want := deployment.GetApiSpecRevision(), spec.GetName()+"@"+spec.GetRevisionId(); got != want {
+				t.Errorf("GetApiDeployment() returned spec revision %q, want %q", got, want)
+			}
+			artifact, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: parent + "/artifacts/lifecycle"})
+			if err != nil {
+				t.Fatalf("GetArtifactContents() returned error: %s", err)
+			}
+			got := &apihub.Lifecycle{}
+			if err := proto.Unmarshal(artifact.GetData(), got); err != nil {
+				t.Fatalf("Failed to unmarshal artifact: %s", err)
+			}
+			if diff := cmp.Diff(lifecycle, got, protocmp.Transform()); diff != "" {
+				t.Errorf("GetArtifactContents() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImportProjectIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedArchiveProject(ctx, t, server)
+	data := exportProject(t, server, &rpc.ExportProjectRequest{Name: "projects/export"})
+	files, err := readArchive(data, maxImportContentsSize)
+	if err != nil {
+		t.Fatalf("readArchive() returned error: %s", err)
+	}
+
+	// Add a deployment that can't be applied because its API is missing.
+	files["apis/missing/deployments/d/info.yaml"] = []byte("apiVersion: apigeeregistry/v1\nkind: Deployment\nmetadata:\n  name: d\n  parent: apis/missing\n")
+	a := &bytes.Buffer{}
+	w := &tarWriter{w: tar.NewWriter(a)}
+	for name, contents := range files {
+		if err := w.WriteFile("export/"+name, contents); err != nil {
+			t.Fatalf("Setup: failed to write archive: %s", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Setup: failed to write archive: %s", err)
+	}
+
+	err = server.ImportProject(&importStream{name: "projects/atomic", data: a.Bytes()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ImportProject() returned status %s, want %s: %v", status.Code(err), codes.NotFound, err)
+	}
+	if _, err := server.GetProject(ctx, &rpc.GetProjectRequest{Name: "projects/atomic"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetProject() returned status %s, want %s: %v", status.Code(err), codes.NotFound, err)
+	}
+}
+
+func TestImportProjectAuthorization(t *testing.T) {
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to get server with SQLite: %s", err)
+	}
+	server.policy = &auth.Policy{Bindings: []auth.Binding{
+		{Principal: "editor@example.com", Project: "my-project", Role: auth.Editor},
+	}}
+	// The caller is rejected before the rest of the archive is received.
+	stream := &importStream{
+		ctx:  auth.NewContext(context.Background(), "editor@example.com"),
+		name: "projects/my-project",
+		data: make([]byte, 1000),
+	}
+	if err := server.ImportProject(stream); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ImportProject() returned status %s, want %s: %v", status.Code(err), codes.PermissionDenied, err)
+	}
+	if got, want := len(stream.data), 900; got != want {
+		t.Errorf("ImportProject() left %d bytes unread, want %d", got, want)
+	}
+}
+
+func TestReadArchiveLimit(t *testing.T) {
+	contents := []byte(strings.Repeat("a", 200))
+	tarData := &bytes.Buffer{}
+	tw := &tarWriter{w: tar.NewWriter(tarData)}
+	zipData := &bytes.Buffer{}
+	zw := &zipWriter{w: zip.NewWriter(zipData)}
+	for _, w := range []archiveWriter{tw, zw} {
+		if err := w.WriteFile("p/a.yaml", contents); err != nil {
+			t.Fatalf("Setup: failed to write archive: %s", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Setup: failed to write archive: %s", err)
+		}
+	}
+	for name, data := range map[string][]byte{"tar": tarData.Bytes(), "zip": zipData.Bytes()} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := readArchive(data, 199); err != errArchiveTooLarge {
+				t.Errorf("readArchive() with a limit below the contents size returned %v, want %v", err, errArchiveTooLarge)
+			}
+			files, err := readArchive(data, 200)
+			if err != nil {
+				t.Fatalf("readArchive() returned error: %s", err)
+			}
+			if got := string(files["a.yaml"]); got != string(contents) {
+				t.Errorf("readArchive() returned %d bytes, want %d", len(got), len(contents))
+			}
+		})
+	}
+}
+
+func TestArchiveErrors(t *testing.T) {
+	server := defaultTestServer(t)
+	tests := []struct {
+		desc string
+		err  error
+		want codes.Code
+	}{
+		{
+			desc: "export with invalid name",
+			err:  server.ExportProject(&rpc.ExportProjectRequest{Name: "invalid"}, &exportStream{}),
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "export of missing project",
+			err:  server.ExportProject(&rpc.ExportProjectRequest{Name: "projects/missing"}, &exportStream{}),
+			want: codes.NotFound,
+		},
+		{
+			desc: "export with invalid format",
+			err:  server.ExportProject(&rpc.ExportProjectRequest{Name: "projects/missing", Format: 99}, &exportStream{}),
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "import with invalid name",
+			err:  server.ImportProject(&importStream{name: "invalid"}),
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "import of invalid archive",
+			err:  server.ImportProject(&importStream{name: "projects/p", data: []byte("not an archive")}),
+			want: codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if status.Code(test.err) != test.want {
+				t.Errorf("returned status %s, want %s: %v", status.Code(test.err), test.want, test.err)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/genproto/googleapis/api/httpbody"
@@ -60,6 +61,10 @@ func (s *RegistryServer) CreateArtifact(ctx context.Context, req *rpc.CreateArti
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, parent.Artifact("-").ProjectID(), auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.Artifact
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// Creation should only succeed when the parent exists.
@@ -108,12 +113,17 @@ func (s *RegistryServer) CreateArtifact(ctx context.Context, req *rpc.CreateArti
 			return err
 		}
 		response, err = artifact.Message()
-		return err
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, response)
 	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -124,15 +134,67 @@ func (s *RegistryServer) DeleteArtifact(ctx context.Context, req *rpc.DeleteArti
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID(), auth.Editor); err != nil {
+		return nil, err
+	}
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.DeleteArtifact(ctx, name)
+		if req.GetEtag() != "" {
+			artifact, err := db.GetArtifact(ctx, name, false)
+			if err != nil {
+				return err
+			}
+			if err := checkEtag(req.GetName(), req.GetEtag(), artifact.Etag()); err != nil {
+				return err
+			}
+		}
+		if err := db.DeleteArtifact(ctx, name, s.deleteExpireTime()); err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_DELETED, req.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, req.GetName(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteArtifact handles the corresponding API request.
+func (s *RegistryServer) UndeleteArtifact(ctx context.Context, req *rpc.UndeleteArtifactRequest) (*rpc.Artifact, error) {
+	// Artifact name must be valid.
+	name, err := names.ParseArtifact(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID(), auth.Editor); err != nil {
+		return nil, err
+	}
+	var response *rpc.Artifact
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.LockArtifacts(ctx).UndeleteArtifact(ctx, name); err != nil {
+			return err
+		}
+		artifact, err := db.GetArtifact(ctx, name, false)
+		if err != nil {
+			return err
+		}
+		response, err = artifact.Message()
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // GetArtifact handles the corresponding API request.
 func (s *RegistryServer) GetArtifact(ctx context.Context, req *rpc.GetArtifactRequest) (*rpc.Artifact, error) {
 	db, err := s.getStorageClient(ctx)
@@ -144,6 +206,10 @@ func (s *RegistryServer) GetArtifact(ctx context.Context, req *rpc.GetArtifactRe
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, name.ProjectID(), auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	artifact, err := db.GetArtifact(ctx, name, false)
 	if err != nil {
@@ -169,6 +235,10 @@ func (s *RegistryServer) GetArtifactContents(ctx context.Context, req *rpc.GetAr
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, name.ProjectID(), auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	artifact, err := db.GetArtifact(ctx, name, false)
 	if err != nil {
@@ -213,57 +283,68 @@ func (s *RegistryServer) ListArtifacts(ctx context.Context, req *rpc.ListArtifac
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, parent.Artifact("-").ProjectID(), auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	var listing storage.ArtifactList
 	switch parent := parent.(type) {
 	case names.Project:
 		listing, err = db.ListProjectArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Order:  req.GetOrderBy(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Api:
 		listing, err = db.ListApiArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Order:  req.GetOrderBy(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Version:
 		listing, err = db.ListVersionArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Order:  req.GetOrderBy(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Spec:
 		listing, err = db.ListSpecArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Order:  req.GetOrderBy(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.SpecRevision:
 		listing, err = db.ListSpecRevisionArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Order:  req.GetOrderBy(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Deployment:
 		listing, err = db.ListDeploymentArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Order:  req.GetOrderBy(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.DeploymentRevision:
 		listing, err = db.ListDeploymentRevisionArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Order:  req.GetOrderBy(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	}
 	if err != nil {
@@ -296,6 +377,10 @@ func (s *RegistryServer) ReplaceArtifact(ctx context.Context, req *rpc.ReplaceAr
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID(), auth.Editor); err != nil {
+		return nil, err
+	}
 
 	var artifact *models.Artifact
 	err = db.Transaction(ctx, func(ctx context.Context, db *storage.Client) error {
@@ -304,6 +389,9 @@ func (s *RegistryServer) ReplaceArtifact(ctx context.Context, req *rpc.ReplaceAr
 		if err != nil {
 			return err
 		}
+		if err := checkEtag(name.String(), req.GetArtifact().GetEtag(), art.Etag()); err != nil {
+			return err
+		}
 		artifact, err = models.NewArtifact(name, req.GetArtifact())
 		if err != nil {
 			return err
@@ -313,12 +401,26 @@ func (s *RegistryServer) ReplaceArtifact(ctx context.Context, req *rpc.ReplaceAr
 		if err := db.SaveArtifact(ctx, artifact); err != nil {
 			return err
 		}
-		return db.SaveArtifactContents(ctx, artifact, req.Artifact.GetContents())
+		if err := db.SaveArtifactContents(ctx, artifact, req.Artifact.GetContents()); err != nil {
+			return err
+		}
+		before, err := art.Message()
+		if err != nil {
+			return err
+		}
+		after, err := artifact.Message()
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_UPDATED, name.String()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, name.String(), before, after)
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.outbox.signal()
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
 	return artifact.Message()
 }
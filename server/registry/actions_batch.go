@@ -0,0 +1,318 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchSize is the largest number of items allowed in a batch request.
+const maxBatchSize = 1000
+
+// BatchCreateApiSpecs handles the corresponding API request.
+func (s *RegistryServer) BatchCreateApiSpecs(ctx context.Context, req *rpc.BatchCreateApiSpecsRequest) (*rpc.BatchCreateApiSpecsResponse, error) {
+	parent, err := names.ParseVersion(req.GetParent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkBatchParent(parent.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := checkBatchSize("requests", len(req.GetRequests())); err != nil {
+		return nil, err
+	}
+	// Every spec must be created in a version that matches the parent.
+	for i, r := range req.GetRequests() {
+		if r.GetParent() == "" {
+			if parent.ApiID == "-" || parent.VersionID == "-" {
+				return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: parent is required when the batch parent contains wildcards", i)
+			}
+			r.Parent = req.GetParent()
+		}
+		v, err := names.ParseVersion(r.GetParent())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: %s", i, err)
+		}
+		if v.ProjectID != parent.ProjectID ||
+			(parent.ApiID != "-" && v.ApiID != parent.ApiID) ||
+			(parent.VersionID != "-" && v.VersionID != parent.VersionID) {
+			return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: parent %q does not match %q", i, r.GetParent(), req.GetParent())
+		}
+	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
+	response := &rpc.BatchCreateApiSpecsResponse{}
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		response.ApiSpecs = nil
+		for i, r := range req.GetRequests() {
+			spec, err := s.CreateApiSpec(ctx, r)
+			if err != nil {
+				return batchError("requests", i, err)
+			}
+			response.ApiSpecs = append(response.ApiSpecs, spec)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// BatchUpdateArtifacts handles the corresponding API request.
+func (s *RegistryServer) BatchUpdateArtifacts(ctx context.Context, req *rpc.BatchUpdateArtifactsRequest) (*rpc.BatchUpdateArtifactsResponse, error) {
+	parent, err := names.ParseProjectWithLocation(req.GetParent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkBatchParent(parent.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := checkBatchSize("requests", len(req.GetRequests())); err != nil {
+		return nil, err
+	}
+	for i, r := range req.GetRequests() {
+		if err := checkBatchMember(parent, "requests", i, r.GetArtifact().GetName()); err != nil {
+			return nil, err
+		}
+	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
+	response := &rpc.BatchUpdateArtifactsResponse{}
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		response.Artifacts = nil
+		for i, r := range req.GetRequests() {
+			artifact, err := s.replaceOrCreateArtifact(ctx, r, req.GetAllowMissing())
+			if err != nil {
+				return batchError("requests", i, err)
+			}
+			response.Artifacts = append(response.Artifacts, artifact)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// replaceOrCreateArtifact replaces an artifact, creating it first if
+// allowMissing is set and it doesn't exist.
+func (s *RegistryServer) replaceOrCreateArtifact(ctx context.Context, req *rpc.ReplaceArtifactRequest, allowMissing bool) (*rpc.Artifact, error) {
+	if allowMissing {
+		name, err := names.ParseArtifact(req.GetArtifact().GetName())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		_, err = s.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: name.String()})
+		if isNotFound(err) {
+			return s.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+				Parent:     name.Parent(),
+				ArtifactId: name.ArtifactID(),
+				Artifact:   req.GetArtifact(),
+			})
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return s.ReplaceArtifact(ctx, req)
+}
+
+// BatchMutate handles the corresponding API request.
+func (s *RegistryServer) BatchMutate(ctx context.Context, req *rpc.BatchMutateRequest) (*rpc.BatchMutateResponse, error) {
+	parent, err := names.ParseProjectWithLocation(req.GetParent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkBatchParent(parent.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := checkBatchSize("mutations", len(req.GetMutations())); err != nil {
+		return nil, err
+	}
+	for i, m := range req.GetMutations() {
+		name, err := mutationTarget(m)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "mutations[%d]: %s", i, err)
+		}
+		if err := checkBatchMember(parent, "mutations", i, name); err != nil {
+			return nil, err
+		}
+	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
+	response := &rpc.BatchMutateResponse{}
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		response.Results = nil
+		for i, m := range req.GetMutations() {
+			result, err := s.mutate(ctx, m)
+			if err != nil {
+				return batchError("mutations", i, err)
+			}
+			response.Results = append(response.Results, result)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// mutate applies a single mutation with the handler of the corresponding request.
+func (s *RegistryServer) mutate(ctx context.Context, m *rpc.Mutation) (*rpc.MutationResult, error) {
+	var err error
+	result := &rpc.MutationResult{}
+	switch op := m.GetOperation().(type) {
+	case *rpc.Mutation_CreateApi:
+		var r *rpc.Api
+		r, err = s.CreateApi(ctx, op.CreateApi)
+		result.Resource = &rpc.MutationResult_Api{Api: r}
+	case *rpc.Mutation_UpdateApi:
+		var r *rpc.Api
+		r, err = s.UpdateApi(ctx, op.UpdateApi)
+		result.Resource = &rpc.MutationResult_Api{Api: r}
+	case *rpc.Mutation_DeleteApi:
+		_, err = s.DeleteApi(ctx, op.DeleteApi)
+	case *rpc.Mutation_CreateApiVersion:
+		var r *rpc.ApiVersion
+		r, err = s.CreateApiVersion(ctx, op.CreateApiVersion)
+		result.Resource = &rpc.MutationResult_ApiVersion{ApiVersion: r}
+	case *rpc.Mutation_UpdateApiVersion:
+		var r *rpc.ApiVersion
+		r, err = s.UpdateApiVersion(ctx, op.UpdateApiVersion)
+		result.Resource = &rpc.MutationResult_ApiVersion{ApiVersion: r}
+	case *rpc.Mutation_DeleteApiVersion:
+		_, err = s.DeleteApiVersion(ctx, op.DeleteApiVersion)
+	case *rpc.Mutation_CreateApiSpec:
+		var r *rpc.ApiSpec
+		r, err = s.CreateApiSpec(ctx, op.CreateApiSpec)
+		result.Resource = &rpc.MutationResult_ApiSpec{ApiSpec: r}
+	case *rpc.Mutation_UpdateApiSpec:
+		var r *rpc.ApiSpec
+		r, err = s.UpdateApiSpec(ctx, op.UpdateApiSpec)
+		result.Resource = &rpc.MutationResult_ApiSpec{ApiSpec: r}
+	case *rpc.Mutation_DeleteApiSpec:
+		_, err = s.DeleteApiSpec(ctx, op.DeleteApiSpec)
+	case *rpc.Mutation_CreateApiDeployment:
+		var r *rpc.ApiDeployment
+		r, err = s.CreateApiDeployment(ctx, op.CreateApiDeployment)
+		result.Resource = &rpc.MutationResult_ApiDeployment{ApiDeployment: r}
+	case *rpc.Mutation_UpdateApiDeployment:
+		var r *rpc.ApiDeployment
+		r, err = s.UpdateApiDeployment(ctx, op.UpdateApiDeployment)
+		result.Resource = &rpc.MutationResult_ApiDeployment{ApiDeployment: r}
+	case *rpc.Mutation_DeleteApiDeployment:
+		_, err = s.DeleteApiDeployment(ctx, op.DeleteApiDeployment)
+	case *rpc.Mutation_CreateArtifact:
+		var r *rpc.Artifact
+		r, err = s.CreateArtifact(ctx, op.CreateArtifact)
+		result.Resource = &rpc.MutationResult_Artifact{Artifact: r}
+	case *rpc.Mutation_ReplaceArtifact:
+		var r *rpc.Artifact
+		r, err = s.replaceOrCreateArtifact(ctx, op.ReplaceArtifact, m.GetAllowMissing())
+		result.Resource = &rpc.MutationResult_Artifact{Artifact: r}
+	case *rpc.Mutation_DeleteArtifact:
+		_, err = s.DeleteArtifact(ctx, op.DeleteArtifact)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "operation is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// mutationTarget returns the name of the resource or collection that a mutation changes.
+func mutationTarget(m *rpc.Mutation) (string, error) {
+	switch op := m.GetOperation().(type) {
+	case *rpc.Mutation_CreateApi:
+		return op.CreateApi.GetParent(), nil
+	case *rpc.Mutation_UpdateApi:
+		return op.UpdateApi.GetApi().GetName(), nil
+	case *rpc.Mutation_DeleteApi:
+		return op.DeleteApi.GetName(), nil
+	case *rpc.Mutation_CreateApiVersion:
+		return op.CreateApiVersion.GetParent(), nil
+	case *rpc.Mutation_UpdateApiVersion:
+		return op.UpdateApiVersion.GetApiVersion().GetName(), nil
+	case *rpc.Mutation_DeleteApiVersion:
+		return op.DeleteApiVersion.GetName(), nil
+	case *rpc.Mutation_CreateApiSpec:
+		return op.CreateApiSpec.GetParent(), nil
+	case *rpc.Mutation_UpdateApiSpec:
+		return op.UpdateApiSpec.GetApiSpec().GetName(), nil
+	case *rpc.Mutation_DeleteApiSpec:
+		return op.DeleteApiSpec.GetName(), nil
+	case *rpc.Mutation_CreateApiDeployment:
+		return op.CreateApiDeployment.GetParent(), nil
+	case *rpc.Mutation_UpdateApiDeployment:
+		return op.UpdateApiDeployment.GetApiDeployment().GetName(), nil
+	case *rpc.Mutation_DeleteApiDeployment:
+		return op.DeleteApiDeployment.GetName(), nil
+	case *rpc.Mutation_CreateArtifact:
+		return op.CreateArtifact.GetParent(), nil
+	case *rpc.Mutation_ReplaceArtifact:
+		return op.ReplaceArtifact.GetArtifact().GetName(), nil
+	case *rpc.Mutation_DeleteArtifact:
+		return op.DeleteArtifact.GetName(), nil
+	default:
+		return "", errors.New("operation is required")
+	}
+}
+
+// checkBatchParent returns an error if the parent of a batch doesn't name a single project.
+func checkBatchParent(projectID string) error {
+	if projectID == "-" {
+		return status.Error(codes.InvalidArgument, "batch parent must name a single project")
+	}
+	return nil
+}
+
+func checkBatchSize(field string, n int) error {
+	if n == 0 {
+		return status.Errorf(codes.InvalidArgument, "%s must not be empty", field)
+	}
+	if n > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "too many %s (%d): a batch can contain at most %d", field, n, maxBatchSize)
+	}
+	return nil
+}
+
+// checkBatchMember returns an error unless name is the parent of a batch or one of its descendants.
+func checkBatchMember(parent names.Project, field string, i int, name string) error {
+	p := parent.String()
+	if name != p && !strings.HasPrefix(name, p+"/") {
+		return status.Errorf(codes.InvalidArgument, "%s[%d]: %q is not in %q", field, i, name, p)
+	}
+	return nil
+}
+
+// batchError identifies the item of a batch that failed, keeping the code of its error.
+func batchError(field string, i int, err error) error {
+	return status.Errorf(status.Code(err), "%s[%d]: %s", field, i, status.Convert(err).Message())
+}
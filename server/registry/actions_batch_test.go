@@ -0,0 +1,311 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const batchParent = "projects/batch/locations/global"
+
+func seedBatchProject(ctx context.Context, t *testing.T, server TestServer) {
+	t.Helper()
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/batch"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+}
+
+func createApiMutation(id string) *rpc.Mutation {
+	return &rpc.Mutation{Operation: &rpc.Mutation_CreateApi{CreateApi: &rpc.CreateApiRequest{
+		Parent: batchParent,
+		ApiId:  id,
+		Api:    &rpc.Api{},
+	}}}
+}
+
+func TestBatchMutate(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedBatchProject(ctx, t, server)
+
+	resp, err := server.BatchMutate(ctx, &rpc.BatchMutateRequest{
+		Parent: batchParent,
+		Mutations: []*rpc.Mutation{
+			createApiMutation("a"),
+			{Operation: &rpc.Mutation_CreateApiVersion{CreateApiVersion: &rpc.CreateApiVersionRequest{
+				Parent:       batchParent + "/apis/a",
+				ApiVersionId: "v1",
+				ApiVersion:   &rpc.ApiVersion{},
+			}}},
+			{Operation: &rpc.Mutation_CreateApiSpec{CreateApiSpec: &rpc.CreateApiSpecRequest{
+				Parent:    batchParent + "/apis/a/versions/v1",
+				ApiSpecId: "s",
+				ApiSpec:   &rpc.ApiSpec{MimeType: "text/plain", Contents: []byte("hello")},
+			}}},
+			{Operation: &rpc.Mutation_UpdateApi{UpdateApi: &rpc.UpdateApiRequest{
+				Api: &rpc.Api{Name: batchParent + "/apis/a", DisplayName: "A"},
+			}}},
+			{Operation: &rpc.Mutation_CreateArtifact{CreateArtifact: &rpc.CreateArtifactRequest{
+				Parent:     batchParent,
+				ArtifactId: "x",
+				Artifact:   &rpc.Artifact{MimeType: "text/plain"},
+			}}},
+			{Operation: &rpc.Mutation_DeleteArtifact{DeleteArtifact: &rpc.DeleteArtifactRequest{
+				Name: batchParent + "/artifacts/x",
+			}}},
+			{
+				Operation: &rpc.Mutation_ReplaceArtifact{ReplaceArtifact: &rpc.ReplaceArtifactRequest{
+					Artifact: &rpc.Artifact{Name: batchParent + "/artifacts/y", MimeType: "text/plain"},
+				}},
+				AllowMissing: true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchMutate() returned error: %s", err)
+	}
+	if got := len(resp.GetResults()); got != 7 {
+		t.Fatalf("BatchMutate() returned %d results, want 7", got)
+	}
+	if got := resp.GetResults()[2].GetApiSpec().GetName(); got != batchParent+"/apis/a/versions/v1/specs/s" {
+		t.Errorf("BatchMutate() results[2] is %q, want the created spec", got)
+	}
+	if got := resp.GetResults()[5].GetResource(); got != nil {
+		t.Errorf("BatchMutate() results[5] is %v, want empty result for delete", got)
+	}
+
+	api, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: batchParent + "/apis/a"})
+	if err != nil {
+		t.Fatalf("GetApi() returned error: %s", err)
+	}
+	if api.GetDisplayName() != "A" {
+		t.Errorf("GetApi() returned display name %q, want %q", api.GetDisplayName(), "A")
+	}
+	if _, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: batchParent + "/artifacts/x"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetArtifact() returned status %s, want %s", status.Code(err), codes.NotFound)
+	}
+	if _, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: batchParent + "/artifacts/y"}); err != nil {
+		t.Errorf("GetArtifact() returned error: %s", err)
+	}
+}
+
+func TestBatchMutateIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedBatchProject(ctx, t, server)
+
+	_, err := server.BatchMutate(ctx, &rpc.BatchMutateRequest{
+		Parent: batchParent,
+		Mutations: []*rpc.Mutation{
+			createApiMutation("a"),
+			createApiMutation("b"),
+			createApiMutation("a"),
+		},
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("BatchMutate() returned status %s, want %s: %s", status.Code(err), codes.AlreadyExists, err)
+	}
+	if want := "mutations[2]: "; !strings.HasPrefix(status.Convert(err).Message(), want) {
+		t.Errorf("BatchMutate() returned message %q, want prefix %q", status.Convert(err).Message(), want)
+	}
+	for _, id := range []string{"a", "b"} {
+		if _, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: batchParent + "/apis/" + id}); status.Code(err) != codes.NotFound {
+			t.Errorf("GetApi(%q) returned status %s, want %s", id, status.Code(err), codes.NotFound)
+		}
+	}
+}
+
+func TestBatchRequestErrors(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedBatchProject(ctx, t, server)
+
+	tooMany := make([]*rpc.Mutation, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = createApiMutation(fmt.Sprintf("a%d", i))
+	}
+	tests := []struct {
+		desc string
+		call func() error
+	}{
+		{
+			desc: "empty batch",
+			call: func() error {
+				_, err := server.BatchMutate(ctx, &rpc.BatchMutateRequest{Parent: batchParent})
+				return err
+			},
+		},
+		{
+			desc: "too many mutations",
+			call: func() error {
+				_, err := server.BatchMutate(ctx, &rpc.BatchMutateRequest{Parent: batchParent, Mutations: tooMany})
+				return err
+			},
+		},
+		{
+			desc: "mutation outside the parent",
+			call: func() error {
+				_, err := server.BatchMutate(ctx, &rpc.BatchMutateRequest{
+					Parent: batchParent,
+					Mutations: []*rpc.Mutation{{Operation: &rpc.Mutation_DeleteApi{DeleteApi: &rpc.DeleteApiRequest{
+						Name: "projects/other/locations/global/apis/a",
+					}}}},
+				})
+				return err
+			},
+		},
+		{
+			desc: "missing operation",
+			call: func() error {
+				_, err := server.BatchMutate(ctx, &rpc.BatchMutateRequest{Parent: batchParent, Mutations: []*rpc.Mutation{{}}})
+				return err
+			},
+		},
+		{
+			desc: "wildcard project",
+			call: func() error {
+				_, err := server.BatchMutate(ctx, &rpc.BatchMutateRequest{
+					Parent:    "projects/-/locations/global",
+					Mutations: []*rpc.Mutation{createApiMutation("a")},
+				})
+				return err
+			},
+		},
+		{
+			desc: "spec outside the parent version",
+			call: func() error {
+				_, err := server.BatchCreateApiSpecs(ctx, &rpc.BatchCreateApiSpecsRequest{
+					Parent: batchParent + "/apis/a/versions/-",
+					Requests: []*rpc.CreateApiSpecRequest{{
+						Parent:    batchParent + "/apis/b/versions/v1",
+						ApiSpecId: "s",
+					}},
+				})
+				return err
+			},
+		},
+		{
+			desc: "artifact outside the parent",
+			call: func() error {
+				_, err := server.BatchUpdateArtifacts(ctx, &rpc.BatchUpdateArtifactsRequest{
+					Parent: batchParent,
+					Requests: []*rpc.ReplaceArtifactRequest{{
+						Artifact: &rpc.Artifact{Name: "projects/other/locations/global/artifacts/x"},
+					}},
+				})
+				return err
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if err := test.call(); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("returned status %s, want %s: %s", status.Code(err), codes.InvalidArgument, err)
+			}
+		})
+	}
+}
+
+func TestBatchCreateApiSpecs(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedVersions(ctx, server,
+		&rpc.ApiVersion{Name: batchParent + "/apis/a/versions/v1"},
+		&rpc.ApiVersion{Name: batchParent + "/apis/b/versions/v1"},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	req := &rpc.BatchCreateApiSpecsRequest{
+		Parent: batchParent + "/apis/-/versions/-",
+		Requests: []*rpc.CreateApiSpecRequest{
+			{Parent: batchParent + "/apis/a/versions/v1", ApiSpecId: "s", ApiSpec: &rpc.ApiSpec{}},
+			{Parent: batchParent + "/apis/b/versions/v1", ApiSpecId: "s", ApiSpec: &rpc.ApiSpec{}},
+		},
+	}
+	resp, err := server.BatchCreateApiSpecs(ctx, req)
+	if err != nil {
+		t.Fatalf("BatchCreateApiSpecs() returned error: %s", err)
+	}
+	if got := len(resp.GetApiSpecs()); got != 2 {
+		t.Fatalf("BatchCreateApiSpecs() returned %d specs, want 2", got)
+	}
+
+	// A second batch fails entirely because its first spec already exists.
+	req.Requests = []*rpc.CreateApiSpecRequest{
+		{Parent: batchParent + "/apis/b/versions/v1", ApiSpecId: "t", ApiSpec: &rpc.ApiSpec{}},
+		{Parent: batchParent + "/apis/a/versions/v1", ApiSpecId: "s", ApiSpec: &rpc.ApiSpec{}},
+	}
+	if _, err := server.BatchCreateApiSpecs(ctx, req); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("BatchCreateApiSpecs() returned status %s, want %s", status.Code(err), codes.AlreadyExists)
+	}
+	if _, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: batchParent + "/apis/b/versions/v1/specs/t"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApiSpec() returned status %s, want %s", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestBatchUpdateArtifacts(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedArtifacts(ctx, server,
+		&rpc.Artifact{Name: batchParent + "/artifacts/x", MimeType: "text/plain", Contents: []byte("old")},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	req := &rpc.BatchUpdateArtifactsRequest{
+		Parent: batchParent,
+		Requests: []*rpc.ReplaceArtifactRequest{
+			{Artifact: &rpc.Artifact{Name: batchParent + "/artifacts/x", MimeType: "text/plain", Contents: []byte("new")}},
+			{Artifact: &rpc.Artifact{Name: batchParent + "/artifacts/y", MimeType: "text/plain", Contents: []byte("new")}},
+		},
+	}
+	if _, err := server.BatchUpdateArtifacts(ctx, req); status.Code(err) != codes.NotFound {
+		t.Fatalf("BatchUpdateArtifacts() returned status %s, want %s", status.Code(err), codes.NotFound)
+	}
+	contents, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: batchParent + "/artifacts/x"})
+	if err != nil {
+		t.Fatalf("GetArtifactContents() returned error: %s", err)
+	}
+	if got := string(contents.GetData()); got != "old" {
+		t.Errorf("GetArtifactContents() returned %q after a failed batch, want %q", got, "old")
+	}
+
+	req.AllowMissing = true
+	resp, err := server.BatchUpdateArtifacts(ctx, req)
+	if err != nil {
+		t.Fatalf("BatchUpdateArtifacts() returned error: %s", err)
+	}
+	if got := len(resp.GetArtifacts()); got != 2 {
+		t.Fatalf("BatchUpdateArtifacts() returned %d artifacts, want 2", got)
+	}
+	for _, name := range []string{batchParent + "/artifacts/x", batchParent + "/artifacts/y"} {
+		contents, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name})
+		if err != nil {
+			t.Fatalf("GetArtifactContents(%q) returned error: %s", name, err)
+		}
+		if got := string(contents.GetData()); got != "new" {
+			t.Errorf("GetArtifactContents(%q) returned %q, want %q", name, got, "new")
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/grpc/codes"
@@ -44,6 +45,10 @@ func (s *RegistryServer) ListApiDeploymentRevisions(ctx context.Context, req *rp
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	listing, err := db.ListDeploymentRevisions(ctx, parent, storage.PageOptions{
 		Size:   req.GetPageSize(),
@@ -77,12 +82,19 @@ func (s *RegistryServer) DeleteApiDeploymentRevision(ctx context.Context, req *r
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiDeployment
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		if err := db.DeleteDeploymentRevision(ctx, name); err != nil {
 			return err
 		}
-		return nil
+		if err := s.notify(ctx, db, rpc.Notification_DELETED, name.String()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, name.String(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
@@ -91,7 +103,6 @@ func (s *RegistryServer) DeleteApiDeploymentRevision(ctx context.Context, req *r
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return response, nil
 }
 
@@ -114,8 +125,11 @@ func (s *RegistryServer) TagApiDeploymentRevision(ctx context.Context, req *rpc.
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiDeployment
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// The revision to be tagged must exist.
 		revision, err := db.GetDeploymentRevision(ctx, name)
@@ -136,12 +150,14 @@ func (s *RegistryServer) TagApiDeploymentRevision(ctx context.Context, req *rpc.
 		if err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
-		revisionName = name.String()
-		return nil
+		if err := s.notify(ctx, db, rpc.Notification_UPDATED, name.String()); err != nil {
+			return err
+		}
+		// The tagged name of the revision records the tag.
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, revisionName)
 	return response, nil
 }
 
@@ -156,8 +172,11 @@ func (s *RegistryServer) RollbackApiDeployment(ctx context.Context, req *rpc.Rol
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiDeployment
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// Get the target deployment revision to use as a base for the new rollback revision.
 		name := parent.Revision(req.GetRevisionId())
@@ -165,6 +184,15 @@ func (s *RegistryServer) RollbackApiDeployment(ctx context.Context, req *rpc.Rol
 		if err != nil {
 			return err
 		}
+		// The current revision is the state the rollback changes.
+		current, err := db.GetDeployment(ctx, parent)
+		if err != nil {
+			return err
+		}
+		before, err := current.BasicMessage(current.RevisionName())
+		if err != nil {
+			return err
+		}
 		// Save a new rollback revision based on the target revision.
 		rollback := target.NewRevision()
 		if err := db.SaveDeploymentRevision(ctx, rollback); err != nil {
@@ -174,11 +202,12 @@ func (s *RegistryServer) RollbackApiDeployment(ctx context.Context, req *rpc.Rol
 		if err != nil {
 			return err
 		}
-		revisionName = rollback.RevisionName()
-		return nil
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, rollback.RevisionName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, rollback.RevisionName(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, revisionName)
 	return response, nil
 }
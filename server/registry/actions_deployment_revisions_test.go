@@ -300,7 +300,7 @@ func TestRollbackApiDeployment(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	if !cmp.Equal(want, rollback, opts) {
@@ -540,7 +540,7 @@ func TestListApiDeploymentRevisions(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApiDeploymentRevisionsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.ApiDeployment), "name", "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiDeployment), "name", "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -628,6 +628,7 @@ func TestListApiDeploymentRevisionsSequence(t *testing.T) {
 		CreateTime:         firstRevision.GetCreateTime(),
 		RevisionCreateTime: firstRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: firstRevision.GetRevisionUpdateTime(),
+		Etag:               firstRevision.GetEtag(),
 		RevisionId:         firstRevision.GetRevisionId(),
 	}
 
@@ -649,6 +650,7 @@ func TestListApiDeploymentRevisionsSequence(t *testing.T) {
 		CreateTime:         secondRevision.GetCreateTime(),
 		RevisionCreateTime: secondRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: secondRevision.GetRevisionUpdateTime(),
+		Etag:               secondRevision.GetEtag(),
 		RevisionId:         secondRevision.GetRevisionId(),
 	}
 
@@ -793,7 +795,7 @@ func TestUpdateApiDeploymentRevisions(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	t.Run("modify revision without content changes", func(t *testing.T) {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/grpc/codes"
@@ -38,15 +39,24 @@ func (s *RegistryServer) CreateApiDeployment(ctx context.Context, req *rpc.Creat
 	if err := name.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiDeployment
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createDeployment(ctx, db, name, req.GetApiDeployment())
-		return err
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -77,21 +87,80 @@ func (s *RegistryServer) DeleteApiDeployment(ctx context.Context, req *rpc.Delet
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockDeployments(ctx).DeleteDeployment(ctx, name, req.GetForce())
+		db.LockDeployments(ctx)
+		if req.GetEtag() != "" {
+			deployment, err := db.GetDeployment(ctx, name)
+			if err != nil {
+				return err
+			}
+			if err := checkEtag(req.GetName(), req.GetEtag(), deployment.Etag()); err != nil {
+				return err
+			}
+		}
+		if err := db.DeleteDeployment(ctx, name, req.GetForce(), s.deleteExpireTime()); err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_DELETED, req.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, req.GetName(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApiDeployment handles the corresponding API request.
+func (s *RegistryServer) UndeleteApiDeployment(ctx context.Context, req *rpc.UndeleteApiDeploymentRequest) (*rpc.ApiDeployment, error) {
+	// Deployment name must be valid.
+	name, err := names.ParseDeployment(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
+	var response *rpc.ApiDeployment
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.LockDeployments(ctx).UndeleteDeployment(ctx, name); err != nil {
+			return err
+		}
+		deployment, err := db.GetDeployment(ctx, name)
+		if err != nil {
+			return err
+		}
+		response, err = deployment.BasicMessage(name.String())
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // GetApiDeployment handles the corresponding API request.
 func (s *RegistryServer) GetApiDeployment(ctx context.Context, req *rpc.GetApiDeploymentRequest) (*rpc.ApiDeployment, error) {
 	if name, err := names.ParseDeployment(req.GetName()); err == nil {
+		if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+			return nil, err
+		}
 		return s.getApiDeployment(ctx, name)
 	} else if name, err := names.ParseDeploymentRevision(req.GetName()); err == nil {
+		if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+			return nil, err
+		}
 		return s.getApiDeploymentRevision(ctx, name)
 	}
 
@@ -155,12 +224,17 @@ func (s *RegistryServer) ListApiDeployments(ctx context.Context, req *rpc.ListAp
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	listing, err := db.ListDeployments(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Order:  req.GetOrderBy(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -192,14 +266,25 @@ func (s *RegistryServer) UpdateApiDeployment(ctx context.Context, req *rpc.Updat
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	// Update mask must be valid.
 	if err := models.ValidateMask(req.GetApiDeployment(), req.GetUpdateMask()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
 	}
 	var response *rpc.ApiDeployment
 	if err = s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		var before *rpc.ApiDeployment
 		deployment, err := db.GetDeployment(ctx, name)
 		if err == nil {
+			if err := checkEtag(name.String(), req.GetApiDeployment().GetEtag(), deployment.Etag()); err != nil {
+				return err
+			}
+			if before, err = deployment.BasicMessage(name.String()); err != nil {
+				return err
+			}
 			// Apply the update to the deployment - possibly changing the revision ID.
 			maskExpansion := models.ExpandMask(req.GetApiDeployment(), req.GetUpdateMask())
 			if err := deployment.Update(req.GetApiDeployment(), maskExpansion); err != nil {
@@ -210,19 +295,25 @@ func (s *RegistryServer) UpdateApiDeployment(ctx context.Context, req *rpc.Updat
 				return err
 			}
 			response, err = deployment.BasicMessage(name.String())
-			return err
+			if err != nil {
+				return err
+			}
 		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
 			response, err = s.createDeployment(ctx, db, name, req.GetApiDeployment())
 			if status.Code(err) == codes.AlreadyExists {
-				err = status.Error(codes.Aborted, err.Error())
+				return status.Error(codes.Aborted, err.Error())
+			} else if err != nil {
+				return err
 			}
-			return err
 		} else {
 			return err
 		}
+		if err := s.notify(ctx, db, rpc.Notification_UPDATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
 	return response, nil
 }
@@ -19,6 +19,7 @@ import (
 
 	longrunning "cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -26,6 +27,10 @@ import (
 
 // MigrateDatabase handles the corresponding API request.
 func (s *RegistryServer) MigrateDatabase(ctx context.Context, req *rpc.MigrateDatabaseRequest) (*longrunning.Operation, error) {
+	// Caller must have the admin role in all projects.
+	if err := s.authorize(ctx, "-", auth.Admin); err != nil {
+		return nil, err
+	}
 	if req.Kind != "" && req.Kind != "auto" {
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported migration kind %q", req.Kind)
 	}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/grpc/codes"
@@ -33,15 +34,24 @@ func (s *RegistryServer) CreateProject(ctx context.Context, req *rpc.CreateProje
 	if err := name.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
 	var response *rpc.Project
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createProject(ctx, db, name, req.GetProject())
-		return err
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -62,15 +72,65 @@ func (s *RegistryServer) DeleteProject(ctx context.Context, req *rpc.DeleteProje
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockProjects(ctx).DeleteProject(ctx, name, req.GetForce())
+		db.LockProjects(ctx)
+		if req.GetEtag() != "" {
+			project, err := db.GetProject(ctx, name)
+			if err != nil {
+				return err
+			}
+			if err := checkEtag(req.GetName(), req.GetEtag(), project.Etag()); err != nil {
+				return err
+			}
+		}
+		if err := db.DeleteProject(ctx, name, req.GetForce(), s.deleteExpireTime()); err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_DELETED, req.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, req.GetName(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteProject handles the corresponding API request.
+func (s *RegistryServer) UndeleteProject(ctx context.Context, req *rpc.UndeleteProjectRequest) (*rpc.Project, error) {
+	// Project name must be valid.
+	name, err := names.ParseProject(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
+	var response *rpc.Project
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.LockProjects(ctx).UndeleteProject(ctx, name); err != nil {
+			return err
+		}
+		project, err := db.GetProject(ctx, name)
+		if err != nil {
+			return err
+		}
+		response = project.Message()
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // GetProject handles the corresponding API request.
 func (s *RegistryServer) GetProject(ctx context.Context, req *rpc.GetProjectRequest) (*rpc.Project, error) {
 	db, err := s.getStorageClient(ctx)
@@ -82,6 +142,10 @@ func (s *RegistryServer) GetProject(ctx context.Context, req *rpc.GetProjectRequ
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	project, err := db.GetProject(ctx, name)
 	if err != nil {
@@ -93,6 +157,10 @@ func (s *RegistryServer) GetProject(ctx context.Context, req *rpc.GetProjectRequ
 
 // ListProjects handles the corresponding API request.
 func (s *RegistryServer) ListProjects(ctx context.Context, req *rpc.ListProjectsRequest) (*rpc.ListProjectsResponse, error) {
+	// Caller must have the viewer role in all projects.
+	if err := s.authorize(ctx, "-", auth.Viewer); err != nil {
+		return nil, err
+	}
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
@@ -107,10 +175,11 @@ func (s *RegistryServer) ListProjects(ctx context.Context, req *rpc.ListProjects
 	}
 
 	listing, err := db.ListProjects(ctx, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Order:  req.GetOrderBy(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -139,6 +208,10 @@ func (s *RegistryServer) UpdateProject(ctx context.Context, req *rpc.UpdateProje
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
 	// Update mask must be valid.
 	if err := models.ValidateMask(req.GetProject(), req.GetUpdateMask()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
@@ -146,23 +219,32 @@ func (s *RegistryServer) UpdateProject(ctx context.Context, req *rpc.UpdateProje
 	var response *rpc.Project
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		db.LockProjects(ctx)
+		var before *rpc.Project
 		project, err := db.GetProject(ctx, name)
 		if err == nil {
+			if err := checkEtag(name.String(), req.GetProject().GetEtag(), project.Etag()); err != nil {
+				return err
+			}
+			before = project.Message()
 			project.Update(req.GetProject(), models.ExpandMask(req.GetProject(), req.GetUpdateMask()))
 			if err := db.SaveProject(ctx, project); err != nil {
 				return err
 			}
 			response = project.Message()
-			return nil
 		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
 			response, err = s.createProject(ctx, db, name, req.GetProject())
-			return err
+			if err != nil {
+				return err
+			}
 		} else {
 			return err
 		}
+		if err := s.notify(ctx, db, rpc.Notification_UPDATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
 	return response, nil
 }
@@ -0,0 +1,76 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchResources handles the corresponding API request.
+func (s *RegistryServer) SearchResources(ctx context.Context, req *rpc.SearchResourcesRequest) (*rpc.SearchResourcesResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	parent, err := names.ParseProjectWithLocation(req.GetParent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
+
+	listing, err := db.SearchResources(ctx, parent, req.GetQuery(), storage.PageOptions{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rpc.SearchResourcesResponse{
+		Results:       make([]*rpc.SearchResult, len(listing.Results)),
+		NextPageToken: listing.Token,
+	}
+
+	for i, r := range listing.Results {
+		response.Results[i] = &rpc.SearchResult{
+			Name:        r.Name,
+			Kind:        r.Kind,
+			Title:       r.Title,
+			Description: r.Description,
+			Score:       r.Score,
+		}
+	}
+
+	return response, nil
+}
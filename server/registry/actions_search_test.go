@@ -0,0 +1,379 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	searchParent   = "projects/search/locations/global"
+	petstoreName   = searchParent + "/apis/petstore"
+	bookstoreName  = searchParent + "/apis/bookstore"
+	petstoreSpec   = petstoreName + "/versions/v1/specs/openapi"
+	petstoreReport = petstoreName + "/artifacts/report"
+)
+
+func petstoreContents(operationID string) []byte {
+	return []byte(fmt.Sprintf(`openapi: 3.0.0
+info:
+  title: Swagger Petstore
+paths:
+  /pets:
+    get:
+      operationId: %s
+      summary: List all pets
+`, operationID))
+}
+
+func seedSearch(ctx context.Context, t *testing.T, server TestServer) {
+	t.Helper()
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.Api{
+			Name:        petstoreName,
+			DisplayName: "Swagger Petstore",
+			Description: "A sample API for pets",
+			Labels:      map[string]string{"team": "animals"},
+		},
+		&rpc.Api{
+			Name:        bookstoreName,
+			DisplayName: "Bookstore",
+			Description: "A sample API for books",
+		},
+		&rpc.ApiSpec{
+			Name:     petstoreSpec,
+			Filename: "openapi.yaml",
+			MimeType: "application/x.openapi;version=3.0.0",
+			Contents: petstoreContents("listPets"),
+		},
+		&rpc.Artifact{
+			Name:        petstoreReport,
+			MimeType:    "text/plain",
+			Annotations: map[string]string{"summary": "weekly traffic report"},
+		},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+}
+
+func searchNames(ctx context.Context, t *testing.T, server TestServer, parent, query string) []string {
+	t.Helper()
+	resp, err := server.SearchResources(ctx, &rpc.SearchResourcesRequest{Parent: parent, Query: query})
+	if err != nil {
+		t.Fatalf("SearchResources(%q) returned error: %s", query, err)
+	}
+	names := make([]string, len(resp.GetResults()))
+	for i, r := range resp.GetResults() {
+		names[i] = r.GetName()
+	}
+	return names
+}
+
+func TestSearchResources(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	seedSearch(ctx, t, server)
+
+	tests := []struct {
+		desc  string
+		query string
+		want  []string
+	}{
+		{
+			desc:  "display names rank above descriptions and contents",
+			query: "petstore",
+			want:  []string{petstoreName, petstoreSpec},
+		},
+		{
+			desc:  "descriptions",
+			query: "books",
+			want:  []string{bookstoreName},
+		},
+		{
+			desc:  "labels",
+			query: "animals",
+			want:  []string{petstoreName},
+		},
+		{
+			desc:  "annotations",
+			query: "traffic",
+			want:  []string{petstoreReport},
+		},
+		{
+			desc:  "resource IDs",
+			query: "report",
+			want:  []string{petstoreReport},
+		},
+		{
+			desc:  "operation IDs",
+			query: "listPets",
+			want:  []string{petstoreSpec},
+		},
+		{
+			desc:  "words of operation IDs and paths",
+			query: "list pets",
+			want:  []string{petstoreSpec},
+		},
+		{
+			desc:  "all words must match",
+			query: "sample books",
+			want:  []string{bookstoreName},
+		},
+		{
+			desc:  "no matches",
+			query: "unicorns",
+			want:  []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := searchNames(ctx, t, server, searchParent, test.query)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("SearchResources(%q) returned unexpected results (-want +got):\n%s", test.query, diff)
+			}
+		})
+	}
+}
+
+func TestSearchResourcesResults(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	seedSearch(ctx, t, server)
+
+	resp, err := server.SearchResources(ctx, &rpc.SearchResourcesRequest{Parent: searchParent, Query: "sample"})
+	if err != nil {
+		t.Fatalf("SearchResources() returned error: %s", err)
+	}
+	if len(resp.GetResults()) != 2 {
+		t.Fatalf("SearchResources() returned %d results, want 2", len(resp.GetResults()))
+	}
+	for _, r := range resp.GetResults() {
+		if r.GetKind() != "api" || r.GetTitle() == "" || r.GetDescription() == "" || r.GetScore() <= 0 {
+			t.Errorf("SearchResources() returned incomplete result %+v", r)
+		}
+	}
+	if resp.Results[0].GetScore() < resp.Results[1].GetScore() {
+		t.Errorf("SearchResources() returned results in increasing order of score")
+	}
+
+	// Pages of results continue from each other.
+	var names []string
+	req := &rpc.SearchResourcesRequest{Parent: searchParent, Query: "sample", PageSize: 1}
+	for {
+		resp, err := server.SearchResources(ctx, req)
+		if err != nil {
+			t.Fatalf("SearchResources(%+v) returned error: %s", req, err)
+		}
+		if len(resp.GetResults()) != 1 {
+			t.Fatalf("SearchResources(%+v) returned %d results, want 1", req, len(resp.GetResults()))
+		}
+		names = append(names, resp.Results[0].GetName())
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+	if diff := cmp.Diff([]string{resp.Results[0].GetName(), resp.Results[1].GetName()}, names); diff != "" {
+		t.Errorf("SearchResources() pages returned unexpected results (-want +got):\n%s", diff)
+	}
+
+	// Searches of all projects include the project.
+	if got := searchNames(ctx, t, server, "projects/-/locations/global", "books"); !cmp.Equal(got, []string{bookstoreName}) {
+		t.Errorf("SearchResources() of all projects returned %v, want %v", got, []string{bookstoreName})
+	}
+}
+
+func TestSearchResourcesErrors(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	seedSearch(ctx, t, server)
+
+	first, err := server.SearchResources(ctx, &rpc.SearchResourcesRequest{Parent: searchParent, Query: "sample", PageSize: 1})
+	if err != nil {
+		t.Fatalf("SearchResources() returned error: %s", err)
+	}
+
+	tests := []struct {
+		desc string
+		req  *rpc.SearchResourcesRequest
+		want codes.Code
+	}{
+		{
+			desc: "invalid parent",
+			req:  &rpc.SearchResourcesRequest{Parent: "invalid", Query: "pets"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "missing project",
+			req:  &rpc.SearchResourcesRequest{Parent: "projects/missing/locations/global", Query: "pets"},
+			want: codes.NotFound,
+		},
+		{
+			desc: "empty query",
+			req:  &rpc.SearchResourcesRequest{Parent: searchParent, Query: " ?! "},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "negative page size",
+			req:  &rpc.SearchResourcesRequest{Parent: searchParent, Query: "pets", PageSize: -1},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid page token",
+			req:  &rpc.SearchResourcesRequest{Parent: searchParent, Query: "pets", PageToken: "invalid"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "page token of another query",
+			req:  &rpc.SearchResourcesRequest{Parent: searchParent, Query: "pets", PageToken: first.GetNextPageToken()},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.SearchResources(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("SearchResources(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestSearchIndexFollowsChanges(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	seedSearch(ctx, t, server)
+
+	check := func(query string, want ...string) {
+		t.Helper()
+		if want == nil {
+			want = []string{}
+		}
+		if diff := cmp.Diff(want, searchNames(ctx, t, server, searchParent, query)); diff != "" {
+			t.Errorf("SearchResources(%q) returned unexpected results (-want +got):\n%s", query, diff)
+		}
+	}
+
+	// New spec revisions replace the text of earlier revisions.
+	revision, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec: &rpc.ApiSpec{
+			Name:     petstoreSpec,
+			Contents: petstoreContents("findPets"),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+	check("findPets", petstoreSpec)
+	check("listPets")
+
+	// Deleting the latest revision restores the text of the previous one.
+	if _, err := server.DeleteApiSpecRevision(ctx, &rpc.DeleteApiSpecRevisionRequest{Name: petstoreSpec + "@" + revision.GetRevisionId()}); err != nil {
+		t.Fatalf("DeleteApiSpecRevision() returned error: %s", err)
+	}
+	check("listPets", petstoreSpec)
+	check("findPets")
+
+	// Metadata updates keep the text of the contents.
+	if _, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec: &rpc.ApiSpec{
+			Name:        petstoreSpec,
+			Description: "The pet catalog",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	}); err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+	check("catalog", petstoreSpec)
+	check("listPets", petstoreSpec)
+
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api: &rpc.Api{
+			Name:   bookstoreName,
+			Labels: map[string]string{"team": "animals"},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	check("animals", bookstoreName, petstoreName)
+
+	// Deleted resources and their children are removed from the index.
+	if err := deleteApi(ctx, server, petstoreName); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	check("pets")
+	check("report")
+	check("animals", bookstoreName)
+}
+
+func deleteApi(ctx context.Context, server TestServer, name string) error {
+	_, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: name, Force: true})
+	return err
+}
+
+func TestMigrateDatabaseIndexesResources(t *testing.T) {
+	ctx := context.Background()
+	dbConfig := fmt.Sprintf("%s/registry.db", t.TempDir())
+	seedSearch(ctx, t, serverWithBlobStore(t, dbConfig, BlobStoreConfig{}, false))
+
+	// Remove the documents to simulate resources saved before they were indexed.
+	db, err := sql.Open("sqlite3", dbConfig)
+	if err != nil {
+		t.Fatalf("Setup: Failed to open database: %s", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("DELETE FROM search_documents"); err != nil {
+		t.Fatalf("Setup: Failed to delete search documents: %s", err)
+	}
+
+	server := serverWithBlobStore(t, dbConfig, BlobStoreConfig{}, true)
+	if got := searchNames(ctx, t, server, searchParent, "petstore"); len(got) != 0 {
+		t.Fatalf("SearchResources() returned %v before migration", got)
+	}
+	if _, err := server.MigrateDatabase(ctx, &rpc.MigrateDatabaseRequest{}); err != nil {
+		t.Fatalf("MigrateDatabase() returned error: %s", err)
+	}
+	if diff := cmp.Diff([]string{petstoreName, petstoreSpec}, searchNames(ctx, t, server, searchParent, "petstore")); diff != "" {
+		t.Errorf("SearchResources() returned unexpected results after migration (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{petstoreSpec}, searchNames(ctx, t, server, searchParent, "listPets")); diff != "" {
+		t.Errorf("SearchResources() returned unexpected results after migration (-want +got):\n%s", diff)
+	}
+}
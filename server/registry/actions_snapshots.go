@@ -0,0 +1,172 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateSnapshot handles the corresponding API request.
+func (s *RegistryServer) CreateSnapshot(ctx context.Context, req *rpc.CreateSnapshotRequest) (*rpc.Snapshot, error) {
+	// Parent name must be valid.
+	parent, err := names.ParseProject(req.GetParent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Snapshot name must be valid.
+	name := names.Snapshot{ProjectID: parent.ProjectID, SnapshotID: req.GetSnapshotId()}
+	if err := name.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
+	var response *rpc.Snapshot
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		snapshot := models.NewSnapshot(name, req.GetSnapshot())
+		if err := db.LockProjects(ctx).CreateSnapshot(ctx, snapshot); err != nil {
+			return err
+		}
+		response = snapshot.Message()
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListSnapshots handles the corresponding API request.
+func (s *RegistryServer) ListSnapshots(ctx context.Context, req *rpc.ListSnapshotsRequest) (*rpc.ListSnapshotsResponse, error) {
+	// Parent name must be valid.
+	parent, err := names.ParseProject(req.GetParent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	listing, err := db.ListSnapshots(ctx, parent, storage.PageOptions{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rpc.ListSnapshotsResponse{
+		Snapshots:     make([]*rpc.Snapshot, len(listing.Snapshots)),
+		NextPageToken: listing.Token,
+	}
+
+	for i, snapshot := range listing.Snapshots {
+		response.Snapshots[i] = snapshot.Message()
+	}
+
+	return response, nil
+}
+
+// DeleteSnapshot handles the corresponding API request.
+func (s *RegistryServer) DeleteSnapshot(ctx context.Context, req *rpc.DeleteSnapshotRequest) (*emptypb.Empty, error) {
+	// Snapshot name must be valid.
+	name, err := names.ParseSnapshot(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.DeleteSnapshot(ctx, name); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, req.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RestoreSnapshot handles the corresponding API request.
+func (s *RegistryServer) RestoreSnapshot(ctx context.Context, req *rpc.RestoreSnapshotRequest) (*rpc.RestoreSnapshotResponse, error) {
+	// Snapshot name must be valid.
+	name, err := names.ParseSnapshot(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the admin role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Admin); err != nil {
+		return nil, err
+	}
+	var response *rpc.RestoreSnapshotResponse
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		changes, err := db.LockProjects(ctx).RestoreSnapshot(ctx, name)
+		if err != nil {
+			return err
+		}
+		for _, c := range []struct {
+			change    rpc.Notification_Change
+			resources []string
+		}{
+			{rpc.Notification_DELETED, changes.Deleted},
+			{rpc.Notification_CREATED, changes.Created},
+			{rpc.Notification_UPDATED, changes.Updated},
+		} {
+			for _, resource := range c.resources {
+				if err := s.notify(ctx, db, c.change, resource); err != nil {
+					return err
+				}
+			}
+		}
+		project, err := db.GetProject(ctx, name.Project())
+		if err != nil {
+			return err
+		}
+		response = &rpc.RestoreSnapshotResponse{
+			Project: project.Message(),
+			Created: changes.Created,
+			Updated: changes.Updated,
+			Deleted: changes.Deleted,
+		}
+		return s.audit(ctx, db, req, req.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
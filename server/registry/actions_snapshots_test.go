@@ -0,0 +1,372 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	snapProject  = "projects/snap"
+	snapApi      = snapProject + "/locations/global/apis/petstore"
+	snapSpec     = snapApi + "/versions/v1/specs/openapi"
+	snapReport   = snapSpec + "/artifacts/report"
+	snapOtherApi = snapProject + "/locations/global/apis/other"
+	snapBefore   = snapProject + "/snapshots/before"
+)
+
+func seedSnapshotProject(ctx context.Context, t *testing.T, server TestServer) {
+	t.Helper()
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{
+			Name:     snapSpec,
+			MimeType: "application/x.openapi;version=3.0.0",
+			Contents: petstoreContents("listPets"),
+		},
+		&rpc.Artifact{
+			Name:     snapReport,
+			MimeType: "text/plain",
+			Contents: []byte("report"),
+		},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	sink := &recordingNotifier{}
+	server := serverWithNotifier(t, sink)
+	seedSnapshotProject(ctx, t, server)
+
+	snapshot, err := server.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{
+		Parent:     snapProject,
+		SnapshotId: "before",
+		Snapshot:   &rpc.Snapshot{Description: "before changes"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSnapshot() returned error: %s", err)
+	}
+	// The project, API, version, spec revision and artifact.
+	if snapshot.GetName() != snapBefore || snapshot.GetResourceCount() != 5 || snapshot.GetDescription() != "before changes" {
+		t.Errorf("CreateSnapshot() returned %v", snapshot)
+	}
+
+	// Artifacts of specs are named by the revisions that they belong to.
+	artifact, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: snapReport})
+	if err != nil {
+		t.Fatalf("GetArtifact() returned error: %s", err)
+	}
+	reportRevision := artifact.GetName()
+
+	// Change the project after the snapshot.
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api:        &rpc.Api{Name: snapApi, DisplayName: "Changed"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	if _, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    &rpc.ApiSpec{Name: snapSpec, Contents: petstoreContents("findPets")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	}); err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+	if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: snapReport}); err != nil {
+		t.Fatalf("DeleteArtifact() returned error: %s", err)
+	}
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: snapProject + "/locations/global",
+		ApiId:  "other",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("CreateApi() returned error: %s", err)
+	}
+	// Resources in the trash are removed by the restore.
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: snapOtherApi}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: snapProject + "/locations/global",
+		ApiId:  "other",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("CreateApi() returned error: %s", err)
+	}
+	response, err := server.RestoreSnapshot(ctx, &rpc.RestoreSnapshotRequest{Name: snapBefore})
+	if err != nil {
+		t.Fatalf("RestoreSnapshot() returned error: %s", err)
+	}
+	want := &rpc.RestoreSnapshotResponse{
+		Created: []string{reportRevision},
+		Updated: []string{snapApi, snapSpec},
+		Deleted: []string{snapOtherApi},
+	}
+	if diff := cmp.Diff(want, response, cmpopts.IgnoreFields(rpc.RestoreSnapshotResponse{}, "Project"), cmpopts.IgnoreUnexported(rpc.RestoreSnapshotResponse{})); diff != "" {
+		t.Errorf("RestoreSnapshot() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if response.GetProject().GetName() != snapProject {
+		t.Errorf("RestoreSnapshot() returned project %q, want %q", response.GetProject().GetName(), snapProject)
+	}
+
+	api, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: snapApi})
+	if err != nil {
+		t.Fatalf("GetApi() returned error: %s", err)
+	} else if api.GetDisplayName() != "" {
+		t.Errorf("GetApi() returned display name %q, want the display name before the snapshot", api.GetDisplayName())
+	}
+	spec, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: snapSpec})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents() returned error: %s", err)
+	} else if string(spec.GetData()) != string(petstoreContents("listPets")) {
+		t.Errorf("GetApiSpecContents() returned %q, want the contents before the snapshot", spec.GetData())
+	}
+	revisions, err := server.ListApiSpecRevisions(ctx, &rpc.ListApiSpecRevisionsRequest{Name: snapSpec})
+	if err != nil {
+		t.Fatalf("ListApiSpecRevisions() returned error: %s", err)
+	} else if len(revisions.GetApiSpecs()) != 1 {
+		t.Errorf("ListApiSpecRevisions() returned %d revisions, want 1", len(revisions.GetApiSpecs()))
+	}
+	report, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: snapReport})
+	if err != nil {
+		t.Fatalf("GetArtifactContents() returned error: %s", err)
+	} else if string(report.GetData()) != "report" {
+		t.Errorf("GetArtifactContents() returned %q, want %q", report.GetData(), "report")
+	}
+	if _, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: snapOtherApi}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApi(%q) returned status code %q, want %q", snapOtherApi, status.Code(err), codes.NotFound)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: snapOtherApi}); status.Code(err) != codes.NotFound {
+		t.Errorf("UndeleteApi(%q) returned status code %q, want %q", snapOtherApi, status.Code(err), codes.NotFound)
+	}
+	parent := snapProject + "/locations/global"
+	if diff := cmp.Diff([]string{snapSpec}, searchNames(ctx, t, server, parent, "listPets")); diff != "" {
+		t.Errorf("SearchResources() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if got := searchNames(ctx, t, server, parent, "findPets"); len(got) != 0 {
+		t.Errorf("SearchResources() returned %v, want no results for contents removed by the restore", got)
+	}
+
+	// Restoring again changes nothing.
+	again, err := server.RestoreSnapshot(ctx, &rpc.RestoreSnapshotRequest{Name: snapBefore})
+	if err != nil {
+		t.Fatalf("RestoreSnapshot() returned error: %s", err)
+	} else if n := len(again.GetCreated()) + len(again.GetUpdated()) + len(again.GetDeleted()); n != 0 {
+		t.Errorf("RestoreSnapshot() of an unchanged project changed %d resources", n)
+	}
+
+	// Notifications are delivered asynchronously; closing the server waits for delivery.
+	server.Close()
+	got := sink.resources()
+	got = got[len(got)-4:]
+	if diff := cmp.Diff([]string{snapOtherApi, reportRevision, snapApi, snapSpec}, got); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestSnapshotKeepsContents(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	server := serverWithBlobStore(t, fmt.Sprintf("%s/registry.db", t.TempDir()), BlobStoreConfig{Type: "file", Path: root}, false)
+	db, err := server.getStorageClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: Failed to get storage client: %s", err)
+	}
+	seedSnapshotProject(ctx, t, server)
+	if _, err := server.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{Parent: snapProject, SnapshotId: "before"}); err != nil {
+		t.Fatalf("CreateSnapshot() returned error: %s", err)
+	}
+	sweep := func(want int) {
+		t.Helper()
+		if n, err := db.DeleteUnreferencedBlobContents(ctx); err != nil {
+			t.Fatalf("DeleteUnreferencedBlobContents() returned error: %s", err)
+		} else if n != want {
+			t.Errorf("DeleteUnreferencedBlobContents() deleted %d contents, want %d", n, want)
+		}
+	}
+	exists := func() bool {
+		_, err := os.Stat(blobPath(root, []byte("report")))
+		return err == nil
+	}
+
+	// Snapshots are kept when their project is deleted, and so are their contents.
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: snapProject, Force: true}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+	purgeAll(ctx, t, server)
+	sweep(0)
+	if !exists() {
+		t.Fatalf("Blob was deleted while a snapshot referred to it")
+	}
+	list, err := server.ListSnapshots(ctx, &rpc.ListSnapshotsRequest{Parent: snapProject})
+	if err != nil {
+		t.Fatalf("ListSnapshots() returned error: %s", err)
+	} else if len(list.GetSnapshots()) != 1 {
+		t.Fatalf("ListSnapshots() returned %d snapshots, want 1", len(list.GetSnapshots()))
+	}
+
+	response, err := server.RestoreSnapshot(ctx, &rpc.RestoreSnapshotRequest{Name: snapBefore})
+	if err != nil {
+		t.Fatalf("RestoreSnapshot() returned error: %s", err)
+	} else if len(response.GetCreated()) != 5 {
+		t.Errorf("RestoreSnapshot() created %v, want 5 resources", response.GetCreated())
+	}
+	report, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: snapReport})
+	if err != nil {
+		t.Fatalf("GetArtifactContents() returned error: %s", err)
+	} else if string(report.GetData()) != "report" {
+		t.Errorf("GetArtifactContents() returned %q, want %q", report.GetData(), "report")
+	}
+
+	// Contents are deleted when neither the project nor a snapshot refers to them.
+	if _, err := server.DeleteSnapshot(ctx, &rpc.DeleteSnapshotRequest{Name: snapBefore}); err != nil {
+		t.Fatalf("DeleteSnapshot() returned error: %s", err)
+	}
+	sweep(0)
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: snapProject, Force: true}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+	purgeAll(ctx, t, server)
+	sweep(2)
+	if exists() {
+		t.Errorf("Blob was kept after its last reference was deleted")
+	}
+}
+
+func TestListSnapshots(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	seedSnapshotProject(ctx, t, server)
+	want := []string{snapProject + "/snapshots/a", snapProject + "/snapshots/b", snapProject + "/snapshots/c"}
+	for _, id := range []string{"a", "b", "c"} {
+		if _, err := server.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{Parent: snapProject, SnapshotId: id}); err != nil {
+			t.Fatalf("CreateSnapshot(%q) returned error: %s", id, err)
+		}
+	}
+
+	var got []string
+	token := ""
+	for {
+		resp, err := server.ListSnapshots(ctx, &rpc.ListSnapshotsRequest{Parent: snapProject, PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("ListSnapshots() returned error: %s", err)
+		}
+		for _, s := range resp.GetSnapshots() {
+			got = append(got, s.GetName())
+		}
+		if token = resp.GetNextPageToken(); token == "" {
+			break
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListSnapshots() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestSnapshotErrors(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	seedSnapshotProject(ctx, t, server)
+	if _, err := server.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{Parent: snapProject, SnapshotId: "before"}); err != nil {
+		t.Fatalf("Setup: CreateSnapshot() returned error: %s", err)
+	}
+
+	tests := []struct {
+		desc string
+		call func() error
+		want codes.Code
+	}{
+		{
+			desc: "create with invalid id",
+			call: func() error {
+				_, err := server.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{Parent: snapProject, SnapshotId: "Invalid!"})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "create in missing project",
+			call: func() error {
+				_, err := server.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{Parent: "projects/missing", SnapshotId: "s"})
+				return err
+			},
+			want: codes.NotFound,
+		},
+		{
+			desc: "create existing snapshot",
+			call: func() error {
+				_, err := server.CreateSnapshot(ctx, &rpc.CreateSnapshotRequest{Parent: snapProject, SnapshotId: "before"})
+				return err
+			},
+			want: codes.AlreadyExists,
+		},
+		{
+			desc: "list with invalid parent",
+			call: func() error {
+				_, err := server.ListSnapshots(ctx, &rpc.ListSnapshotsRequest{Parent: "invalid"})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "restore with invalid name",
+			call: func() error {
+				_, err := server.RestoreSnapshot(ctx, &rpc.RestoreSnapshotRequest{Name: snapProject})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "restore missing snapshot",
+			call: func() error {
+				_, err := server.RestoreSnapshot(ctx, &rpc.RestoreSnapshotRequest{Name: snapProject + "/snapshots/missing"})
+				return err
+			},
+			want: codes.NotFound,
+		},
+		{
+			desc: "delete missing snapshot",
+			call: func() error {
+				_, err := server.DeleteSnapshot(ctx, &rpc.DeleteSnapshotRequest{Name: snapProject + "/snapshots/missing"})
+				return err
+			},
+			want: codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if err := test.call(); status.Code(err) != test.want {
+				t.Errorf("returned status code %q, want %q: %v", status.Code(err), test.want, err)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/grpc/codes"
@@ -44,6 +45,10 @@ func (s *RegistryServer) ListApiSpecRevisions(ctx context.Context, req *rpc.List
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	listing, err := db.ListSpecRevisions(ctx, parent, storage.PageOptions{
 		Size:   req.GetPageSize(),
@@ -77,12 +82,19 @@ func (s *RegistryServer) DeleteApiSpecRevision(ctx context.Context, req *rpc.Del
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiSpec
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		if err := db.DeleteSpecRevision(ctx, name); err != nil {
 			return err
 		}
-		return nil
+		if err := s.notify(ctx, db, rpc.Notification_DELETED, name.String()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, name.String(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
@@ -92,7 +104,6 @@ func (s *RegistryServer) DeleteApiSpecRevision(ctx context.Context, req *rpc.Del
 		// The get will fail if we are deleting the only revision.
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return response, nil
 }
 
@@ -115,8 +126,11 @@ func (s *RegistryServer) TagApiSpecRevision(ctx context.Context, req *rpc.TagApi
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiSpec
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// The revision to be tagged must exist.
 		revision, err := db.GetSpecRevision(ctx, name)
@@ -137,12 +151,14 @@ func (s *RegistryServer) TagApiSpecRevision(ctx context.Context, req *rpc.TagApi
 		if err != nil {
 			return err
 		}
-		revisionName = name.String()
-		return nil
+		if err := s.notify(ctx, db, rpc.Notification_UPDATED, name.String()); err != nil {
+			return err
+		}
+		// The tagged name of the revision records the tag.
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, revisionName)
 	return response, nil
 }
 
@@ -157,8 +173,11 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiSpec
-	var revisionName string
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		// Get the target spec revision to use as a base for the new rollback revision.
 		name := parent.Revision(req.GetRevisionId())
@@ -166,6 +185,15 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 		if err != nil {
 			return err
 		}
+		// The current revision is the state the rollback changes.
+		current, err := db.GetSpec(ctx, parent)
+		if err != nil {
+			return err
+		}
+		before, err := current.BasicMessage(current.RevisionName())
+		if err != nil {
+			return err
+		}
 		// Save a new rollback revision based on the target revision.
 		rollback := target.NewRevision()
 		if err := db.SaveSpecRevision(ctx, rollback); err != nil {
@@ -184,11 +212,12 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 		if err != nil {
 			return err
 		}
-		revisionName = rollback.RevisionName()
-		return nil
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, rollback.RevisionName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, rollback.RevisionName(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, revisionName)
 	return response, nil
 }
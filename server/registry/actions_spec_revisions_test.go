@@ -296,7 +296,7 @@ func TestRollbackApiSpec(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	if !cmp.Equal(want, rollback, opts) {
@@ -598,7 +598,7 @@ func TestListApiSpecRevisions(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApiSpecRevisionsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "name", "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "name", "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -647,6 +647,7 @@ func TestListApiSpecRevisionsSequence(t *testing.T) {
 		CreateTime:         firstRevision.GetCreateTime(),
 		RevisionCreateTime: firstRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: firstRevision.GetRevisionUpdateTime(),
+		Etag:               firstRevision.GetEtag(),
 		RevisionId:         firstRevision.GetRevisionId(),
 	}
 
@@ -671,6 +672,7 @@ func TestListApiSpecRevisionsSequence(t *testing.T) {
 		CreateTime:         secondRevision.GetCreateTime(),
 		RevisionCreateTime: secondRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: secondRevision.GetRevisionUpdateTime(),
+		Etag:               secondRevision.GetEtag(),
 		RevisionId:         secondRevision.GetRevisionId(),
 	}
 
@@ -801,7 +803,7 @@ func TestUpdateApiSpecRevisions(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	t.Run("modify revision without content changes", func(t *testing.T) {
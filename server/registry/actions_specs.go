@@ -20,6 +20,7 @@ import (
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"google.golang.org/genproto/googleapis/api/httpbody"
@@ -42,15 +43,24 @@ func (s *RegistryServer) CreateApiSpec(ctx context.Context, req *rpc.CreateApiSp
 	if err := name.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	var response *rpc.ApiSpec
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
 		var err error
 		response, err = s.createSpec(ctx, db, name, req.GetApiSpec())
-		return err
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_CREATED, response.GetName())
 	return response, nil
 }
 
@@ -85,23 +95,80 @@ func (s *RegistryServer) DeleteApiSpec(ctx context.Context, req *rpc.DeleteApiSp
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 
 	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
-		return db.LockSpecs(ctx).DeleteSpec(ctx, name, req.GetForce())
+		db.LockSpecs(ctx)
+		if req.GetEtag() != "" {
+			spec, err := db.GetSpec(ctx, name)
+			if err != nil {
+				return err
+			}
+			if err := checkEtag(req.GetName(), req.GetEtag(), spec.Etag()); err != nil {
+				return err
+			}
+		}
+		if err := db.DeleteSpec(ctx, name, req.GetForce(), s.deleteExpireTime()); err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_DELETED, req.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, req.GetName(), nil, nil)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_DELETED, req.GetName())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApiSpec handles the corresponding API request.
+func (s *RegistryServer) UndeleteApiSpec(ctx context.Context, req *rpc.UndeleteApiSpecRequest) (*rpc.ApiSpec, error) {
+	// Spec name must be valid.
+	name, err := names.ParseSpec(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
+	var response *rpc.ApiSpec
+	if err := s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.LockSpecs(ctx).UndeleteSpec(ctx, name); err != nil {
+			return err
+		}
+		spec, err := db.GetSpec(ctx, name)
+		if err != nil {
+			return err
+		}
+		response, err = spec.BasicMessage(name.String())
+		if err != nil {
+			return err
+		}
+		if err := s.notify(ctx, db, rpc.Notification_CREATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), nil, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // GetApiSpec handles the corresponding API request.
 func (s *RegistryServer) GetApiSpec(ctx context.Context, req *rpc.GetApiSpecRequest) (*rpc.ApiSpec, error) {
-	s.begin()
-	defer s.end()
 	if name, err := names.ParseSpec(req.GetName()); err == nil {
+		if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+			return nil, err
+		}
 		return s.getApiSpec(ctx, name)
 	} else if name, err := names.ParseSpecRevision(req.GetName()); err == nil {
+		if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+			return nil, err
+		}
 		return s.getApiSpecRevision(ctx, name)
 	}
 
@@ -157,11 +224,17 @@ func (s *RegistryServer) GetApiSpecContents(ctx context.Context, req *rpc.GetApi
 	var spec *models.Spec
 	var revisionName names.SpecRevision
 	if name, err := names.ParseSpec(specName); err == nil {
+		if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+			return nil, err
+		}
 		if spec, err = db.GetSpec(ctx, name); err != nil {
 			return nil, err
 		}
 		revisionName = name.Revision(spec.RevisionID)
 	} else if name, err := names.ParseSpecRevision(specName); err == nil {
+		if err := s.authorize(ctx, name.ProjectID, auth.Viewer); err != nil {
+			return nil, err
+		}
 		if spec, err = db.GetSpecRevision(ctx, name); err != nil {
 			return nil, err
 		}
@@ -209,12 +282,17 @@ func (s *RegistryServer) ListApiSpecs(ctx context.Context, req *rpc.ListApiSpecs
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the viewer role in the project.
+	if err := s.authorize(ctx, parent.ProjectID, auth.Viewer); err != nil {
+		return nil, err
+	}
 
 	listing, err := db.ListSpecs(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Order:  req.GetOrderBy(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -246,14 +324,25 @@ func (s *RegistryServer) UpdateApiSpec(ctx context.Context, req *rpc.UpdateApiSp
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// Caller must have the editor role in the project.
+	if err := s.authorize(ctx, name.ProjectID, auth.Editor); err != nil {
+		return nil, err
+	}
 	// Update mask must be valid.
 	if err := models.ValidateMask(req.GetApiSpec(), req.GetUpdateMask()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
 	}
 	var response *rpc.ApiSpec
 	if err = s.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		var before *rpc.ApiSpec
 		spec, err := db.GetSpec(ctx, name)
 		if err == nil {
+			if err := checkEtag(name.String(), req.GetApiSpec().GetEtag(), spec.Etag()); err != nil {
+				return err
+			}
+			if before, err = spec.BasicMessage(name.String()); err != nil {
+				return err
+			}
 			// Apply the update to the spec - possibly changing the revision ID.
 			maskExpansion := models.ExpandMask(req.GetApiSpec(), req.GetUpdateMask())
 			if err := spec.Update(req.GetApiSpec(), maskExpansion); err != nil {
@@ -270,20 +359,26 @@ func (s *RegistryServer) UpdateApiSpec(ctx context.Context, req *rpc.UpdateApiSp
 				}
 			}
 			response, err = spec.BasicMessage(name.String())
-			return err
+			if err != nil {
+				return err
+			}
 		} else if status.Code(err) == codes.NotFound && req.GetAllowMissing() {
 			response, err = s.createSpec(ctx, db, name, req.GetApiSpec())
 			if status.Code(err) == codes.AlreadyExists {
-				err = status.Error(codes.Aborted, err.Error())
+				return status.Error(codes.Aborted, err.Error())
+			} else if err != nil {
+				return err
 			}
-			return err
 		} else {
 			return err
 		}
+		if err := s.notify(ctx, db, rpc.Notification_UPDATED, response.GetName()); err != nil {
+			return err
+		}
+		return s.audit(ctx, db, req, response.GetName(), before, response)
 	}); err != nil {
 		return nil, err
 	}
-	s.notify(ctx, rpc.Notification_UPDATED, response.GetName())
 	return response, nil
 }
 
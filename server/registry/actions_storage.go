@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -25,6 +26,10 @@ import (
 
 // GetStorage handles the corresponding API request.
 func (s *RegistryServer) GetStorage(ctx context.Context, req *emptypb.Empty) (*rpc.Storage, error) {
+	// Caller must have the admin role in all projects.
+	if err := s.authorize(ctx, "-", auth.Admin); err != nil {
+		return nil, err
+	}
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
@@ -46,8 +51,14 @@ func (s *RegistryServer) GetStorage(ctx context.Context, req *emptypb.Empty) (*r
 			},
 		)
 	}
+	logical, physical, err := db.BlobContentsSize(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return &rpc.Storage{
-		Description: db.DatabaseName(ctx),
-		Collections: collections,
+		Description:   db.DatabaseName(ctx),
+		Collections:   collections,
+		LogicalBytes:  logical,
+		PhysicalBytes: physical,
 	}, nil
 }
@@ -103,7 +103,7 @@ func TestCreateApiVersion(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -340,7 +340,7 @@ func TestGetApiVersion(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -664,7 +664,7 @@ func TestListApiVersions(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApiVersionsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 				test.extraOpts,
 			}
 
@@ -887,7 +887,7 @@ func TestListApiVersionsSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.ApiVersion) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -1092,7 +1092,7 @@ func TestUpdateApiVersion(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
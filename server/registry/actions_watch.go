@@ -0,0 +1,152 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var watchFields = map[string]filtering.FieldType{
+	"resource":    filtering.String,
+	"change":      filtering.String,
+	"change_time": filtering.Timestamp,
+}
+
+// WatchResources handles the corresponding API request.
+func (s *RegistryServer) WatchResources(req *rpc.WatchResourcesRequest, stream rpc.Registry_WatchResourcesServer) error {
+	if s.watchers == nil {
+		return status.Error(codes.Unavailable, "watching is not available")
+	}
+	if p := req.GetPattern(); p != "projects" && !strings.HasPrefix(p, "projects/") {
+		return status.Errorf(codes.InvalidArgument, "invalid pattern %q: must begin with \"projects/\"", p)
+	}
+	// Caller must have the viewer role in the watched projects.
+	if err := s.authorize(stream.Context(), watchedProject(req.GetPattern()), auth.Viewer); err != nil {
+		return err
+	}
+	filter, err := filtering.NewFilter(req.GetFilter(), watchFields)
+	if err != nil {
+		return err
+	}
+	after, err := parseWatchToken(req.GetResumeToken())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid resume token %q: %s", req.GetResumeToken(), err)
+	}
+
+	// Subscribe before reading earlier changes so that none are missed
+	// in between. Changes that are both read and published are sent once.
+	w := s.watchers.subscribe()
+	defer s.watchers.unsubscribe(w)
+
+	last := after
+	send := func(n *rpc.Notification) error {
+		if n.GetSequence() <= last {
+			return nil
+		}
+		last = n.GetSequence()
+		if !matchesPattern(req.GetPattern(), n.GetResource()) {
+			return nil
+		}
+		if match, err := filter.Matches(map[string]interface{}{
+			"resource":    n.GetResource(),
+			"change":      n.GetChange().String(),
+			"change_time": n.GetChangeTime().AsTime(),
+		}); err != nil {
+			return err
+		} else if !match {
+			return nil
+		}
+		return stream.Send(&rpc.WatchResourcesResponse{
+			Notification: n,
+			ResumeToken:  watchToken(n.GetSequence()),
+		})
+	}
+
+	if after > 0 {
+		if err := s.sendChanges(stream.Context(), after, send); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case n, ok := <-w.events:
+			if !ok {
+				return status.Error(codes.Aborted, "watch fell behind; resume with the last token received")
+			}
+			if err := send(n); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendChanges sends the changes in the outbox that follow the change with
+// sequence number after. It returns an OutOfRange error if some of those
+// changes have been removed from the outbox or if there is no such change.
+func (s *RegistryServer) sendChanges(ctx context.Context, after int64, send func(*rpc.Notification) error) error {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return err
+	}
+	next := after
+	for {
+		changes, err := db.ListChanges(ctx, next, outboxBatchSize)
+		if err != nil {
+			return err
+		}
+		if next == after {
+			if len(changes) > 0 && *changes[0].Sequence > after+1 {
+				return status.Errorf(codes.OutOfRange, "resume token for change %d has expired", after)
+			}
+			if len(changes) == 0 {
+				last, err := db.LastChangeSequence(ctx)
+				if err != nil {
+					return err
+				}
+				if after > last {
+					return status.Errorf(codes.OutOfRange, "resume token for change %d is ahead of the last change %d", after, last)
+				}
+			}
+		}
+		for _, c := range changes {
+			if err := send(changeNotification(c)); err != nil {
+				return err
+			}
+			next = *c.Sequence
+		}
+		if len(changes) < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+// watchedProject returns the ID of the project that a pattern matches
+// resources in, or "-" if it matches resources in all projects.
+func watchedProject(pattern string) string {
+	p := strings.Split(pattern, "/")
+	if len(p) < 2 || p[1] == "" {
+		return "-"
+	}
+	return p[1]
+}
@@ -0,0 +1,374 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// watchStream collects the responses sent on a WatchResources stream.
+type watchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	cancel    context.CancelFunc
+	responses chan *rpc.WatchResourcesResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(r *rpc.WatchResourcesResponse) error {
+	s.responses <- r
+	return nil
+}
+
+// startWatch runs WatchResources until the test ends and returns its stream.
+// It waits for the watch to be registered so that no changes are missed.
+func startWatch(t *testing.T, server *RegistryServer, req *rpc.WatchResourcesRequest) (*watchStream, chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := &watchStream{ctx: ctx, cancel: cancel, responses: make(chan *rpc.WatchResourcesResponse, 100)}
+
+	server.watchers.mu.Lock()
+	count := len(server.watchers.watchers)
+	server.watchers.mu.Unlock()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.WatchResources(req, stream)
+	}()
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		server.watchers.mu.Lock()
+		n := len(server.watchers.watchers)
+		server.watchers.mu.Unlock()
+		if n > count {
+			return stream, errs
+		}
+		select {
+		case err := <-errs:
+			t.Fatalf("WatchResources(%+v) returned error: %s", req, err)
+		default:
+		}
+	}
+	t.Fatalf("WatchResources(%+v) did not start", req)
+	return nil, nil
+}
+
+func receive(t *testing.T, stream *watchStream, count int) []*rpc.WatchResourcesResponse {
+	t.Helper()
+	var got []*rpc.WatchResourcesResponse
+	for len(got) < count {
+		select {
+		case r := <-stream.responses:
+			got = append(got, r)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for notifications: got %d, want %d", len(got), count)
+		}
+	}
+	select {
+	case r := <-stream.responses:
+		t.Fatalf("Received unexpected notification %+v", r)
+	case <-time.After(50 * time.Millisecond):
+	}
+	return got
+}
+
+type change struct {
+	Change   rpc.Notification_Change
+	Resource string
+}
+
+func changes(responses []*rpc.WatchResourcesResponse) []change {
+	var c []change
+	for _, r := range responses {
+		c = append(c, change{Change: r.GetNotification().GetChange(), Resource: r.GetNotification().GetResource()})
+		if r.GetResumeToken() == "" {
+			c = append(c, change{Resource: "missing resume token"})
+		}
+	}
+	return c
+}
+
+func TestWatchResources(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+
+	req := &rpc.WatchResourcesRequest{
+		Pattern: "projects/my-project/locations/global/apis/-",
+		Filter:  "change != 'DELETED'",
+	}
+	stream, errs := startWatch(t, server, req)
+
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "my-project"}); err != nil {
+		t.Fatalf("Setup: CreateProject() returned error: %s", err)
+	}
+	for _, id := range []string{"a", "b"} {
+		if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+			Parent: "projects/my-project/locations/global",
+			ApiId:  id,
+		}); err != nil {
+			t.Fatalf("Setup: CreateApi() returned error: %s", err)
+		}
+	}
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api:        &rpc.Api{Name: "projects/my-project/locations/global/apis/a", DisplayName: "A"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}); err != nil {
+		t.Fatalf("Setup: UpdateApi() returned error: %s", err)
+	}
+	if _, err := server.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+		Parent:       "projects/my-project/locations/global/apis/a",
+		ApiVersionId: "v1",
+	}); err != nil {
+		t.Fatalf("Setup: CreateApiVersion() returned error: %s", err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{
+		Name:  "projects/my-project/locations/global/apis/b",
+		Force: true,
+	}); err != nil {
+		t.Fatalf("Setup: DeleteApi() returned error: %s", err)
+	}
+
+	want := []change{
+		{Change: rpc.Notification_CREATED, Resource: "projects/my-project/locations/global/apis/a"},
+		{Change: rpc.Notification_CREATED, Resource: "projects/my-project/locations/global/apis/b"},
+		{Change: rpc.Notification_UPDATED, Resource: "projects/my-project/locations/global/apis/a"},
+		{Change: rpc.Notification_CREATED, Resource: "projects/my-project/locations/global/apis/a/versions/v1"},
+	}
+	got := changes(receive(t, stream, len(want)))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("WatchResources(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+	}
+
+	stream.cancel()
+	if err := <-errs; status.Code(err) != codes.Canceled {
+		t.Errorf("WatchResources(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.Canceled, err)
+	}
+}
+
+func TestWatchResourcesResume(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+
+	req := &rpc.WatchResourcesRequest{Pattern: "projects/-"}
+	stream, _ := startWatch(t, server, req)
+	for _, id := range []string{"p1", "p2", "p3"} {
+		if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: id}); err != nil {
+			t.Fatalf("Setup: CreateProject() returned error: %s", err)
+		}
+	}
+	first := receive(t, stream, 3)
+
+	// Resuming after the first change returns the changes that followed it.
+	req = &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: first[0].GetResumeToken()}
+	stream, _ = startWatch(t, server, req)
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "p4"}); err != nil {
+		t.Fatalf("Setup: CreateProject() returned error: %s", err)
+	}
+
+	want := []change{
+		{Change: rpc.Notification_CREATED, Resource: "projects/p2"},
+		{Change: rpc.Notification_CREATED, Resource: "projects/p3"},
+		{Change: rpc.Notification_CREATED, Resource: "projects/p4"},
+	}
+	got := changes(receive(t, stream, len(want)))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("WatchResources(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+	}
+}
+
+func TestWatchResourcesAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	config := Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+	}
+	server, err := New(config)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	req := &rpc.WatchResourcesRequest{Pattern: "projects/-"}
+	stream, _ := startWatch(t, server, req)
+	for _, id := range []string{"p1", "p2", "p3"} {
+		if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: id}); err != nil {
+			t.Fatalf("Setup: CreateProject() returned error: %s", err)
+		}
+	}
+	first := receive(t, stream, 3)
+	stream.cancel()
+	server.Close()
+
+	// Tokens identify changes in the database, so they can be used with
+	// the restarted server.
+	server, err = New(config)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	t.Cleanup(server.Close)
+	req = &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: first[0].GetResumeToken()}
+	stream, _ = startWatch(t, server, req)
+
+	want := []change{
+		{Change: rpc.Notification_CREATED, Resource: "projects/p2"},
+		{Change: rpc.Notification_CREATED, Resource: "projects/p3"},
+	}
+	got := changes(receive(t, stream, len(want)))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("WatchResources(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+	}
+}
+
+func TestWatchResourcesResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	stream, errs := startWatch(t, server, &rpc.WatchResourcesRequest{Pattern: "projects/-"})
+	for _, id := range []string{"p1", "p2", "p3"} {
+		if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: id}); err != nil {
+			t.Fatalf("Setup: CreateProject() returned error: %s", err)
+		}
+	}
+	received := receive(t, stream, 3)
+	stream.cancel()
+	<-errs
+
+	// Remove all but the last change so that earlier tokens expire.
+	last := received[2].GetNotification().GetSequence()
+	if err := server.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.MarkChangesDelivered(ctx, last); err != nil {
+			return err
+		}
+		_, err := db.DeleteDeliveredChanges(ctx, time.Now().Add(time.Hour))
+		return err
+	}); err != nil {
+		t.Fatalf("Setup: failed to remove changes: %s", err)
+	}
+
+	tests := []struct {
+		desc string
+		req  *rpc.WatchResourcesRequest
+		want codes.Code
+	}{
+		{
+			desc: "missing pattern",
+			req:  &rpc.WatchResourcesRequest{},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid pattern",
+			req:  &rpc.WatchResourcesRequest{Pattern: "apis/-"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid filter",
+			req:  &rpc.WatchResourcesRequest{Pattern: "projects/-", Filter: "this filter is not valid"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid resume token",
+			req:  &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: "invalid"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "expired resume token",
+			req:  &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: received[0].GetResumeToken()},
+			want: codes.OutOfRange,
+		},
+		{
+			desc: "resume token from the future",
+			req:  &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: watchToken(last + 10)},
+			want: codes.OutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			stream := &watchStream{ctx: context.Background()}
+			if err := server.WatchResources(test.req, stream); status.Code(err) != test.want {
+				t.Errorf("WatchResources(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+
+	// The change before the last one was removed, but nothing that follows it.
+	req := &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: received[1].GetResumeToken()}
+	stream, _ = startWatch(t, server, req)
+	if got := changes(receive(t, stream, 1)); got[0].Resource != "projects/p3" {
+		t.Errorf("WatchResources(%+v) returned %+v, want projects/p3", req, got)
+	}
+}
+
+func TestWatchFallingBehind(t *testing.T) {
+	h := newWatchHub()
+	w := h.subscribe()
+	for i := 0; i <= watchBufferSize; i++ {
+		h.publish(&rpc.Notification{Resource: "projects/p"})
+	}
+
+	count := 0
+	for range w.events {
+		count++
+	}
+	if count != watchBufferSize {
+		t.Errorf("Watcher received %d changes before falling behind, want %d", count, watchBufferSize)
+	}
+	h.unsubscribe(w)
+}
+
+func TestMatchesPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"projects/p", "projects/p", true},
+		{"projects/p", "projects/p/locations/global/apis/a", true},
+		{"projects/p", "projects/q", false},
+		{"projects/p/locations/global/apis/a", "projects/p", false},
+		{"projects/-/locations/global/apis/-", "projects/p/locations/global/apis/a", true},
+		{"projects/-/locations/global/apis/-", "projects/p/locations/global/artifacts/x", false},
+		{"projects/p/locations/global/apis/a/versions/v/specs/s", "projects/p/locations/global/apis/a/versions/v/specs/s@123", true},
+		{"projects/p/locations/global/apis/a/versions/v/specs/s@123", "projects/p/locations/global/apis/a/versions/v/specs/s@456", false},
+		{"projects/p/locations/global/apis/a/versions/v/specs/s@123", "projects/p/locations/global/apis/a/versions/v/specs/s@123/artifacts/x", true},
+	}
+
+	for _, test := range tests {
+		if got := matchesPattern(test.pattern, test.name); got != test.want {
+			t.Errorf("matchesPattern(%q, %q) returned %t, want %t", test.pattern, test.name, got, test.want)
+		}
+	}
+}
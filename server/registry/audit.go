@@ -0,0 +1,149 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// actorMetadataKeys are the request metadata keys that identify callers,
+// in order of preference. They are set by authenticating proxies.
+var actorMetadataKeys = []string{"x-goog-authenticated-user-email"}
+
+// requestIDMetadataKey is the request metadata key that identifies requests.
+const requestIDMetadataKey = "x-request-id"
+
+// audit records a change in the audit log. Like notify, it must be called in
+// the transaction that makes the change. The method is named after the type
+// of req, and the changed fields are found by comparing before and after, either
+// of which may be nil when a resource is created or deleted.
+func (s *RegistryServer) audit(ctx context.Context, db *storage.Client, req proto.Message, resource string, before, after proto.Message) error {
+	method := strings.TrimSuffix(string(req.ProtoReflect().Descriptor().Name()), "Request")
+	actor, requestID := callerIdentity(ctx)
+	return db.RecordAuditEvent(ctx, models.NewAuditEvent(method, resource, actor, requestID, changedFields(before, after)))
+}
+
+// callerIdentity returns the actor and request ID of the call that ctx belongs to.
+// Authenticated principals are preferred to actors named in metadata.
+// A request ID is generated for calls that don't provide one.
+func callerIdentity(ctx context.Context) (actor, requestID string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if principal, ok := auth.FromContext(ctx); ok {
+		actor = principal
+	} else {
+		for _, key := range actorMetadataKeys {
+			if values := md.Get(key); len(values) > 0 {
+				actor = values[0]
+				break
+			}
+		}
+	}
+	if values := md.Get(requestIDMetadataKey); len(values) > 0 && values[0] != "" {
+		requestID = values[0]
+	} else {
+		requestID = uuid.New().String()
+	}
+	return actor, requestID
+}
+
+// changedFields returns the paths of the fields that differ between two messages
+// of the same type. Names are recorded separately and timestamps and etags are
+// maintained by the server, so they are skipped.
+// Map entries are compared individually and returned as "field.key" paths.
+func changedFields(before, after proto.Message) []string {
+	var m protoreflect.Message
+	if after != nil {
+		m = after.ProtoReflect()
+	} else if before != nil {
+		m = before.ProtoReflect()
+	} else {
+		return nil
+	}
+	b, a := m.Type().Zero(), m.Type().Zero()
+	if before != nil {
+		b = before.ProtoReflect()
+	}
+	if after != nil {
+		a = after.ProtoReflect()
+	}
+
+	var paths []string
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if f.Name() == "name" || f.Name() == "etag" || f.Message() != nil && f.Message().FullName() == "google.protobuf.Timestamp" {
+			continue
+		}
+		if f.IsMap() {
+			paths = append(paths, changedEntries(f, b.Get(f).Map(), a.Get(f).Map())...)
+		} else if !fieldEqual(f, b, a) {
+			paths = append(paths, string(f.Name()))
+		}
+	}
+	return paths
+}
+
+// changedEntries returns "field.key" paths for the entries that differ between two maps.
+func changedEntries(f protoreflect.FieldDescriptor, before, after protoreflect.Map) []string {
+	var paths []string
+	before.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		if !after.Has(k) || !valueEqual(f.MapValue(), v, after.Get(k)) {
+			paths = append(paths, string(f.Name())+"."+k.String())
+		}
+		return true
+	})
+	after.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		if !before.Has(k) {
+			paths = append(paths, string(f.Name())+"."+k.String())
+		}
+		return true
+	})
+	sort.Strings(paths)
+	return paths
+}
+
+// fieldEqual returns true if a field has the same value in two messages.
+func fieldEqual(f protoreflect.FieldDescriptor, x, y protoreflect.Message) bool {
+	a, b := x.Type().New(), y.Type().New()
+	if x.Has(f) {
+		a.Set(f, x.Get(f))
+	}
+	if y.Has(f) {
+		b.Set(f, y.Get(f))
+	}
+	return proto.Equal(a.Interface(), b.Interface())
+}
+
+// valueEqual returns true if two singular values of a field are equal.
+func valueEqual(f protoreflect.FieldDescriptor, x, y protoreflect.Value) bool {
+	switch {
+	case f.Message() != nil:
+		return proto.Equal(x.Message().Interface(), y.Message().Interface())
+	case f.Kind() == protoreflect.BytesKind:
+		return string(x.Bytes()) == string(y.Bytes())
+	default:
+		return x.Interface() == y.Interface()
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func listAuditEvents(ctx context.Context, t *testing.T, server *RegistryServer, filter string) []*rpc.AuditEvent {
+	t.Helper()
+	resp, err := server.ListAuditEvents(ctx, &rpc.ListAuditEventsRequest{Filter: filter})
+	if err != nil {
+		t.Fatalf("ListAuditEvents(%q) returned error: %s", filter, err)
+	}
+	return resp.GetAuditEvents()
+}
+
+func TestAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to get server with SQLite: %s", err)
+	}
+
+	const (
+		project = "projects/audit"
+		api     = project + "/locations/global/apis/petstore"
+	)
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "audit"}); err != nil {
+		t.Fatalf("CreateProject() returned error: %s", err)
+	}
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: project + "/locations/global",
+		ApiId:  "petstore",
+		Api:    &rpc.Api{DisplayName: "Petstore", Labels: map[string]string{"team": "pets"}},
+	}); err != nil {
+		t.Fatalf("CreateApi() returned error: %s", err)
+	}
+	callCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"x-goog-authenticated-user-email", "alice@example.com",
+		"x-request-id", "request-1",
+	))
+	if _, err := server.UpdateApi(callCtx, &rpc.UpdateApiRequest{
+		Api: &rpc.Api{
+			Name:        api,
+			DisplayName: "Pet Store",
+			Description: "Pets",
+			Labels:      map[string]string{"team": "pets", "tier": "gold"},
+		},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	// Reads are not audited.
+	if _, err := server.GetProject(ctx, &rpc.GetProjectRequest{Name: project}); err != nil {
+		t.Fatalf("GetProject() returned error: %s", err)
+	}
+
+	got := listAuditEvents(ctx, t, server, `project_id == "audit"`)
+	want := []*rpc.AuditEvent{
+		{
+			Method:        "CreateProject",
+			Resource:      project,
+			ChangedFields: &fieldmaskpb.FieldMask{},
+		},
+		{
+			Method:        "CreateApi",
+			Resource:      api,
+			ChangedFields: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "labels.team"}},
+		},
+		{
+			Method:        "UpdateApi",
+			Resource:      api,
+			Actor:         "alice@example.com",
+			RequestId:     "request-1",
+			ChangedFields: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "description", "labels.tier"}},
+		},
+		{
+			Method:        "DeleteApi",
+			Resource:      api,
+			ChangedFields: &fieldmaskpb.FieldMask{},
+		},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ListAuditEvents() returned %d events, want %d: %v", len(got), len(want), got)
+	}
+	for i, e := range got {
+		if e.GetEventTime() == nil || e.GetRequestId() == "" {
+			t.Errorf("event %d is missing a time or request ID: %v", i, e)
+		}
+		if i > 0 && e.GetRequestId() == got[i-1].GetRequestId() {
+			t.Errorf("events %d and %d have the same generated request ID %q", i-1, i, e.GetRequestId())
+		}
+		e.EventTime = nil
+		if want[i].RequestId == "" {
+			e.RequestId = ""
+		}
+		if !proto.Equal(want[i], e) {
+			t.Errorf("event %d = %v, want %v", i, e, want[i])
+		}
+	}
+
+	got = listAuditEvents(ctx, t, server, `actor == "alice@example.com"`)
+	if len(got) != 1 || got[0].GetMethod() != "UpdateApi" {
+		t.Errorf("ListAuditEvents() filtered by actor returned %v, want the UpdateApi event", got)
+	}
+}
+
+func TestAuditEventsArePaged(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to get server with SQLite: %s", err)
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: id}); err != nil {
+			t.Fatalf("CreateProject(%q) returned error: %s", id, err)
+		}
+	}
+
+	var got []string
+	req := &rpc.ListAuditEventsRequest{PageSize: 2}
+	for {
+		resp, err := server.ListAuditEvents(ctx, req)
+		if err != nil {
+			t.Fatalf("ListAuditEvents(%v) returned error: %s", req, err)
+		}
+		for _, e := range resp.GetAuditEvents() {
+			got = append(got, e.GetResource())
+		}
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+	want := []string{"projects/a", "projects/b", "projects/c"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListAuditEvents() returned unexpected resources (-want +got):\n%s", diff)
+	}
+}
+
+func TestListAuditEventsResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to get server with SQLite: %s", err)
+	}
+	tests := []struct {
+		desc string
+		req  *rpc.ListAuditEventsRequest
+		want codes.Code
+	}{
+		{
+			desc: "negative page size",
+			req:  &rpc.ListAuditEventsRequest{PageSize: -1},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid filter",
+			req:  &rpc.ListAuditEventsRequest{Filter: "this filter is not valid"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "unknown field in filter",
+			req:  &rpc.ListAuditEventsRequest{Filter: `color == "red"`},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid page token",
+			req:  &rpc.ListAuditEventsRequest{PageToken: "this token is not valid"},
+			want: codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.ListAuditEvents(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("ListAuditEvents(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	tests := []struct {
+		desc   string
+		before proto.Message
+		after  proto.Message
+		want   []string
+	}{
+		{
+			desc: "nothing",
+		},
+		{
+			desc:   "created",
+			before: nil,
+			after:  &rpc.Api{Name: "a", Description: "d", CreateTime: timestamppb.Now()},
+			want:   []string{"description"},
+		},
+		{
+			desc:   "deleted",
+			before: &rpc.Project{Name: "p", DisplayName: "P"},
+			after:  nil,
+			want:   []string{"display_name"},
+		},
+		{
+			desc:   "unchanged",
+			before: &rpc.ApiSpec{MimeType: "text/plain", RevisionUpdateTime: timestamppb.Now()},
+			after:  &rpc.ApiSpec{MimeType: "text/plain"},
+		},
+		{
+			desc:   "fields and map entries",
+			before: &rpc.Api{RecommendedVersion: "v1", Labels: map[string]string{"a": "1"}},
+			after:  &rpc.Api{RecommendedVersion: "v2", Labels: map[string]string{"a": "2", "b": "1"}},
+			want:   []string{"recommended_version", "labels.a", "labels.b"},
+		},
+		{
+			desc:   "map entry removed",
+			before: &rpc.Api{Annotations: map[string]string{"a": "1", "b": "2"}},
+			after:  &rpc.Api{Annotations: map[string]string{"b": "2"}},
+			want:   []string{"annotations.a"},
+		},
+		{
+			desc:   "contents",
+			before: &rpc.Artifact{Contents: []byte("x")},
+			after:  &rpc.Artifact{Contents: []byte("y")},
+			want:   []string{"contents"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := changedFields(test.before, test.after)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("changedFields() returned unexpected paths (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates the callers of registry RPCs and authorizes
+// them with roles that are granted for projects.
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoCredentials is returned by authenticators for calls that don't carry
+// credentials that they recognize.
+var ErrNoCredentials = errors.New("no credentials")
+
+// An Authenticator identifies the principal that made a call.
+type Authenticator interface {
+	// Authenticate returns the principal that made the call that ctx belongs to.
+	// It returns ErrNoCredentials if the call has no credentials that the
+	// authenticator recognizes and another error if the credentials are invalid.
+	Authenticate(ctx context.Context) (string, error)
+}
+
+type principalKey struct{}
+
+// NewContext returns a context that carries an authenticated principal.
+func NewContext(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the authenticated principal carried by a context.
+func FromContext(ctx context.Context) (string, bool) {
+	principal, ok := ctx.Value(principalKey{}).(string)
+	return principal, ok
+}
+
+// Authenticate tries each authenticator in turn and returns a context that
+// carries the first principal that is identified. Calls that can't be
+// authenticated fail with Unauthenticated.
+func Authenticate(ctx context.Context, authenticators ...Authenticator) (context.Context, error) {
+	err := ErrNoCredentials
+	for _, a := range authenticators {
+		principal, aerr := a.Authenticate(ctx)
+		if aerr == nil {
+			return NewContext(ctx, principal), nil
+		}
+		if !errors.Is(aerr, ErrNoCredentials) {
+			err = aerr
+		}
+	}
+	return nil, status.Errorf(codes.Unauthenticated, "unauthenticated: %s", err)
+}
+
+// UnaryServerInterceptor authenticates unary calls.
+func UnaryServerInterceptor(authenticators ...Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := Authenticate(ctx, authenticators...)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates streaming calls.
+func StreamServerInterceptor(authenticators ...Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := Authenticate(ss.Context(), authenticators...)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream is a stream with a context that carries its principal.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// bearerToken returns the bearer token in the authorization metadata of a call.
+func bearerToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if scheme, token, ok := strings.Cut(v, " "); ok && strings.EqualFold(scheme, "bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
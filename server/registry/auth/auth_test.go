@@ -0,0 +1,120 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func incomingContext(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func TestAPIKeys(t *testing.T) {
+	keys := NewAPIKeys(map[string]string{"secret-1": "alice", "secret-2": "bob"})
+	tests := []struct {
+		desc      string
+		ctx       context.Context
+		principal string
+		err       error
+	}{
+		{"bearer token", incomingContext("authorization", "Bearer secret-1"), "alice", nil},
+		{"lowercase scheme", incomingContext("authorization", "bearer secret-2"), "bob", nil},
+		{"api key header", incomingContext("x-api-key", "secret-2"), "bob", nil},
+		{"unknown key", incomingContext("authorization", "Bearer secret-3"), "", ErrNoCredentials},
+		{"basic auth", incomingContext("authorization", "Basic secret-1"), "", ErrNoCredentials},
+		{"no metadata", context.Background(), "", ErrNoCredentials},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			principal, err := keys.Authenticate(test.ctx)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Authenticate() returned error %v, want %v", err, test.err)
+			}
+			if principal != test.principal {
+				t.Errorf("Authenticate() returned %q, want %q", principal, test.principal)
+			}
+		})
+	}
+}
+
+type fakeAuthenticator struct {
+	principal string
+	err       error
+}
+
+func (f fakeAuthenticator) Authenticate(ctx context.Context) (string, error) {
+	return f.principal, f.err
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	none := fakeAuthenticator{err: ErrNoCredentials}
+	invalid := fakeAuthenticator{err: errors.New("token has expired")}
+	alice := fakeAuthenticator{principal: "alice"}
+
+	got, err := Authenticate(ctx, none, alice, invalid)
+	if err != nil {
+		t.Fatalf("Authenticate() returned error: %s", err)
+	}
+	if principal, ok := FromContext(got); !ok || principal != "alice" {
+		t.Errorf("FromContext() returned %q, %t, want %q", principal, ok, "alice")
+	}
+
+	for _, authenticators := range [][]Authenticator{nil, {none}, {none, invalid}} {
+		if _, err := Authenticate(ctx, authenticators...); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Authenticate() returned status code %s, want %s", status.Code(err), codes.Unauthenticated)
+		}
+	}
+	if _, ok := FromContext(ctx); ok {
+		t.Errorf("FromContext() returned a principal for an unauthenticated context")
+	}
+}
+
+func TestCertificatePrincipal(t *testing.T) {
+	spiffe, _ := url.Parse("spiffe://example.com/registry")
+	tests := []struct {
+		cert *x509.Certificate
+		want string
+	}{
+		{&x509.Certificate{EmailAddresses: []string{"alice@example.com"}, URIs: []*url.URL{spiffe}, Subject: pkix.Name{CommonName: "alice"}}, "alice@example.com"},
+		{&x509.Certificate{URIs: []*url.URL{spiffe}, Subject: pkix.Name{CommonName: "alice"}}, "spiffe://example.com/registry"},
+		{&x509.Certificate{Subject: pkix.Name{CommonName: "alice"}}, "alice"},
+	}
+	for _, test := range tests {
+		got, err := CertificatePrincipal(test.cert)
+		if err != nil {
+			t.Errorf("CertificatePrincipal() returned error: %s", err)
+		}
+		if got != test.want {
+			t.Errorf("CertificatePrincipal() returned %q, want %q", got, test.want)
+		}
+	}
+	if _, err := CertificatePrincipal(&x509.Certificate{}); err == nil {
+		t.Errorf("CertificatePrincipal() returned no error for a certificate without names")
+	}
+	if _, err := (ClientCertificates{}).Authenticate(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Authenticate() returned error %v, want %v", err, ErrNoCredentials)
+	}
+}
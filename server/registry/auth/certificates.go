@@ -0,0 +1,58 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientCertificates authenticates callers with the TLS client certificates
+// that they present. Certificates must have been verified by the server's
+// transport credentials, which requires mutual TLS.
+type ClientCertificates struct{}
+
+// Authenticate implements Authenticator.
+func (ClientCertificates) Authenticate(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", ErrNoCredentials
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", ErrNoCredentials
+	}
+	return CertificatePrincipal(info.State.VerifiedChains[0][0])
+}
+
+// CertificatePrincipal returns the principal identified by a certificate:
+// its first email address, its first URI (such as a SPIFFE ID) or its
+// subject common name, in that order of preference.
+func CertificatePrincipal(cert *x509.Certificate) (string, error) {
+	switch {
+	case len(cert.EmailAddresses) > 0:
+		return cert.EmailAddresses[0], nil
+	case len(cert.URIs) > 0:
+		return cert.URIs[0].String(), nil
+	case cert.Subject.CommonName != "":
+		return cert.Subject.CommonName, nil
+	default:
+		return "", errors.New("client certificate does not identify a principal")
+	}
+}
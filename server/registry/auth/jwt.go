@@ -0,0 +1,298 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// clockSkew is the difference between clocks that is tolerated when the
+// times in tokens are checked.
+const clockSkew = time.Minute
+
+// JWT authenticates callers with JSON Web Tokens that are sent as bearer
+// tokens, such as OIDC ID tokens. Tokens must be signed with one of the keys
+// of a JSON Web Key Set and must not have expired.
+type JWT struct {
+	// Issuer is required in the "iss" claim of tokens if it is set.
+	Issuer string
+	// Audience is required in the "aud" claim of tokens if it is set.
+	Audience string
+	// PrincipalClaim is the claim that identifies principals.
+	// Default: "email", or "sub" for tokens without an email.
+	PrincipalClaim string
+
+	keys []jsonWebKey
+	now  func() time.Time
+}
+
+// jsonWebKey is a key that verifies token signatures.
+type jsonWebKey struct {
+	id  string
+	alg string
+	key crypto.PublicKey
+}
+
+// NewJWT returns an authenticator for tokens that are signed with the keys
+// of a JSON Web Key Set (RFC 7517). RSA and elliptic curve keys are supported.
+func NewJWT(jwks []byte) (*JWT, error) {
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Alg string `json:"alg"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &set); err != nil {
+		return nil, fmt.Errorf("invalid JSON Web Key Set: %s", err)
+	}
+	j := &JWT{now: time.Now}
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var key crypto.PublicKey
+		var err error
+		switch k.Kty {
+		case "RSA":
+			key, err = rsaKey(k.N, k.E)
+		case "EC":
+			key, err = ecKey(k.Crv, k.X, k.Y)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d of JSON Web Key Set: %s", i, err)
+		}
+		j.keys = append(j.keys, jsonWebKey{id: k.Kid, alg: k.Alg, key: key})
+	}
+	if len(j.keys) == 0 {
+		return nil, errors.New("invalid JSON Web Key Set: no RSA or EC signing keys")
+	}
+	return j, nil
+}
+
+func rsaKey(n, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %s", err)
+	}
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %s", err)
+	}
+	exponent := new(big.Int).SetBytes(eb)
+	if len(nb) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid RSA key")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: int(exponent.Int64())}, nil
+}
+
+func ecKey(crv, x, y string) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", crv)
+	}
+	xb, err := base64.RawURLEncoding.DecodeString(x)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x coordinate: %s", err)
+	}
+	yb, err := base64.RawURLEncoding.DecodeString(y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y coordinate: %s", err)
+	}
+	key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(xb), Y: new(big.Int).SetBytes(yb)}
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("point is not on the curve")
+	}
+	return key, nil
+}
+
+// Authenticate implements Authenticator.
+func (j *JWT) Authenticate(ctx context.Context) (string, error) {
+	token := bearerToken(ctx)
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrNoCredentials
+	}
+	claims, err := j.verify(parts)
+	if err != nil {
+		return "", fmt.Errorf("invalid token: %s", err)
+	}
+	return j.principal(claims)
+}
+
+// verify checks the signature of a token and returns its claims.
+func (j *JWT) verify(parts []string) (map[string]interface{}, error) {
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid header: %s", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %s", err)
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+
+	verified := false
+	for _, k := range j.keys {
+		if (header.Kid != "" && k.id != header.Kid) || (k.alg != "" && k.alg != header.Alg) {
+			continue
+		}
+		if err := verifySignature(header.Alg, k.key, signed, signature); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("signature is not valid for any key")
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid claims: %s", err)
+	}
+	now := j.now()
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, errors.New("missing expiration time")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return nil, errors.New("token has expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return nil, errors.New("token is not valid yet")
+	}
+	if j.Issuer != "" && claims["iss"] != j.Issuer {
+		return nil, fmt.Errorf("issuer %v is not %q", claims["iss"], j.Issuer)
+	}
+	if j.Audience != "" && !hasAudience(claims["aud"], j.Audience) {
+		return nil, fmt.Errorf("audience %v does not include %q", claims["aud"], j.Audience)
+	}
+	return claims, nil
+}
+
+func (j *JWT) principal(claims map[string]interface{}) (string, error) {
+	names := []string{j.PrincipalClaim}
+	if j.PrincipalClaim == "" {
+		names = []string{"email", "sub"}
+	}
+	for _, name := range names {
+		if principal, ok := claims[name].(string); ok && principal != "" {
+			return principal, nil
+		}
+	}
+	return "", fmt.Errorf("invalid token: missing %s claim", strings.Join(names, " or "))
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func hasAudience(aud interface{}, want string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == want
+	case []interface{}:
+		for _, a := range aud {
+			if a == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifySignature verifies a signature made with one of the RSA or ECDSA
+// algorithms of RFC 7518.
+func verifySignature(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"):
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %q requires an RSA key", alg)
+		}
+		if alg[0] == 'P' {
+			return rsa.VerifyPSS(pub, hash, digest, signature, nil)
+		}
+		return rsa.VerifyPKCS1v15(pub, hash, digest, signature)
+	case strings.HasPrefix(alg, "ES"):
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %q requires an EC key", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// sign returns a token with claims that is signed with key.
+func sign(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(signed))
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatalf("Setup: failed to sign token: %s", err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			t.Fatalf("Setup: failed to sign token: %s", err)
+		}
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signed + "." + b64(signature)
+}
+
+func TestJWT(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Setup: failed to generate RSA key: %s", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Setup: failed to generate EC key: %s", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Setup: failed to generate RSA key: %s", err)
+	}
+	jwks, _ := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   b64(rsaKey.N.Bytes()),
+				"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec",
+				"crv": "P-256",
+				"x":   b64(ecKey.X.FillBytes(make([]byte, 32))),
+				"y":   b64(ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+		},
+	})
+	j, err := NewJWT(jwks)
+	if err != nil {
+		t.Fatalf("NewJWT() returned error: %s", err)
+	}
+	j.Issuer = "https://issuer.example.com"
+	j.Audience = "registry"
+	j.now = func() time.Time { return testNow }
+
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":   "https://issuer.example.com",
+			"aud":   []string{"other", "registry"},
+			"sub":   "1234",
+			"email": "alice@example.com",
+			"exp":   testNow.Add(time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+	tests := []struct {
+		desc      string
+		token     string
+		principal string
+		valid     bool
+	}{
+		{"RSA", sign(t, "RS256", "rsa", rsaKey, claims(nil)), "alice@example.com", true},
+		{"EC", sign(t, "ES256", "ec", ecKey, claims(nil)), "alice@example.com", true},
+		{"no key ID", sign(t, "ES256", "", ecKey, claims(nil)), "alice@example.com", true},
+		{"subject", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"email": nil})), "1234", true},
+		{"audience string", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"aud": "registry"})), "alice@example.com", true},
+		{"within clock skew", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"exp": testNow.Add(-30 * time.Second).Unix()})), "alice@example.com", true},
+		{"expired", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"exp": testNow.Add(-time.Hour).Unix()})), "", false},
+		{"no expiration", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"exp": nil})), "", false},
+		{"not yet valid", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"nbf": testNow.Add(time.Hour).Unix()})), "", false},
+		{"wrong issuer", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"iss": "https://evil.example.com"})), "", false},
+		{"wrong audience", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"aud": "other"})), "", false},
+		{"unknown key", sign(t, "RS256", "rsa", otherKey, claims(nil)), "", false},
+		{"wrong algorithm", sign(t, "RS256", "ec", rsaKey, claims(nil)), "", false},
+		{"no principal", sign(t, "RS256", "rsa", rsaKey, claims(map[string]interface{}{"email": nil, "sub": nil})), "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			principal, err := j.Authenticate(incomingContext("authorization", "Bearer "+test.token))
+			if test.valid && err != nil {
+				t.Fatalf("Authenticate() returned error: %s", err)
+			}
+			if !test.valid && (err == nil || errors.Is(err, ErrNoCredentials)) {
+				t.Fatalf("Authenticate() returned error %v, want an invalid token error", err)
+			}
+			if principal != test.principal {
+				t.Errorf("Authenticate() returned %q, want %q", principal, test.principal)
+			}
+		})
+	}
+
+	// Tokens that aren't JWTs may be recognized by other authenticators.
+	if _, err := j.Authenticate(incomingContext("authorization", "Bearer api-key")); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Authenticate() returned error %v, want %v", err, ErrNoCredentials)
+	}
+}
+
+func TestNewJWTErrors(t *testing.T) {
+	tests := []string{
+		"not json",
+		`{"keys": []}`,
+		`{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`,
+		`{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`,
+		`{"keys": [{"kty": "RSA", "n": "", "e": "AQAB"}]}`,
+	}
+	for _, jwks := range tests {
+		if _, err := NewJWT([]byte(jwks)); err == nil {
+			t.Errorf("NewJWT(%s) returned no error", jwks)
+		}
+	}
+}
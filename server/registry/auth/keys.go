@@ -0,0 +1,56 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// APIKeys authenticates callers with static keys. Keys are sent as bearer
+// tokens (as the registry tool does with its configured token) or in the
+// x-api-key metadata of calls.
+type APIKeys struct {
+	keys map[[sha256.Size]byte]string
+}
+
+// NewAPIKeys returns an authenticator for a map of keys to the principals
+// that they identify.
+func NewAPIKeys(principals map[string]string) *APIKeys {
+	a := &APIKeys{keys: make(map[[sha256.Size]byte]string, len(principals))}
+	for key, principal := range principals {
+		a.keys[sha256.Sum256([]byte(key))] = principal
+	}
+	return a
+}
+
+// Authenticate implements Authenticator.
+func (a *APIKeys) Authenticate(ctx context.Context) (string, error) {
+	keys := []string{bearerToken(ctx)}
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys = append(keys, md.Get("x-api-key")...)
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		// Keys are looked up by hash so that timing doesn't reveal their contents.
+		if principal, ok := a.keys[sha256.Sum256([]byte(key))]; ok {
+			return principal, nil
+		}
+	}
+	return "", ErrNoCredentials
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A Role is a set of permissions in a project. Each role includes the
+// permissions of the roles before it.
+type Role int
+
+const (
+	// Viewer can read resources.
+	Viewer Role = iota + 1
+	// Editor can also create, change and delete resources below projects.
+	Editor
+	// Admin can also create, change and delete projects.
+	Admin
+)
+
+var roleNames = map[Role]string{
+	Viewer: "viewer",
+	Editor: "editor",
+	Admin:  "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+// ParseRole returns the role with a name.
+func ParseRole(name string) (Role, error) {
+	for r, n := range roleNames {
+		if n == name {
+			return r, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown role %q: must be one of [viewer, editor, admin]", name)
+}
+
+// AllProjects is the project ID of the collection of all projects.
+// Bindings for AllProjects grant roles in every project.
+const AllProjects = "*"
+
+// AnyPrincipal is the principal of bindings that apply to every caller.
+const AnyPrincipal = "*"
+
+// A Binding grants a role in a project to a principal.
+type Binding struct {
+	Principal string
+	Project   string
+	Role      Role
+}
+
+// A Policy decides which roles principals have. The zero Policy grants no roles.
+type Policy struct {
+	Bindings []Binding
+}
+
+// Check returns a PermissionDenied error unless a principal has at least a
+// role in a project. A project ID of "-" refers to all projects and requires
+// a binding for AllProjects.
+func (p *Policy) Check(principal, projectID string, role Role) error {
+	for _, b := range p.Bindings {
+		if (b.Principal == principal || b.Principal == AnyPrincipal) &&
+			(b.Project == projectID || b.Project == AllProjects) &&
+			b.Role >= role {
+			return nil
+		}
+	}
+	if projectID == "-" {
+		return status.Errorf(codes.PermissionDenied, "permission denied: %q does not have the %s role in all projects", principal, role)
+	}
+	return status.Errorf(codes.PermissionDenied, "permission denied: %q does not have the %s role in project %q", principal, role, projectID)
+}
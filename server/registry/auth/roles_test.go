@@ -0,0 +1,80 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseRole(t *testing.T) {
+	for _, role := range []Role{Viewer, Editor, Admin} {
+		got, err := ParseRole(role.String())
+		if err != nil {
+			t.Errorf("ParseRole(%q) returned error: %s", role, err)
+		}
+		if got != role {
+			t.Errorf("ParseRole(%q) returned %s", role, got)
+		}
+	}
+	if _, err := ParseRole("owner"); err == nil {
+		t.Errorf("ParseRole(%q) returned no error", "owner")
+	}
+}
+
+func TestPolicyCheck(t *testing.T) {
+	policy := &Policy{Bindings: []Binding{
+		{Principal: "admin", Project: AllProjects, Role: Admin},
+		{Principal: "editor", Project: "p", Role: Editor},
+		{Principal: "viewer", Project: "p", Role: Viewer},
+		{Principal: AnyPrincipal, Project: "public", Role: Viewer},
+	}}
+	tests := []struct {
+		principal string
+		project   string
+		role      Role
+		allowed   bool
+	}{
+		{"admin", "p", Admin, true},
+		{"admin", "q", Editor, true},
+		{"admin", "-", Admin, true},
+		{"editor", "p", Viewer, true},
+		{"editor", "p", Editor, true},
+		{"editor", "p", Admin, false},
+		{"editor", "q", Viewer, false},
+		{"editor", "-", Viewer, false},
+		{"viewer", "p", Viewer, true},
+		{"viewer", "p", Editor, false},
+		{"viewer", "public", Viewer, true},
+		{"", "public", Viewer, true},
+		{"", "public", Editor, false},
+		{"", "p", Viewer, false},
+	}
+	for _, test := range tests {
+		err := policy.Check(test.principal, test.project, test.role)
+		if test.allowed && err != nil {
+			t.Errorf("Check(%q, %q, %s) returned error: %s", test.principal, test.project, test.role, err)
+		}
+		if !test.allowed && status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Check(%q, %q, %s) returned status code %s, want %s", test.principal, test.project, test.role, status.Code(err), codes.PermissionDenied)
+		}
+	}
+
+	if err := (&Policy{}).Check("admin", "p", Viewer); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("empty policy returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/pkg/connection"
+	"github.com/apigee/registry/pkg/connection/grpctest"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/auth"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type clients struct {
+	registry connection.RegistryClient
+	admin    connection.AdminClient
+}
+
+// newClients returns clients that call a test server with a token.
+func newClients(ctx context.Context, t *testing.T, server *grpctest.Server, token string) clients {
+	t.Helper()
+	config, err := connection.ActiveConfig()
+	if err != nil {
+		t.Fatalf("Setup: failed to get config: %s", err)
+	}
+	config.Token = token
+	registryClient, err := connection.NewRegistryClientWithSettings(ctx, config)
+	if err != nil {
+		t.Fatalf("Setup: failed to create registry client: %s", err)
+	}
+	t.Cleanup(func() { registryClient.Close() })
+	adminClient, err := connection.NewAdminClientWithSettings(ctx, config)
+	if err != nil {
+		t.Fatalf("Setup: failed to create admin client: %s", err)
+	}
+	t.Cleanup(func() { adminClient.Close() })
+	return clients{registry: registryClient, admin: adminClient}
+}
+
+func TestServerAuthorization(t *testing.T) {
+	ctx := context.Background()
+	server, err := grpctest.NewServer(registry.Config{
+		Authenticators: []auth.Authenticator{auth.NewAPIKeys(map[string]string{
+			"admin-key":  "admin@example.com",
+			"editor-key": "editor@example.com",
+			"viewer-key": "viewer@example.com",
+			"other-key":  "other@example.com",
+		})},
+		Policy: &auth.Policy{Bindings: []auth.Binding{
+			{Principal: "admin@example.com", Project: auth.AllProjects, Role: auth.Admin},
+			{Principal: "editor@example.com", Project: "my-project", Role: auth.Editor},
+			{Principal: "viewer@example.com", Project: "my-project", Role: auth.Viewer},
+			{Principal: "other@example.com", Project: "other-project", Role: auth.Admin},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to start server: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	var (
+		admin  = newClients(ctx, t, server, "admin-key")
+		editor = newClients(ctx, t, server, "editor-key")
+		viewer = newClients(ctx, t, server, "viewer-key")
+		other  = newClients(ctx, t, server, "other-key")
+	)
+	const (
+		project = "projects/my-project"
+		parent  = project + "/locations/global"
+		api     = parent + "/apis/petstore"
+	)
+
+	if _, err := admin.admin.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "my-project"}); err != nil {
+		t.Fatalf("CreateProject() returned error: %s", err)
+	}
+	if _, err := editor.admin.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "editor-project"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateProject() by editor returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := viewer.registry.CreateApi(ctx, &rpc.CreateApiRequest{Parent: parent, ApiId: "petstore", Api: &rpc.Api{}}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateApi() by viewer returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := editor.registry.CreateApi(ctx, &rpc.CreateApiRequest{Parent: parent, ApiId: "petstore", Api: &rpc.Api{}}); err != nil {
+		t.Fatalf("CreateApi() by editor returned error: %s", err)
+	}
+	if _, err := viewer.registry.GetApi(ctx, &rpc.GetApiRequest{Name: api}); err != nil {
+		t.Errorf("GetApi() by viewer returned error: %s", err)
+	}
+	if _, err := other.registry.GetApi(ctx, &rpc.GetApiRequest{Name: api}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetApi() by admin of another project returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if err := other.registry.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteApi() by admin of another project returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := viewer.admin.ListProjects(ctx, &rpc.ListProjectsRequest{}).Next(); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListProjects() by viewer returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := editor.admin.GetStorage(ctx, &emptypb.Empty{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetStorage() by editor returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+
+	stream, err := other.registry.WatchResources(ctx, &rpc.WatchResourcesRequest{Pattern: project})
+	if err != nil {
+		t.Fatalf("WatchResources() returned error: %s", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("WatchResources() by admin of another project returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+
+	// Audit events identify authenticated principals.
+	it := admin.admin.ListAuditEvents(ctx, &rpc.ListAuditEventsRequest{Filter: `method == "CreateApi"`})
+	event, err := it.Next()
+	if err != nil {
+		t.Fatalf("ListAuditEvents() returned error: %s", err)
+	}
+	if event.GetActor() != "editor@example.com" {
+		t.Errorf("CreateApi audit event has actor %q, want %q", event.GetActor(), "editor@example.com")
+	}
+	if _, err := it.Next(); err != iterator.Done {
+		t.Errorf("ListAuditEvents() returned more than one CreateApi event: %v", err)
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	ctx := context.Background()
+	server, err := grpctest.NewServer(registry.Config{
+		Authenticators: []auth.Authenticator{auth.NewAPIKeys(map[string]string{"key": "alice@example.com"})},
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to start server: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	for _, token := range []string{"", "wrong-key"} {
+		c := newClients(ctx, t, server, token)
+		if _, err := c.admin.GetStatus(ctx, &emptypb.Empty{}); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("GetStatus() with token %q returned status code %s, want %s", token, status.Code(err), codes.Unauthenticated)
+		}
+	}
+
+	// Without a policy, every authenticated caller is allowed.
+	c := newClients(ctx, t, server, "key")
+	if _, err := c.admin.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "my-project"}); err != nil {
+		t.Errorf("CreateProject() returned error: %s", err)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/server/registry/auth"
+)
+
+// authorize returns a PermissionDenied error unless the caller has at least
+// a role in a project. A project ID of "-" refers to all projects. All calls
+// are allowed if the server has no policy.
+func (s *RegistryServer) authorize(ctx context.Context, projectID string, role auth.Role) error {
+	if s.policy == nil {
+		return nil
+	}
+	principal, _ := auth.FromContext(ctx)
+	return s.policy.Check(principal, projectID, role)
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestAuthorization(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to get server with SQLite: %s", err)
+	}
+	if err := seeder.SeedSpecs(ctx, server,
+		&rpc.ApiSpec{Name: "projects/my-project/locations/global/apis/a/versions/v/specs/s"},
+		&rpc.ApiSpec{Name: "projects/other-project/locations/global/apis/a/versions/v/specs/s"},
+	); err != nil {
+		t.Fatalf("Setup: failed to seed specs: %s", err)
+	}
+	server.policy = &auth.Policy{Bindings: []auth.Binding{
+		{Principal: "admin@example.com", Project: auth.AllProjects, Role: auth.Admin},
+		{Principal: "owner@example.com", Project: "my-project", Role: auth.Admin},
+		{Principal: "editor@example.com", Project: "my-project", Role: auth.Editor},
+		{Principal: "viewer@example.com", Project: "my-project", Role: auth.Viewer},
+		{Principal: auth.AnyPrincipal, Project: "public", Role: auth.Viewer},
+	}}
+
+	const (
+		project = "projects/my-project"
+		parent  = project + "/locations/global"
+		api     = parent + "/apis/a"
+		spec    = api + "/versions/v/specs/s"
+	)
+	calls := map[string]func(context.Context) error{
+		"GetApi": func(ctx context.Context) error {
+			_, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: api})
+			return err
+		},
+		"ListApis": func(ctx context.Context) error {
+			_, err := server.ListApis(ctx, &rpc.ListApisRequest{Parent: parent})
+			return err
+		},
+		"ListAllApis": func(ctx context.Context) error {
+			_, err := server.ListApis(ctx, &rpc.ListApisRequest{Parent: "projects/-/locations/global"})
+			return err
+		},
+		"GetApiSpec": func(ctx context.Context) error {
+			_, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec})
+			return err
+		},
+		"GetApiSpecContents": func(ctx context.Context) error {
+			_, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec})
+			return err
+		},
+		"UpdateApi": func(ctx context.Context) error {
+			_, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{Api: &rpc.Api{Name: api, DisplayName: "A"}})
+			return err
+		},
+		"CreateArtifact": func(ctx context.Context) error {
+			_, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+				Parent:     api,
+				ArtifactId: "x",
+				Artifact:   &rpc.Artifact{},
+			})
+			if status.Code(err) == codes.AlreadyExists {
+				return nil
+			}
+			return err
+		},
+		"UpdateProject": func(ctx context.Context) error {
+			_, err := server.UpdateProject(ctx, &rpc.UpdateProjectRequest{Project: &rpc.Project{Name: project, DisplayName: "P"}})
+			return err
+		},
+		"GetStorage": func(ctx context.Context) error {
+			_, err := server.GetStorage(ctx, &emptypb.Empty{})
+			return err
+		},
+	}
+
+	tests := []struct {
+		principal string
+		allowed   []string
+	}{
+		{
+			principal: "admin@example.com",
+			allowed:   []string{"GetApi", "ListApis", "ListAllApis", "GetApiSpec", "GetApiSpecContents", "UpdateApi", "CreateArtifact", "UpdateProject", "GetStorage"},
+		},
+		{
+			principal: "owner@example.com",
+			allowed:   []string{"GetApi", "ListApis", "GetApiSpec", "GetApiSpecContents", "UpdateApi", "CreateArtifact", "UpdateProject"},
+		},
+		{
+			principal: "editor@example.com",
+			allowed:   []string{"GetApi", "ListApis", "GetApiSpec", "GetApiSpecContents", "UpdateApi", "CreateArtifact"},
+		},
+		{
+			principal: "viewer@example.com",
+			allowed:   []string{"GetApi", "ListApis", "GetApiSpec", "GetApiSpecContents"},
+		},
+		{
+			principal: "stranger@example.com",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.principal, func(t *testing.T) {
+			allowed := make(map[string]bool)
+			for _, name := range test.allowed {
+				allowed[name] = true
+			}
+			ctx := auth.NewContext(ctx, test.principal)
+			for name, call := range calls {
+				err := call(ctx)
+				if allowed[name] && err != nil {
+					t.Errorf("%s returned error: %s", name, err)
+				}
+				if !allowed[name] && status.Code(err) != codes.PermissionDenied {
+					t.Errorf("%s returned status code %s, want %s: %v", name, status.Code(err), codes.PermissionDenied, err)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorizationOfOtherProjects(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to get server with SQLite: %s", err)
+	}
+	if err := seeder.SeedSpecs(ctx, server, &rpc.ApiSpec{
+		Name: "projects/other-project/locations/global/apis/a/versions/v/specs/s",
+	}); err != nil {
+		t.Fatalf("Setup: failed to seed specs: %s", err)
+	}
+	server.policy = &auth.Policy{Bindings: []auth.Binding{
+		{Principal: "admin@example.com", Project: "my-project", Role: auth.Admin},
+	}}
+	ctx = auth.NewContext(ctx, "admin@example.com")
+
+	const spec = "projects/other-project/locations/global/apis/a/versions/v/specs/s"
+	if _, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("GetApiSpec(%q) returned status code %s, want %s", spec, status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: spec}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteApiSpec(%q) returned status code %s, want %s", spec, status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "new-project"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateProject() returned status code %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "my-project"}); err != nil {
+		t.Errorf("CreateProject() returned error: %s", err)
+	}
+}
+
+func TestWatchedProject(t *testing.T) {
+	tests := map[string]string{
+		"projects":                           "-",
+		"projects/":                          "-",
+		"projects/-":                         "-",
+		"projects/my-project":                "my-project",
+		"projects/my-project/locations/-":    "my-project",
+		"projects/-/locations/global/apis/a": "-",
+	}
+	for pattern, want := range tests {
+		if got := watchedProject(pattern); got != want {
+			t.Errorf("watchedProject(%q) returned %q, want %q", pattern, got, want)
+		}
+	}
+}
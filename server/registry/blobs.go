@@ -0,0 +1,61 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"fmt"
+
+	"github.com/apigee/registry/server/registry/internal/storage"
+)
+
+// BlobStoreConfig configures where the contents of spec revisions and
+// artifacts are stored. By default they are stored in the database.
+type BlobStoreConfig struct {
+	// Type of the blob store: "file", "s3" or "" to use the database.
+	Type string
+	// Path of the directory that holds blobs (file).
+	Path string
+	// Endpoint URL of the object store (s3).
+	Endpoint string
+	// Bucket that holds blobs (s3).
+	Bucket string
+	// Prefix added to the names of objects (s3).
+	Prefix string
+	// Region used to sign requests (s3).
+	Region string
+	// Credentials used to sign requests (s3).
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+func newBlobStore(config BlobStoreConfig) (storage.BlobStore, error) {
+	switch config.Type {
+	case "":
+		return nil, nil
+	case "file":
+		return storage.NewFileBlobStore(config.Path)
+	case "s3":
+		return storage.NewS3BlobStore(storage.S3Config{
+			Endpoint:        config.Endpoint,
+			Bucket:          config.Bucket,
+			Prefix:          config.Prefix,
+			Region:          config.Region,
+			AccessKeyID:     config.AccessKeyID,
+			SecretAccessKey: config.SecretAccessKey,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported blob store type %q", config.Type)
+	}
+}
@@ -0,0 +1,610 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// fakeS3 is a stand-in for an S3-compatible object store like MinIO.
+type fakeS3 struct {
+	bucket   string
+	pageSize int // maximum number of objects listed at a time
+	mu       sync.Mutex
+	objects  map[string][]byte
+}
+
+// newFakeS3 returns a fake object store and its endpoint URL.
+func newFakeS3(t *testing.T, bucket string) (*fakeS3, string) {
+	f := &fakeS3{bucket: bucket, pageSize: 1000, objects: make(map[string][]byte)}
+	s := httptest.NewServer(f)
+	t.Cleanup(s.Close)
+	return f, s.URL
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	hash := sha256.Sum256(body)
+	if got, want := r.Header.Get("X-Amz-Content-Sha256"), hex.EncodeToString(hash[:]); got != want {
+		http.Error(w, "XAmzContentSHA256Mismatch", http.StatusBadRequest)
+		return
+	}
+	if auth := r.Header.Get("Authorization"); !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=minio/") {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+	if r.URL.Path == "/"+f.bucket && r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2" {
+		f.list(w, r.URL.Query().Get("prefix"), r.URL.Query().Get("continuation-token"))
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if name == r.URL.Path {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[name] = body
+	case http.MethodGet:
+		b, ok := f.objects[name]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(b)
+	case http.MethodDelete:
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// list writes a page of a ListObjectsV2 response. Continuation tokens are
+// the name of the last object of the previous page.
+func (f *fakeS3) list(w http.ResponseWriter, prefix, token string) {
+	f.mu.Lock()
+	var names []string
+	for name := range f.objects {
+		if strings.HasPrefix(name, prefix) && name > token {
+			names = append(names, name)
+		}
+	}
+	f.mu.Unlock()
+	sort.Strings(names)
+	type object struct {
+		Key string
+	}
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Contents              []object
+		IsTruncated           bool
+		NextContinuationToken string `xml:",omitempty"`
+	}{}
+	if len(names) > f.pageSize {
+		names = names[:f.pageSize]
+		result.IsTruncated = true
+		result.NextContinuationToken = names[len(names)-1]
+	}
+	for _, name := range names {
+		result.Contents = append(result.Contents, object{Key: name})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeS3) put(name string, contents []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[name] = contents
+}
+
+func (f *fakeS3) object(name string) ([]byte, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	b, ok := f.objects[name]
+	return b, ok
+}
+
+func serverWithBlobStore(t *testing.T, dbConfig string, blobs BlobStoreConfig, noMigrate bool) *RegistryServer {
+	t.Helper()
+	server, err := New(Config{
+		Database:  "sqlite3",
+		DBConfig:  dbConfig,
+		NoMigrate: noMigrate,
+		BlobStore: blobs,
+	})
+	if err != nil {
+		t.Fatalf("Setup: Failed to create server: %s", err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+func seedContents(ctx context.Context, t *testing.T, server *RegistryServer) (*rpc.ApiSpec, *rpc.Artifact) {
+	t.Helper()
+	spec := &rpc.ApiSpec{
+		Name:     "projects/my-project/locations/global/apis/my-api/versions/v1/specs/my-spec",
+		MimeType: "application/x.openapi;version=3.0.0",
+		Contents: specContents,
+	}
+	if err := seeder.SeedSpecs(ctx, server, spec); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed spec: %s", err)
+	}
+	artifact := &rpc.Artifact{
+		Name:     "projects/my-project/locations/global/artifacts/my-artifact",
+		MimeType: "text/plain",
+		Contents: []byte("artifact contents"),
+	}
+	if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     "projects/my-project/locations/global",
+		ArtifactId: "my-artifact",
+		Artifact:   artifact,
+	}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to create artifact: %s", err)
+	}
+	return spec, artifact
+}
+
+func checkContents(ctx context.Context, t *testing.T, server *RegistryServer, spec *rpc.ApiSpec, artifact *rpc.Artifact) {
+	t.Helper()
+	specBody, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec.Name})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents(%q) returned error: %s", spec.Name, err)
+	}
+	if !bytes.Equal(specBody.GetData(), spec.Contents) {
+		t.Errorf("GetApiSpecContents(%q) returned unexpected contents", spec.Name)
+	}
+	artifactBody, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: artifact.Name})
+	if err != nil {
+		t.Fatalf("GetArtifactContents(%q) returned error: %s", artifact.Name, err)
+	}
+	if !bytes.Equal(artifactBody.GetData(), artifact.Contents) {
+		t.Errorf("GetArtifactContents(%q) returned %q, want %q", artifact.Name, artifactBody.GetData(), artifact.Contents)
+	}
+}
+
+func blobPath(root string, contents []byte) string {
+	key := storage.BlobKey(contents)
+	return filepath.Join(root, key[:2], key)
+}
+
+func TestFileBlobStore(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	server := serverWithBlobStore(t, fmt.Sprintf("%s/registry.db", t.TempDir()), BlobStoreConfig{Type: "file", Path: root}, false)
+	spec, artifact := seedContents(ctx, t, server)
+
+	for _, contents := range [][]byte{spec.Contents, artifact.Contents} {
+		if b, err := os.ReadFile(blobPath(root, contents)); err != nil {
+			t.Errorf("Blob for %q is missing: %s", contents, err)
+		} else if !bytes.Equal(b, contents) {
+			t.Errorf("Blob for %q has contents %q", contents, b)
+		}
+	}
+	checkContents(ctx, t, server, spec, artifact)
+}
+
+func TestS3BlobStore(t *testing.T) {
+	ctx := context.Background()
+	s3, endpoint := newFakeS3(t, "registry")
+	server := serverWithBlobStore(t, fmt.Sprintf("%s/registry.db", t.TempDir()), BlobStoreConfig{
+		Type:            "s3",
+		Endpoint:        endpoint,
+		Bucket:          "registry",
+		Prefix:          "blobs/",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio-secret",
+	}, false)
+	spec, artifact := seedContents(ctx, t, server)
+
+	for _, contents := range [][]byte{spec.Contents, artifact.Contents} {
+		name := "blobs/" + storage.BlobKey(contents)
+		if b, ok := s3.object(name); !ok {
+			t.Errorf("Object %q is missing", name)
+		} else if !bytes.Equal(b, contents) {
+			t.Errorf("Object %q has contents %q", name, b)
+		}
+	}
+	checkContents(ctx, t, server, spec, artifact)
+}
+
+func TestS3BlobStoreErrors(t *testing.T) {
+	ctx := context.Background()
+	_, endpoint := newFakeS3(t, "registry")
+	store, err := storage.NewS3BlobStore(storage.S3Config{
+		Endpoint:        endpoint,
+		Bucket:          "registry",
+		AccessKeyID:     "someone-else",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("NewS3BlobStore() returned error: %s", err)
+	}
+	contents := []byte("contents")
+	if err := store.Put(ctx, storage.BlobKey(contents), contents); err == nil {
+		t.Errorf("Put() with invalid credentials succeeded, want error")
+	}
+	if _, err := store.Get(ctx, "not-a-key"); err == nil {
+		t.Errorf("Get() with invalid key succeeded, want error")
+	}
+
+	store, err = storage.NewS3BlobStore(storage.S3Config{
+		Endpoint:        endpoint,
+		Bucket:          "registry",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio-secret",
+	})
+	if err != nil {
+		t.Fatalf("NewS3BlobStore() returned error: %s", err)
+	}
+	if _, err := store.Get(ctx, storage.BlobKey(contents)); err != storage.ErrBlobNotFound {
+		t.Errorf("Get() of missing blob returned %v, want %v", err, storage.ErrBlobNotFound)
+	}
+	if err := store.Put(ctx, storage.BlobKey(contents), contents); err != nil {
+		t.Fatalf("Put() returned error: %s", err)
+	}
+	if err := store.Delete(ctx, storage.BlobKey(contents)); err != nil {
+		t.Fatalf("Delete() returned error: %s", err)
+	}
+	if _, err := store.Get(ctx, storage.BlobKey(contents)); err != storage.ErrBlobNotFound {
+		t.Errorf("Get() of deleted blob returned %v, want %v", err, storage.ErrBlobNotFound)
+	}
+}
+
+func TestMigrateDatabaseMovesBlobsToStore(t *testing.T) {
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	ctx := context.Background()
+	dbConfig := fmt.Sprintf("%s/registry.db", t.TempDir())
+	server := serverWithBlobStore(t, dbConfig, BlobStoreConfig{}, false)
+	spec, artifact := seedContents(ctx, t, server)
+	server.Close()
+
+	root := t.TempDir()
+	server = serverWithBlobStore(t, dbConfig, BlobStoreConfig{Type: "file", Path: root}, true)
+	// Contents remain readable from the database until they are migrated.
+	checkContents(ctx, t, server, spec, artifact)
+	if _, err := os.Stat(blobPath(root, spec.Contents)); !os.IsNotExist(err) {
+		t.Fatalf("Blob exists before migration: %v", err)
+	}
+
+	if _, err := server.MigrateDatabase(ctx, &rpc.MigrateDatabaseRequest{}); err != nil {
+		t.Fatalf("MigrateDatabase() returned error: %s", err)
+	}
+	for _, contents := range [][]byte{spec.Contents, artifact.Contents} {
+		if _, err := os.Stat(blobPath(root, contents)); err != nil {
+			t.Errorf("Blob for %q is missing after migration: %s", contents, err)
+		}
+	}
+	checkContents(ctx, t, server, spec, artifact)
+
+	// Migrated contents are read from the store.
+	for _, contents := range [][]byte{spec.Contents, artifact.Contents} {
+		if err := os.Remove(blobPath(root, contents)); err != nil {
+			t.Fatalf("Failed to remove blob: %s", err)
+		}
+	}
+	if _, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec.Name}); err == nil {
+		t.Errorf("GetApiSpecContents(%q) succeeded after its blob was removed, want error", spec.Name)
+	}
+}
+
+func storageSize(ctx context.Context, t *testing.T, server *RegistryServer) (logical, physical int64) {
+	t.Helper()
+	resp, err := server.GetStorage(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetStorage() returned error: %s", err)
+	}
+	return resp.GetLogicalBytes(), resp.GetPhysicalBytes()
+}
+
+func checkStorageSize(ctx context.Context, t *testing.T, server *RegistryServer, logical, physical int) {
+	t.Helper()
+	if gotLogical, gotPhysical := storageSize(ctx, t, server); gotLogical != int64(logical) || gotPhysical != int64(physical) {
+		t.Errorf("GetStorage() returned %d logical and %d physical bytes, want %d and %d", gotLogical, gotPhysical, logical, physical)
+	}
+}
+
+func TestBlobContentsAreShared(t *testing.T) {
+	ctx := context.Background()
+	server := serverWithBlobStore(t, fmt.Sprintf("%s/registry.db", t.TempDir()), BlobStoreConfig{}, false)
+	specA := &rpc.ApiSpec{
+		Name:     "projects/my-project/locations/global/apis/my-api/versions/v1/specs/a",
+		Contents: specContents,
+	}
+	specB := &rpc.ApiSpec{
+		Name:     "projects/my-project/locations/global/apis/my-api/versions/v2/specs/b",
+		Contents: specContents,
+	}
+	if err := seeder.SeedSpecs(ctx, server, specA, specB); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed specs: %s", err)
+	}
+	artifactContents := []byte("artifact contents")
+	for _, id := range []string{"x", "y"} {
+		if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+			Parent:     "projects/my-project/locations/global",
+			ArtifactId: id,
+			Artifact:   &rpc.Artifact{Contents: artifactContents},
+		}); err != nil {
+			t.Fatalf("Setup/Seeding: Failed to create artifact: %s", err)
+		}
+	}
+	s, a := len(specContents), len(artifactContents)
+	checkStorageSize(ctx, t, server, 2*s+2*a, s+a)
+
+	// Saving unchanged contents doesn't store them again.
+	if _, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    specA,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	}); err != nil {
+		t.Fatalf("UpdateApiSpec(%q) returned error: %s", specA.Name, err)
+	}
+	checkStorageSize(ctx, t, server, 2*s+2*a, s+a)
+
+	// Deleting a revision releases its contents.
+	changed := []byte("changed contents")
+	revision, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    &rpc.ApiSpec{Name: specA.Name, Contents: changed},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiSpec(%q) returned error: %s", specA.Name, err)
+	}
+	checkStorageSize(ctx, t, server, 2*s+2*a+len(changed), s+a+len(changed))
+	if _, err := server.DeleteApiSpecRevision(ctx, &rpc.DeleteApiSpecRevisionRequest{
+		Name: specA.Name + "@" + revision.GetRevisionId(),
+	}); err != nil {
+		t.Fatalf("DeleteApiSpecRevision() returned error: %s", err)
+	}
+	checkStorageSize(ctx, t, server, 2*s+2*a, s+a)
+
+	// Replacing contents releases the previous contents.
+	other := []byte("other contents")
+	if _, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+		Artifact: &rpc.Artifact{Name: "projects/my-project/locations/global/artifacts/x", Contents: other},
+	}); err != nil {
+		t.Fatalf("ReplaceArtifact() returned error: %s", err)
+	}
+	checkStorageSize(ctx, t, server, 2*s+a+len(other), s+a+len(other))
+
+	if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{
+		Name: "projects/my-project/locations/global/artifacts/y",
+	}); err != nil {
+		t.Fatalf("DeleteArtifact() returned error: %s", err)
+	}
+	checkStorageSize(ctx, t, server, 2*s+a+len(other), s+a+len(other))
+	purgeAll(ctx, t, server)
+	checkStorageSize(ctx, t, server, 2*s+len(other), s+len(other))
+
+	// Deleted specs keep their contents until they are purged from the trash.
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: specB.Name, Force: true}); err != nil {
+		t.Fatalf("DeleteApiSpec(%q) returned error: %s", specB.Name, err)
+	}
+	checkStorageSize(ctx, t, server, 2*s+len(other), s+len(other))
+	purgeAll(ctx, t, server)
+	checkStorageSize(ctx, t, server, s+len(other), s+len(other))
+	checkContents(ctx, t, server, specA, &rpc.Artifact{Name: "projects/my-project/locations/global/artifacts/x", Contents: other})
+
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: "projects/my-project", Force: true}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+	purgeAll(ctx, t, server)
+	checkStorageSize(ctx, t, server, 0, 0)
+}
+
+// purgeAll permanently deletes all resources in the trash.
+func purgeAll(ctx context.Context, t *testing.T, server *RegistryServer) {
+	t.Helper()
+	if err := server.purgeExpiredResources(ctx, time.Now().Add(server.deleteRetention+time.Minute)); err != nil {
+		t.Fatalf("purgeExpiredResources() returned error: %s", err)
+	}
+}
+
+func TestBlobStoreDeletesUnreferencedContents(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	server := serverWithBlobStore(t, fmt.Sprintf("%s/registry.db", t.TempDir()), BlobStoreConfig{Type: "file", Path: root}, false)
+	db, err := server.getStorageClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: Failed to get storage client: %s", err)
+	}
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed project: %s", err)
+	}
+	contents := []byte("artifact contents")
+	create := func(id string) {
+		t.Helper()
+		if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+			Parent:     "projects/my-project/locations/global",
+			ArtifactId: id,
+			Artifact:   &rpc.Artifact{Contents: contents},
+		}); err != nil {
+			t.Fatalf("CreateArtifact(%q) returned error: %s", id, err)
+		}
+	}
+	remove := func(id string) {
+		t.Helper()
+		if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{
+			Name: "projects/my-project/locations/global/artifacts/" + id,
+		}); err != nil {
+			t.Fatalf("DeleteArtifact(%q) returned error: %s", id, err)
+		}
+		purgeAll(ctx, t, server)
+	}
+	sweep := func(want int) {
+		t.Helper()
+		if n, err := db.DeleteUnreferencedBlobContents(ctx); err != nil {
+			t.Fatalf("DeleteUnreferencedBlobContents() returned error: %s", err)
+		} else if n != want {
+			t.Errorf("DeleteUnreferencedBlobContents() deleted %d contents, want %d", n, want)
+		}
+	}
+	exists := func() bool {
+		_, err := os.Stat(blobPath(root, contents))
+		return err == nil
+	}
+
+	create("x")
+	create("y")
+	remove("x")
+	sweep(0)
+	if !exists() {
+		t.Fatalf("Blob was deleted while it was referenced")
+	}
+
+	// Contents that are referenced again before they are swept are kept.
+	remove("y")
+	create("z")
+	sweep(0)
+	if !exists() {
+		t.Fatalf("Blob was deleted while it was referenced")
+	}
+
+	remove("z")
+	if !exists() {
+		t.Fatalf("Blob was deleted before it was swept")
+	}
+	sweep(1)
+	if exists() {
+		t.Errorf("Unreferenced blob was not deleted")
+	}
+	checkStorageSize(ctx, t, server, 0, 0)
+
+	// Contents are stored again when they are referenced after being swept.
+	create("x")
+	if !exists() {
+		t.Errorf("Blob was not stored again")
+	}
+	checkStorageSize(ctx, t, server, len(contents), len(contents))
+}
+
+func TestBlobStoreDeletesOrphanedContents(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	s3, endpoint := newFakeS3(t, "registry")
+	s3.pageSize = 2
+	tests := []struct {
+		desc   string
+		config BlobStoreConfig
+		put    func(contents []byte)
+		exists func(contents []byte) bool
+	}{
+		{
+			desc:   "file",
+			config: BlobStoreConfig{Type: "file", Path: root},
+			put: func(contents []byte) {
+				path := blobPath(root, contents)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatalf("Setup: Failed to create directory: %s", err)
+				}
+				if err := os.WriteFile(path, contents, 0o644); err != nil {
+					t.Fatalf("Setup: Failed to write blob: %s", err)
+				}
+			},
+			exists: func(contents []byte) bool {
+				_, err := os.Stat(blobPath(root, contents))
+				return err == nil
+			},
+		},
+		{
+			desc: "s3",
+			config: BlobStoreConfig{
+				Type:            "s3",
+				Endpoint:        endpoint,
+				Bucket:          "registry",
+				Prefix:          "blobs/",
+				AccessKeyID:     "minio",
+				SecretAccessKey: "minio-secret",
+			},
+			put: func(contents []byte) {
+				s3.put("blobs/"+storage.BlobKey(contents), contents)
+			},
+			exists: func(contents []byte) bool {
+				_, ok := s3.object("blobs/" + storage.BlobKey(contents))
+				return ok
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			server := serverWithBlobStore(t, fmt.Sprintf("%s/registry.db", t.TempDir()), test.config, false)
+			spec, artifact := seedContents(ctx, t, server)
+
+			// Contents stored by a transaction that is rolled back have no references.
+			rolledBack := []byte("rolled back contents")
+			if err := server.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+				if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+					Parent:     "projects/my-project/locations/global",
+					ArtifactId: "rolled-back",
+					Artifact:   &rpc.Artifact{Contents: rolledBack},
+				}); err != nil {
+					return err
+				}
+				return errors.New("rollback")
+			}); err == nil {
+				t.Fatalf("transaction() succeeded, want it to be rolled back")
+			}
+			if !test.exists(rolledBack) {
+				t.Fatalf("Blob of rolled back transaction was not stored")
+			}
+			orphans := [][]byte{rolledBack, []byte("orphan 1"), []byte("orphan 2")}
+			for _, contents := range orphans[1:] {
+				test.put(contents)
+			}
+
+			if err := server.deleteUnusedBlobContents(ctx); err != nil {
+				t.Fatalf("deleteUnusedBlobContents() returned error: %s", err)
+			}
+			for _, contents := range orphans {
+				if test.exists(contents) {
+					t.Errorf("Orphaned blob %q was not deleted", contents)
+				}
+			}
+			checkContents(ctx, t, server, spec, artifact)
+			checkStorageSize(ctx, t, server, len(spec.Contents)+len(artifact.Contents), len(spec.Contents)+len(artifact.Contents))
+		})
+	}
+}
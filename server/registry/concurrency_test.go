@@ -16,10 +16,15 @@ package registry
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"github.com/apigee/registry/server/registry/test/seeder"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -207,3 +212,132 @@ func TestConcurrentApiDeploymentUpdates(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestConcurrentApiCreates(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// Writes are serialized, so creating different resources concurrently always succeeds.
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func(i int) {
+			defer wg.Done()
+			req := &rpc.CreateApiRequest{
+				Parent: "projects/my-project/locations/global",
+				ApiId:  fmt.Sprintf("a%d", i),
+			}
+			if _, err := server.CreateApi(ctx, req); err != nil {
+				t.Errorf("CreateApi(%+v) returned error: %s", req, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	apis, err := server.ListApis(ctx, &rpc.ListApisRequest{Parent: "projects/my-project/locations/global"})
+	if err != nil {
+		t.Fatalf("ListApis() returned error: %s", err)
+	}
+	if len(apis.GetApis()) != concurrency {
+		t.Errorf("ListApis() returned %d apis, want %d", len(apis.GetApis()), concurrency)
+	}
+}
+
+func TestReadsDuringWriteTransaction(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	spec := "projects/my-project/locations/global/apis/a/versions/v/specs/s"
+	if err := seeder.SeedSpecs(ctx, server, &rpc.ApiSpec{Name: spec, Contents: []byte("contents")}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if _, err := server.UpdateProject(ctx, &rpc.UpdateProjectRequest{
+		Project: &rpc.Project{Name: "projects/my-project", DisplayName: "before"},
+	}); err != nil {
+		t.Fatalf("Setup: UpdateProject() returned error: %s", err)
+	}
+
+	// Hold a write transaction open with an uncommitted change.
+	written := make(chan struct{})
+	release := make(chan struct{})
+	writeErr := make(chan error, 1)
+	go func() {
+		writeErr <- server.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			project, err := db.GetProject(ctx, names.Project{ProjectID: "my-project"})
+			if err != nil {
+				return err
+			}
+			project.DisplayName = "after"
+			if err := db.SaveProject(ctx, project); err != nil {
+				return err
+			}
+			close(written)
+			<-release
+			return nil
+		})
+	}()
+	select {
+	case <-written:
+	case err := <-writeErr:
+		t.Fatalf("Setup: write transaction failed: %s", err)
+	}
+
+	// Reads proceed in parallel and see only committed changes.
+	readCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			if project, err := server.GetProject(readCtx, &rpc.GetProjectRequest{Name: "projects/my-project"}); err != nil {
+				t.Errorf("GetProject() returned error: %s", err)
+			} else if project.GetDisplayName() != "before" {
+				t.Errorf("GetProject() returned display name %q, want %q", project.GetDisplayName(), "before")
+			}
+			if _, err := server.ListApis(readCtx, &rpc.ListApisRequest{Parent: "projects/my-project/locations/global"}); err != nil {
+				t.Errorf("ListApis() returned error: %s", err)
+			}
+			if _, err := server.GetApiSpec(readCtx, &rpc.GetApiSpecRequest{Name: spec}); err != nil {
+				t.Errorf("GetApiSpec() returned error: %s", err)
+			}
+			if _, err := server.GetApiSpecContents(readCtx, &rpc.GetApiSpecContentsRequest{Name: spec}); err != nil {
+				t.Errorf("GetApiSpecContents() returned error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	close(release)
+	if err := <-writeErr; err != nil {
+		t.Fatalf("Write transaction failed: %s", err)
+	}
+	if project, err := server.GetProject(ctx, &rpc.GetProjectRequest{Name: "projects/my-project"}); err != nil {
+		t.Errorf("GetProject() returned error: %s", err)
+	} else if project.GetDisplayName() != "after" {
+		t.Errorf("GetProject() returned display name %q, want %q", project.GetDisplayName(), "after")
+	}
+}
+
+func TestSQLiteReadsAreReadOnly(t *testing.T) {
+	ctx := context.Background()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	db, err := server.getStorageClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: failed to get storage client: %s", err)
+	}
+
+	// Writes outside of transactions would bypass serialization, so they fail.
+	project := models.NewProject(names.Project{ProjectID: "my-project"}, &rpc.Project{})
+	if err := db.CreateProject(ctx, project); status.Code(err) != codes.Unavailable {
+		t.Errorf("CreateProject() outside a transaction returned status code %q, want %q: %v", status.Code(err), codes.Unavailable, err)
+	}
+}
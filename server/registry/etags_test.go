@@ -0,0 +1,215 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEtags(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	const (
+		project    = "projects/etags"
+		api        = project + "/locations/global/apis/petstore"
+		version    = api + "/versions/v1"
+		spec       = version + "/specs/openapi"
+		deployment = api + "/deployments/prod"
+		artifact   = api + "/artifacts/report"
+	)
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{Name: spec, MimeType: "application/x.openapi;version=3.0.0", Contents: petstoreContents("listPets")},
+		&rpc.ApiDeployment{Name: deployment},
+		&rpc.Artifact{Name: artifact, MimeType: "text/plain", Contents: []byte("report")},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// Resources are listed in an order that allows them to be deleted one at a time.
+	tests := []struct {
+		name string
+		// get and update return the etag sent by the server.
+		get    func() (string, error)
+		update func(etag string) (string, error)
+		delete func(etag string) error
+	}{
+		{
+			name: artifact,
+			get: func() (string, error) {
+				a, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: artifact})
+				return a.GetEtag(), err
+			},
+			update: func(etag string) (string, error) {
+				a, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+					Artifact: &rpc.Artifact{Name: artifact, MimeType: "text/plain", Contents: []byte("updated"), Etag: etag},
+				})
+				return a.GetEtag(), err
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: artifact, Etag: etag})
+				return err
+			},
+		},
+		{
+			name: deployment,
+			get: func() (string, error) {
+				d, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: deployment})
+				return d.GetEtag(), err
+			},
+			update: func(etag string) (string, error) {
+				d, err := server.UpdateApiDeployment(ctx, &rpc.UpdateApiDeploymentRequest{
+					ApiDeployment: &rpc.ApiDeployment{Name: deployment, Description: etag, Etag: etag},
+				})
+				return d.GetEtag(), err
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApiDeployment(ctx, &rpc.DeleteApiDeploymentRequest{Name: deployment, Etag: etag, Force: true})
+				return err
+			},
+		},
+		{
+			name: spec,
+			get: func() (string, error) {
+				s, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec})
+				return s.GetEtag(), err
+			},
+			update: func(etag string) (string, error) {
+				s, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+					ApiSpec: &rpc.ApiSpec{Name: spec, Description: etag, Etag: etag},
+				})
+				return s.GetEtag(), err
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: spec, Etag: etag, Force: true})
+				return err
+			},
+		},
+		{
+			name: version,
+			get: func() (string, error) {
+				v, err := server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: version})
+				return v.GetEtag(), err
+			},
+			update: func(etag string) (string, error) {
+				v, err := server.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+					ApiVersion: &rpc.ApiVersion{Name: version, Description: etag, Etag: etag},
+				})
+				return v.GetEtag(), err
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: version, Etag: etag, Force: true})
+				return err
+			},
+		},
+		{
+			name: api,
+			get: func() (string, error) {
+				a, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: api})
+				return a.GetEtag(), err
+			},
+			update: func(etag string) (string, error) {
+				a, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+					Api: &rpc.Api{Name: api, Description: etag, Etag: etag},
+				})
+				return a.GetEtag(), err
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api, Etag: etag, Force: true})
+				return err
+			},
+		},
+		{
+			name: project,
+			get: func() (string, error) {
+				p, err := server.GetProject(ctx, &rpc.GetProjectRequest{Name: project})
+				return p.GetEtag(), err
+			},
+			update: func(etag string) (string, error) {
+				p, err := server.UpdateProject(ctx, &rpc.UpdateProjectRequest{
+					Project: &rpc.Project{Name: project, Description: etag, Etag: etag},
+				})
+				return p.GetEtag(), err
+			},
+			delete: func(etag string) error {
+				_, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: project, Etag: etag, Force: true})
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original, err := test.get()
+			if err != nil {
+				t.Fatalf("Get(%q) returned error: %s", test.name, err)
+			}
+			if original == "" {
+				t.Fatalf("Get(%q) returned no etag", test.name)
+			}
+			if _, err := test.update("stale"); status.Code(err) != codes.Aborted {
+				t.Errorf("Update(%q) with a stale etag returned status code %q, want %q: %v", test.name, status.Code(err), codes.Aborted, err)
+			}
+			updated, err := test.update(original)
+			if err != nil {
+				t.Fatalf("Update(%q) with the current etag returned error: %s", test.name, err)
+			}
+			if updated == original {
+				t.Errorf("Update(%q) returned etag %q, want a new etag", test.name, updated)
+			}
+			if got, err := test.get(); err != nil {
+				t.Fatalf("Get(%q) returned error: %s", test.name, err)
+			} else if got != updated {
+				t.Errorf("Get(%q) returned etag %q, want %q", test.name, got, updated)
+			}
+			if _, err := test.update(""); err != nil {
+				t.Errorf("Update(%q) without an etag returned error: %s", test.name, err)
+			}
+			if err := test.delete(updated); status.Code(err) != codes.Aborted {
+				t.Errorf("Delete(%q) with a stale etag returned status code %q, want %q: %v", test.name, status.Code(err), codes.Aborted, err)
+			}
+			current, err := test.get()
+			if err != nil {
+				t.Fatalf("Get(%q) returned error: %s", test.name, err)
+			}
+			if err := test.delete(current); err != nil {
+				t.Errorf("Delete(%q) with the current etag returned error: %s", test.name, err)
+			}
+		})
+	}
+}
+
+func TestEtagOfMissingResource(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/etags"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	req := &rpc.UpdateApiRequest{
+		Api:          &rpc.Api{Name: "projects/etags/locations/global/apis/missing", Etag: "stale"},
+		AllowMissing: true,
+	}
+	if _, err := server.UpdateApi(ctx, req); err != nil {
+		t.Errorf("UpdateApi(%+v) returned error: %s", req, err)
+	}
+	if _, err := server.UpdateApi(ctx, req); status.Code(err) != codes.Aborted {
+		t.Errorf("UpdateApi(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.Aborted, err)
+	}
+}
@@ -0,0 +1,540 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	rpccode "google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maxGatewayRequestSize is the largest request body that the gateway reads,
+// which matches the default limit of gRPC servers.
+const maxGatewayRequestSize = 4 << 20
+
+// gatewayRoute maps HTTP requests that match a google.api.HttpRule to a method.
+type gatewayRoute struct {
+	httpMethod   string
+	path         *pathTemplate
+	body         string
+	responseBody string
+	method       grpc.MethodDesc
+}
+
+// gateway transcodes HTTP/JSON requests to calls of the server's unary
+// methods as described by the google.api.http options of the services.
+// Streaming methods are not available through the gateway.
+type gateway struct {
+	server      *RegistryServer
+	routes      []*gatewayRoute
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// GatewayHandler returns an HTTP handler that serves the Registry and Admin
+// services as HTTP/JSON APIs. Calls pass through the interceptors and are
+// authenticated like gRPC calls.
+func (rs *RegistryServer) GatewayHandler(interceptors ...grpc.UnaryServerInterceptor) (http.Handler, error) {
+	g := &gateway{server: rs}
+	for _, desc := range []*grpc.ServiceDesc{&rpc.Registry_ServiceDesc, &rpc.Admin_ServiceDesc} {
+		routes, err := gatewayRoutes(desc)
+		if err != nil {
+			return nil, err
+		}
+		g.routes = append(g.routes, routes...)
+	}
+	if len(rs.authenticators) > 0 {
+		interceptors = append(interceptors, auth.UnaryServerInterceptor(rs.authenticators...))
+	}
+	if len(interceptors) > 0 {
+		g.interceptor = chainUnaryInterceptors(interceptors)
+	}
+	return g, nil
+}
+
+// ServeGateway starts a net.Listener and http.Server that serves the
+// HTTP/JSON gateway. Connections use TLS if the server is configured with
+// a certificate. Caller is responsible for stopping server.
+func (rs *RegistryServer) ServeGateway(addr *net.TCPAddr, interceptors ...grpc.UnaryServerInterceptor) (net.Listener, *http.Server, error) {
+	handler, err := rs.GatewayHandler(interceptors...)
+	if err != nil {
+		return nil, nil, err
+	}
+	l, err := net.ListenTCP("tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		var err error
+		if rs.tls != nil {
+			s.TLSConfig = rs.tls.serverConfig("h2", "http/1.1")
+			err = s.ServeTLS(l, "", "")
+		} else {
+			err = s.Serve(l)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal(err)
+		}
+	}()
+
+	return l, s, nil
+}
+
+func gatewayRoutes(desc *grpc.ServiceDesc) ([]*gatewayRoute, error) {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+	if err != nil {
+		return nil, err
+	}
+	service, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", desc.ServiceName)
+	}
+	var routes []*gatewayRoute
+	for _, m := range desc.Methods {
+		md := service.Methods().ByName(protoreflect.Name(m.MethodName))
+		if md == nil {
+			return nil, fmt.Errorf("%s has no method %s", desc.ServiceName, m.MethodName)
+		}
+		rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+		for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			route, err := newGatewayRoute(r, m)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %s", desc.ServiceName, m.MethodName, err)
+			}
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
+func newGatewayRoute(rule *annotations.HttpRule, method grpc.MethodDesc) (*gatewayRoute, error) {
+	route := &gatewayRoute{
+		body:         rule.GetBody(),
+		responseBody: rule.GetResponseBody(),
+		method:       method,
+	}
+	var template string
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		route.httpMethod, template = http.MethodGet, p.Get
+	case *annotations.HttpRule_Put:
+		route.httpMethod, template = http.MethodPut, p.Put
+	case *annotations.HttpRule_Post:
+		route.httpMethod, template = http.MethodPost, p.Post
+	case *annotations.HttpRule_Delete:
+		route.httpMethod, template = http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		route.httpMethod, template = http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Custom:
+		route.httpMethod, template = p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return nil, errors.New("http rule has no pattern")
+	}
+	var err error
+	route.path, err = parsePathTemplate(template)
+	return route, err
+}
+
+// chainUnaryInterceptors returns an interceptor that calls interceptors in order.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var route *gatewayRoute
+	var variables map[string]string
+	pathMatched := false
+	for _, rt := range g.routes {
+		if v, ok := rt.path.match(r.URL.EscapedPath()); ok {
+			pathMatched = true
+			if rt.httpMethod == r.Method {
+				route, variables = rt, v
+				break
+			}
+		}
+	}
+	if route == nil {
+		if pathMatched {
+			writeGatewayError(w, http.StatusMethodNotAllowed, status.Errorf(codes.Unimplemented, "method %s is not allowed for %s", r.Method, r.URL.Path))
+		} else {
+			writeGatewayError(w, http.StatusNotFound, status.Errorf(codes.NotFound, "no method is bound to %s", r.URL.Path))
+		}
+		return
+	}
+
+	dec := func(v interface{}) error {
+		m, ok := v.(proto.Message)
+		if !ok {
+			return status.Errorf(codes.Internal, "unexpected request type %T", v)
+		}
+		if err := decodeGatewayRequest(r, route, variables, m); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil
+	}
+	resp, err := route.method.Handler(g.server, gatewayContext(r), dec, g.interceptor)
+	if err != nil {
+		writeGatewayError(w, 0, err)
+		return
+	}
+	m, ok := resp.(proto.Message)
+	if !ok {
+		writeGatewayError(w, 0, status.Errorf(codes.Internal, "unexpected response type %T", resp))
+		return
+	}
+	if route.responseBody != "" {
+		fd := m.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(route.responseBody))
+		if fd == nil || fd.Message() == nil {
+			writeGatewayError(w, 0, status.Errorf(codes.Internal, "invalid response body %q", route.responseBody))
+			return
+		}
+		m = m.ProtoReflect().Get(fd).Message().Interface()
+	}
+	writeGatewayResponse(w, m)
+}
+
+// gatewayContext returns the context of a call that transcodes an HTTP
+// request. Request headers become incoming metadata and the TLS state of
+// the connection is available to authenticators.
+func gatewayContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		key = strings.ToLower(key)
+		switch key {
+		case "accept-encoding", "connection", "content-length", "content-type", "host",
+			"keep-alive", "te", "trailer", "transfer-encoding", "upgrade":
+			continue
+		}
+		if strings.HasPrefix(key, "grpc-") || strings.HasSuffix(key, "-bin") {
+			continue
+		}
+		md.Append(key, values...)
+	}
+	// Spec contents are returned compressed to callers that accept gzip.
+	if acceptsGZIP(r.Header.Values("Accept-Encoding")) {
+		md.Set("accept-encoding", "gzip")
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+
+	p := &peer.Peer{Addr: gatewayAddr(r.RemoteAddr)}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	return peer.NewContext(ctx, p)
+}
+
+type gatewayAddr string
+
+func (a gatewayAddr) Network() string { return "tcp" }
+func (a gatewayAddr) String() string  { return string(a) }
+
+func acceptsGZIP(values []string) bool {
+	for _, value := range values {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(strings.TrimSpace(coding), ";")
+			if strings.TrimSpace(name) != "gzip" {
+				continue
+			}
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+					continue
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// decodeGatewayRequest sets the fields of a request message from the body,
+// path variables and query parameters of an HTTP request.
+func decodeGatewayRequest(r *http.Request, route *gatewayRoute, variables map[string]string, req proto.Message) error {
+	m := req.ProtoReflect()
+	if route.body != "" {
+		data, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxGatewayRequestSize))
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %s", err)
+		}
+		if len(data) > 0 {
+			target := req
+			if route.body != "*" {
+				fd := m.Descriptor().Fields().ByName(protoreflect.Name(route.body))
+				if fd == nil || fd.Message() == nil {
+					return fmt.Errorf("invalid body field %q", route.body)
+				}
+				target = m.Mutable(fd).Message().Interface()
+			}
+			if err := protojson.Unmarshal(data, target); err != nil {
+				return fmt.Errorf("invalid request body: %s", err)
+			}
+		}
+	}
+	for field, value := range variables {
+		if err := setGatewayField(m, field, []string{value}); err != nil {
+			return err
+		}
+	}
+	// Requests with a body of "*" have no query parameters.
+	if route.body == "*" {
+		return nil
+	}
+	for param, values := range r.URL.Query() {
+		if route.body != "" && (param == route.body || strings.HasPrefix(param, route.body+".")) {
+			return fmt.Errorf("invalid query parameter %q: field is set by the request body", param)
+		}
+		if err := setGatewayField(m, param, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setGatewayField sets a field named by a dotted path of proto or JSON field names.
+func setGatewayField(m protoreflect.Message, path string, values []string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fields := m.Descriptor().Fields()
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = fields.ByJSONName(name)
+		}
+		if fd == nil {
+			return fmt.Errorf("invalid field %q: %s has no field %q", path, m.Descriptor().FullName(), name)
+		}
+		if i < len(names)-1 {
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("invalid field %q: %q is not a message", path, name)
+			}
+			m = m.Mutable(fd).Message()
+			continue
+		}
+		if fd.IsMap() {
+			return fmt.Errorf("invalid field %q: map fields can't be set with parameters", path)
+		}
+		if fd.IsList() {
+			list := m.Mutable(fd).List()
+			for _, value := range values {
+				v, err := gatewayValue(fd, list.NewElement, value)
+				if err != nil {
+					return fmt.Errorf("invalid field %q: %s", path, err)
+				}
+				list.Append(v)
+			}
+			return nil
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("invalid field %q: expected one value", path)
+		}
+		v, err := gatewayValue(fd, func() protoreflect.Value { return m.NewField(fd) }, values[0])
+		if err != nil {
+			return fmt.Errorf("invalid field %q: %s", path, err)
+		}
+		m.Set(fd, v)
+	}
+	return nil
+}
+
+// gatewayValue converts a string to a value of a field. Messages, such as
+// field masks and timestamps, are parsed from their JSON string forms.
+func gatewayValue(fd protoreflect.FieldDescriptor, newValue func() protoreflect.Value, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			b, err = base64.URLEncoding.DecodeString(s)
+		}
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(i), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		i, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(i)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		i, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(i), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.EnumKind:
+		if v := fd.Enum().Values().ByName(protoreflect.Name(s)); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		i, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown value %q of %s", s, fd.Enum().FullName())
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(i)), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		v := newValue()
+		// Field mask paths may be written with proto or JSON field names.
+		if mask, ok := v.Message().Interface().(*fieldmaskpb.FieldMask); ok {
+			for _, p := range strings.Split(s, ",") {
+				if p = strings.TrimSpace(p); p != "" {
+					mask.Paths = append(mask.Paths, snakeCase(p))
+				}
+			}
+			return v, nil
+		}
+		if err := protojson.Unmarshal([]byte(strconv.Quote(s)), v.Message().Interface()); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return v, nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported kind %s", fd.Kind())
+	}
+}
+
+// snakeCase converts a lowerCamelCase JSON field name to a proto field name.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if 'A' <= r && r <= 'Z' {
+			b.WriteByte('_')
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// writeGatewayResponse writes a response message as JSON. HttpBody messages
+// are written as they are, and compressed contents are identified by their
+// Content-Encoding.
+func writeGatewayResponse(w http.ResponseWriter, m proto.Message) {
+	if body, ok := m.(*httpbody.HttpBody); ok {
+		contentType := body.GetContentType()
+		if strings.Contains(contentType, "+gzip") {
+			contentType = strings.Replace(contentType, "+gzip", "", 1)
+			w.Header().Set("Content-Encoding", "gzip")
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(body.GetData())
+		return
+	}
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		writeGatewayError(w, 0, status.Error(codes.Internal, err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// writeGatewayError writes an error in the JSON form of Google APIs.
+// If code is zero, the HTTP status code corresponds to the gRPC status code.
+func writeGatewayError(w http.ResponseWriter, code int, err error) {
+	s := status.Convert(err)
+	if code == 0 {
+		code = httpStatusFromCode(s.Code())
+	}
+	body := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": s.Message(),
+			"status":  rpccode.Code_name[int32(s.Code())],
+		},
+	}
+	data, _ := json.Marshal(body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+// httpStatusFromCode returns the HTTP status code that corresponds to a gRPC
+// status code, as described in google/rpc/code.proto.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// pathTemplate is a parsed path template of a google.api.HttpRule, such as
+// "/v1/{name=projects/*/locations/*/apis/*}:undelete".
+type pathTemplate struct {
+	// segments are literal path segments or the wildcards "*" and "**".
+	segments  []string
+	variables []pathVariable
+	verb      string
+}
+
+// pathVariable binds the path segments in [start, end) to a request field.
+type pathVariable struct {
+	field      string
+	start, end int
+}
+
+func parsePathTemplate(template string) (*pathTemplate, error) {
+	if !strings.HasPrefix(template, "/") {
+		return nil, fmt.Errorf("invalid path template %q: must begin with \"/\"", template)
+	}
+	t := &pathTemplate{}
+	rest := template[1:]
+	if i := strings.LastIndex(rest, ":"); i > strings.LastIndex(rest, "}") && i > strings.LastIndex(rest, "/") {
+		rest, t.verb = rest[:i], rest[i+1:]
+	}
+	for rest != "" {
+		if strings.HasPrefix(rest, "{") {
+			end := strings.Index(rest, "}")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path template %q: unterminated variable", template)
+			}
+			field, pattern, ok := strings.Cut(rest[1:end], "=")
+			if !ok {
+				pattern = "*"
+			}
+			v := pathVariable{field: field, start: len(t.segments)}
+			t.segments = append(t.segments, strings.Split(pattern, "/")...)
+			v.end = len(t.segments)
+			t.variables = append(t.variables, v)
+			rest = rest[end+1:]
+		} else {
+			segment := rest
+			if i := strings.Index(rest, "/"); i >= 0 {
+				segment = rest[:i]
+			}
+			t.segments = append(t.segments, segment)
+			rest = rest[len(segment):]
+		}
+		if rest != "" {
+			if !strings.HasPrefix(rest, "/") {
+				return nil, fmt.Errorf("invalid path template %q: expected \"/\"", template)
+			}
+			rest = rest[1:]
+		}
+	}
+	return t, nil
+}
+
+// match returns the values of the variables of a template if it matches an
+// escaped request path.
+func (t *pathTemplate) match(path string) (map[string]string, bool) {
+	path = strings.TrimPrefix(path, "/")
+	if t.verb != "" {
+		if !strings.HasSuffix(path, ":"+t.verb) {
+			return nil, false
+		}
+		path = strings.TrimSuffix(path, ":"+t.verb)
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		var err error
+		if segments[i], err = url.PathUnescape(s); err != nil {
+			return nil, false
+		}
+	}
+	if t.verb == "" && strings.Contains(segments[len(segments)-1], ":") {
+		return nil, false
+	}
+
+	// starts[i] is the index of the first path segment matched by template segment i.
+	starts := make([]int, len(t.segments)+1)
+	var matchFrom func(ti, si int) bool
+	matchFrom = func(ti, si int) bool {
+		starts[ti] = si
+		if ti == len(t.segments) {
+			return si == len(segments)
+		}
+		if si == len(segments) || segments[si] == "" {
+			return false
+		}
+		switch t.segments[ti] {
+		case "**":
+			for end := len(segments); end > si; end-- {
+				if matchFrom(ti+1, end) {
+					return true
+				}
+			}
+			return false
+		case "*", segments[si]:
+			return matchFrom(ti+1, si+1)
+		default:
+			return false
+		}
+	}
+	if !matchFrom(0, 0) {
+		return nil, false
+	}
+
+	values := make(map[string]string, len(t.variables))
+	for _, v := range t.variables {
+		values[v.field] = strings.Join(segments[starts[v.start]:starts[v.end]], "/")
+	}
+	return values, true
+}
@@ -0,0 +1,301 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/server/registry/auth"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPathTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		path     string
+		want     map[string]string
+	}{
+		{"/v1/projects", "/v1/projects", map[string]string{}},
+		{"/v1/projects", "/v1/projects/p", nil},
+		{"/v1/{name=projects/*}", "/v1/projects/p", map[string]string{"name": "projects/p"}},
+		{"/v1/{name=projects/*}", "/v1/projects/", nil},
+		{"/v1/{name=projects/*}", "/v1/projects/p:undelete", nil},
+		{"/v1/{name=projects/*}:undelete", "/v1/projects/p:undelete", map[string]string{"name": "projects/p"}},
+		{"/v1/{name=projects/*}:undelete", "/v1/projects/p", nil},
+		{"/v1/{parent=projects/*/locations/*}/apis", "/v1/projects/p/locations/global/apis", map[string]string{"parent": "projects/p/locations/global"}},
+		{"/v1/{parent=projects/*/locations/*}/apis", "/v1/projects/p/locations/global/apis/a", nil},
+		{"/v1/{api.name=projects/*/locations/*/apis/*}", "/v1/projects/p/locations/global/apis/a", map[string]string{"api.name": "projects/p/locations/global/apis/a"}},
+		{"/v1/{name=projects/*/locations/*/apis/*/versions/*/specs/*}", "/v1/projects/p/locations/global/apis/a/versions/v/specs/s%40abc", map[string]string{"name": "projects/p/locations/global/apis/a/versions/v/specs/s@abc"}},
+		{"/v1/{name=**}/contents", "/v1/a/b/c/contents", map[string]string{"name": "a/b/c"}},
+		{"/v1/{id}", "/v1/x", map[string]string{"id": "x"}},
+	}
+	for _, test := range tests {
+		template, err := parsePathTemplate(test.template)
+		if err != nil {
+			t.Fatalf("parsePathTemplate(%q) returned error: %s", test.template, err)
+		}
+		got, ok := template.match(test.path)
+		if ok != (test.want != nil) {
+			t.Errorf("%q.match(%q) returned %t, want %t", test.template, test.path, ok, test.want != nil)
+			continue
+		}
+		if diff := cmp.Diff(test.want, got); ok && diff != "" {
+			t.Errorf("%q.match(%q) returned unexpected diff (-want +got):\n%s", test.template, test.path, diff)
+		}
+	}
+
+	for _, template := range []string{"v1/projects", "/v1/{name=projects/*", "/v1/{name}x"} {
+		if _, err := parsePathTemplate(template); err == nil {
+			t.Errorf("parsePathTemplate(%q) returned no error", template)
+		}
+	}
+}
+
+type gatewayClient struct {
+	t       *testing.T
+	url     string
+	headers map[string]string
+}
+
+// call makes a request and returns the response and its body.
+func (c gatewayClient) call(method, path, body string) (*http.Response, []byte) {
+	c.t.Helper()
+	req, err := http.NewRequest(method, c.url+path, strings.NewReader(body))
+	if err != nil {
+		c.t.Fatalf("NewRequest(%s, %s) returned error: %s", method, path, err)
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	// Responses are checked as they are sent.
+	client := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+	resp, err := client.Do(req)
+	if err != nil {
+		c.t.Fatalf("%s %s returned error: %s", method, path, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.t.Fatalf("%s %s: failed to read body: %s", method, path, err)
+	}
+	return resp, data
+}
+
+// json makes a request and checks its status code and returns its JSON body.
+func (c gatewayClient) json(method, path, body string, code int) map[string]interface{} {
+	c.t.Helper()
+	resp, data := c.call(method, path, body)
+	if resp.StatusCode != code {
+		c.t.Fatalf("%s %s returned status %d, want %d: %s", method, path, resp.StatusCode, code, data)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		c.t.Errorf("%s %s returned Content-Type %q, want %q", method, path, ct, "application/json")
+	}
+	var v map[string]interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		c.t.Fatalf("%s %s returned invalid JSON %s: %s", method, path, data, err)
+	}
+	return v
+}
+
+func newGatewayTestServer(t *testing.T) (*RegistryServer, gatewayClient) {
+	t.Helper()
+	server, err := serverWithSQLite(t)
+	if err != nil {
+		t.Fatalf("Setup: failed to get server with SQLite: %s", err)
+	}
+	handler, err := server.GatewayHandler()
+	if err != nil {
+		t.Fatalf("GatewayHandler() returned error: %s", err)
+	}
+	s := httptest.NewServer(handler)
+	t.Cleanup(s.Close)
+	return server, gatewayClient{t: t, url: s.URL}
+}
+
+func TestGateway(t *testing.T) {
+	_, c := newGatewayTestServer(t)
+
+	project := c.json("POST", "/v1/projects?project_id=demo", `{"displayName": "Demo"}`, http.StatusOK)
+	if project["name"] != "projects/demo" || project["displayName"] != "Demo" {
+		t.Errorf("CreateProject returned %v", project)
+	}
+	for _, id := range []string{"petstore", "bookstore"} {
+		c.json("POST", "/v1/projects/demo/locations/global/apis?apiId="+id, `{"displayName": "`+id+`"}`, http.StatusOK)
+	}
+
+	api := c.json("GET", "/v1/projects/demo/locations/global/apis/petstore", "", http.StatusOK)
+	if api["displayName"] != "petstore" {
+		t.Errorf("GetApi returned %v", api)
+	}
+
+	list := c.json("GET", "/v1/projects/demo/locations/global/apis?page_size=1&filter=api_id.startsWith('pet')", "", http.StatusOK)
+	if apis, ok := list["apis"].([]interface{}); !ok || len(apis) != 1 {
+		t.Errorf("ListApis returned %v", list)
+	}
+
+	updated := c.json("PATCH", "/v1/projects/demo/locations/global/apis/petstore?update_mask=display_name,description",
+		`{"displayName": "Pet Store", "description": "Pets", "availability": "GA"}`, http.StatusOK)
+	if updated["displayName"] != "Pet Store" || updated["description"] != "Pets" || updated["availability"] != nil {
+		t.Errorf("UpdateApi returned %v", updated)
+	}
+
+	c.json("DELETE", "/v1/projects/demo/locations/global/apis/bookstore", "", http.StatusOK)
+	c.json("POST", "/v1/projects/demo/locations/global/apis/bookstore:undelete", `{}`, http.StatusOK)
+
+	status := c.json("GET", "/v1/status", "", http.StatusOK)
+	if status["message"] == nil {
+		t.Errorf("GetStatus returned %v", status)
+	}
+}
+
+func TestGatewayContents(t *testing.T) {
+	_, c := newGatewayTestServer(t)
+	c.json("POST", "/v1/projects?project_id=demo", `{}`, http.StatusOK)
+	c.json("POST", "/v1/projects/demo/locations/global/apis?api_id=petstore", `{}`, http.StatusOK)
+	c.json("POST", "/v1/projects/demo/locations/global/apis/petstore/versions?api_version_id=v1", `{}`, http.StatusOK)
+
+	contents := []byte("openapi: 3.0.0\n")
+	var zipped bytes.Buffer
+	zw := gzip.NewWriter(&zipped)
+	_, _ = zw.Write(contents)
+	_ = zw.Close()
+	spec := `{"mimeType": "application/x.openapi+gzip;version=3", "contents": "` + base64.StdEncoding.EncodeToString(zipped.Bytes()) + `"}`
+	c.json("POST", "/v1/projects/demo/locations/global/apis/petstore/versions/v1/specs?api_spec_id=openapi", spec, http.StatusOK)
+	artifact := `{"mimeType": "text/plain", "contents": "` + base64.StdEncoding.EncodeToString([]byte("hello")) + `"}`
+	c.json("POST", "/v1/projects/demo/locations/global/artifacts?artifact_id=greeting", artifact, http.StatusOK)
+
+	tests := []struct {
+		desc           string
+		path           string
+		acceptEncoding string
+		contentType    string
+		encoding       string
+		body           []byte
+	}{
+		{
+			desc:        "spec",
+			path:        "/v1/projects/demo/locations/global/apis/petstore/versions/v1/specs/openapi:getContents",
+			contentType: "application/x.openapi;version=3",
+			body:        contents,
+		},
+		{
+			desc:           "compressed spec",
+			path:           "/v1/projects/demo/locations/global/apis/petstore/versions/v1/specs/openapi:getContents",
+			acceptEncoding: "deflate, gzip;q=0.8",
+			contentType:    "application/x.openapi;version=3",
+			encoding:       "gzip",
+			body:           zipped.Bytes(),
+		},
+		{
+			desc:           "refused compression",
+			path:           "/v1/projects/demo/locations/global/apis/petstore/versions/v1/specs/openapi:getContents",
+			acceptEncoding: "gzip;q=0",
+			contentType:    "application/x.openapi;version=3",
+			body:           contents,
+		},
+		{
+			desc:        "artifact",
+			path:        "/v1/projects/demo/locations/global/artifacts/greeting:getContents",
+			contentType: "text/plain",
+			body:        []byte("hello"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			c := gatewayClient{t: t, url: c.url, headers: map[string]string{"Accept-Encoding": test.acceptEncoding}}
+			resp, body := c.call("GET", test.path, "")
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("GET %s returned status %d: %s", test.path, resp.StatusCode, body)
+			}
+			if got := resp.Header.Get("Content-Type"); got != test.contentType {
+				t.Errorf("GET %s returned Content-Type %q, want %q", test.path, got, test.contentType)
+			}
+			if got := resp.Header.Get("Content-Encoding"); got != test.encoding {
+				t.Errorf("GET %s returned Content-Encoding %q, want %q", test.path, got, test.encoding)
+			}
+			if !bytes.Equal(body, test.body) {
+				t.Errorf("GET %s returned body %q, want %q", test.path, body, test.body)
+			}
+		})
+	}
+}
+
+func TestGatewayErrors(t *testing.T) {
+	_, c := newGatewayTestServer(t)
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		code   int
+		status string
+	}{
+		{"GET", "/v1/projects/missing", "", http.StatusNotFound, "NOT_FOUND"},
+		{"GET", "/v1/unknown", "", http.StatusNotFound, "NOT_FOUND"},
+		{"PUT", "/v1/projects/missing", "", http.StatusMethodNotAllowed, "UNIMPLEMENTED"},
+		{"GET", "/v1/projects?page_size=-1", "", http.StatusBadRequest, "INVALID_ARGUMENT"},
+		{"GET", "/v1/projects?page_size=x", "", http.StatusBadRequest, "INVALID_ARGUMENT"},
+		{"GET", "/v1/projects?unknown=1", "", http.StatusBadRequest, "INVALID_ARGUMENT"},
+		{"POST", "/v1/projects?project_id=demo", "not json", http.StatusBadRequest, "INVALID_ARGUMENT"},
+		{"POST", "/v1/projects?project_id=demo&project.name=x", "{}", http.StatusBadRequest, "INVALID_ARGUMENT"},
+	}
+	for _, test := range tests {
+		got := c.json(test.method, test.path, test.body, test.code)
+		e, _ := got["error"].(map[string]interface{})
+		if e["code"] != float64(test.code) || e["status"] != test.status || e["message"] == "" {
+			t.Errorf("%s %s returned error %v, want code %d and status %s", test.method, test.path, got, test.code, test.status)
+		}
+	}
+}
+
+func TestGatewayAuthentication(t *testing.T) {
+	server, c := newGatewayTestServer(t)
+	server.authenticators = []auth.Authenticator{auth.NewAPIKeys(map[string]string{"key": "alice@example.com"})}
+	server.policy = &auth.Policy{Bindings: []auth.Binding{
+		{Principal: "alice@example.com", Project: "demo", Role: auth.Admin},
+	}}
+	handler, err := server.GatewayHandler()
+	if err != nil {
+		t.Fatalf("GatewayHandler() returned error: %s", err)
+	}
+	s := httptest.NewServer(handler)
+	t.Cleanup(s.Close)
+	c.url = s.URL
+
+	c.json("POST", "/v1/projects?project_id=demo", "{}", http.StatusUnauthorized)
+	c.headers = map[string]string{"Authorization": "Bearer key"}
+	c.json("POST", "/v1/projects?project_id=demo", "{}", http.StatusOK)
+	c.json("POST", "/v1/projects?project_id=other", "{}", http.StatusForbidden)
+
+	// Callers are recorded in the audit log.
+	events := c.json("GET", "/v1/auditEvents?filter=method=='CreateProject'", "", http.StatusForbidden)
+	if events["error"] == nil {
+		t.Errorf("ListAuditEvents returned %v, want an error", events)
+	}
+	server.policy.Bindings = append(server.policy.Bindings, auth.Binding{Principal: "alice@example.com", Project: auth.AllProjects, Role: auth.Admin})
+	events = c.json("GET", "/v1/auditEvents?filter=method=='CreateProject'", "", http.StatusOK)
+	list, _ := events["auditEvents"].([]interface{})
+	if len(list) != 1 || list[0].(map[string]interface{})["actor"] != "alice@example.com" {
+		t.Errorf("ListAuditEvents returned %v", events)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search extracts searchable text from API specs.
+package search
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apigee/registry/pkg/mime"
+	"gopkg.in/yaml.v3"
+)
+
+// MaxTextSize is the maximum size of the text extracted from a spec.
+const MaxTextSize = 1 << 20
+
+// SpecText returns the searchable text in the contents of a spec: operation
+// IDs, paths and summaries of OpenAPI descriptions, method and schema IDs of
+// Discovery documents, and service, method and message names of Protocol
+// Buffers. Other printable contents are returned as they are. Contents that
+// can't be read return an empty string.
+func SpecText(mimeType string, contents []byte) string {
+	if mime.IsGZipCompressed(mimeType) {
+		r, err := gzip.NewReader(bytes.NewReader(contents))
+		if err != nil {
+			return ""
+		}
+		if contents, err = io.ReadAll(io.LimitReader(r, 16*MaxTextSize)); err != nil {
+			return ""
+		}
+	}
+
+	var words []string
+	switch {
+	case mime.IsZipArchive(mimeType):
+		words = archiveText(contents)
+	case mime.IsProto(mimeType):
+		words = protoText(contents)
+	case mime.IsOpenAPIv2(mimeType) || mime.IsOpenAPIv3(mimeType):
+		words = openAPIText(contents)
+	case mime.IsDiscovery(mimeType):
+		words = discoveryText(contents)
+	default:
+		if utf8.Valid(contents) {
+			words = []string{string(contents)}
+		}
+	}
+	return truncate(splitIdentifiers(strings.Join(words, "\n")))
+}
+
+var camelHump = regexp.MustCompile(`\b\w*[a-z0-9][A-Z]\w*\b`)
+
+// splitIdentifiers follows identifiers like "listPets" with their words,
+// so that searches for "pets" match them.
+func splitIdentifiers(s string) string {
+	return camelHump.ReplaceAllStringFunc(s, func(id string) string {
+		var b strings.Builder
+		b.WriteString(id)
+		b.WriteString(" ")
+		for i, r := range id {
+			if i > 0 && r >= 'A' && r <= 'Z' {
+				b.WriteString(" ")
+			}
+			b.WriteRune(r)
+		}
+		return b.String()
+	})
+}
+
+func truncate(s string) string {
+	if len(s) <= MaxTextSize {
+		return s
+	}
+	s = s[:MaxTextSize]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// archiveText returns the text of the Protocol Buffers files in a zip archive.
+func archiveText(contents []byte) []string {
+	r, err := zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	if err != nil {
+		return nil
+	}
+	var words []string
+	for _, f := range r.File {
+		if filepath.Ext(f.Name) != ".proto" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			continue
+		}
+		b, err := io.ReadAll(io.LimitReader(rc, MaxTextSize))
+		rc.Close()
+		if err != nil {
+			continue
+		}
+		words = append(words, protoText(b)...)
+	}
+	return words
+}
+
+var protoDeclaration = regexp.MustCompile(`(?m)^\s*(?:package|service|rpc|message|enum)\s+([\w.]+)`)
+
+// protoText returns the names of packages, services, methods, messages and
+// enums declared in a Protocol Buffers file.
+func protoText(contents []byte) []string {
+	var words []string
+	for _, m := range protoDeclaration.FindAllSubmatch(contents, -1) {
+		words = append(words, string(m[1]))
+	}
+	return words
+}
+
+// openAPIText returns the title, paths, operation IDs and summaries in an
+// OpenAPI description.
+func openAPIText(contents []byte) []string {
+	var doc struct {
+		Info struct {
+			Title       string `yaml:"title"`
+			Description string `yaml:"description"`
+		} `yaml:"info"`
+		Paths map[string]interface{} `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return nil
+	}
+	words := []string{doc.Info.Title, doc.Info.Description}
+	for _, path := range sortedKeys(doc.Paths) {
+		words = append(words, path)
+		ops, ok := doc.Paths[path].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range sortedKeys(ops) {
+			op, ok := ops[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, field := range []string{"operationId", "summary"} {
+				if s, ok := op[field].(string); ok {
+					words = append(words, s)
+				}
+			}
+		}
+	}
+	return words
+}
+
+// discoveryText returns the name, title, method IDs, paths and schema IDs
+// in a Discovery document.
+func discoveryText(contents []byte) []string {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return nil
+	}
+	var words []string
+	for _, field := range []string{"name", "title", "description"} {
+		if s, ok := doc[field].(string); ok {
+			words = append(words, s)
+		}
+	}
+	if schemas, ok := doc["schemas"].(map[string]interface{}); ok {
+		words = append(words, sortedKeys(schemas)...)
+	}
+	return append(words, discoveryMethods(doc)...)
+}
+
+func discoveryMethods(resource map[string]interface{}) []string {
+	var words []string
+	if methods, ok := resource["methods"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(methods) {
+			if method, ok := methods[name].(map[string]interface{}); ok {
+				for _, field := range []string{"id", "path"} {
+					if s, ok := method[field].(string); ok {
+						words = append(words, s)
+					}
+				}
+			}
+		}
+	}
+	if resources, ok := resource["resources"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(resources) {
+			if r, ok := resources[name].(map[string]interface{}); ok {
+				words = append(words, discoveryMethods(r)...)
+			}
+		}
+	}
+	return words
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,185 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+)
+
+const openAPI = `openapi: 3.0.0
+info:
+  title: Swagger Petstore
+  description: A sample API
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List all pets
+  /pets/{petId}:
+    get:
+      operationId: showPetById
+`
+
+const discovery = `{
+  "name": "books",
+  "title": "Books API",
+  "schemas": {"Volume": {}},
+  "resources": {
+    "volumes": {
+      "methods": {
+        "get": {"id": "books.volumes.get", "path": "volumes/{volumeId}"}
+      }
+    }
+  }
+}`
+
+const proto = `syntax = "proto3";
+package google.example.library.v1;
+service LibraryService {
+  rpc GetBook(GetBookRequest) returns (Book);
+}
+message Book {
+  string name = 1;
+}
+enum Genre {
+  FICTION = 0;
+}
+`
+
+func gzipped(t *testing.T, b []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func zipped(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, contents := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(contents)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSpecText(t *testing.T) {
+	tests := []struct {
+		desc     string
+		mimeType string
+		contents []byte
+		want     []string
+		exclude  []string
+	}{
+		{
+			desc:     "openapi",
+			mimeType: "application/x.openapi;version=3.0.0",
+			contents: []byte(openAPI),
+			want:     []string{"Swagger Petstore", "A sample API", "/pets/{petId", "listPets list Pets", "List all pets", "showPetById show Pet By Id"},
+		},
+		{
+			desc:     "gzipped openapi",
+			mimeType: "application/x.openapi+gzip;version=3.0.0",
+			contents: gzipped(t, []byte(openAPI)),
+			want:     []string{"listPets", "showPetById"},
+		},
+		{
+			desc:     "discovery",
+			mimeType: "application/x.discovery",
+			contents: []byte(discovery),
+			want:     []string{"books", "Books API", "Volume", "books.volumes.get", "volumes/{volumeId volume Id}"},
+		},
+		{
+			desc:     "proto",
+			mimeType: "text/x.protobuf",
+			contents: []byte(proto),
+			want:     []string{"google.example.library.v1", "LibraryService Library Service", "GetBook Get Book", "Book", "Genre"},
+			exclude:  []string{"syntax", "FICTION"},
+		},
+		{
+			desc:     "proto archive",
+			mimeType: "application/x.protobuf+zip",
+			contents: zipped(t, map[string]string{"library.proto": proto, "README.md": "readme"}),
+			want:     []string{"LibraryService", "GetBook"},
+			exclude:  []string{"readme"},
+		},
+		{
+			desc:     "text",
+			mimeType: "text/plain",
+			contents: []byte("plain words"),
+			want:     []string{"plain words"},
+		},
+		{
+			desc:     "binary",
+			mimeType: "application/octet-stream",
+			contents: []byte{0xff, 0xfe, 0x00},
+			exclude:  []string{"\xff"},
+		},
+		{
+			desc:     "invalid openapi",
+			mimeType: "application/x.openapi;version=3.0.0",
+			contents: []byte("{"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := SpecText(test.mimeType, test.contents)
+			for _, w := range test.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("SpecText() = %q, want it to contain %q", got, w)
+				}
+			}
+			for _, e := range test.exclude {
+				if strings.Contains(got, e) {
+					t.Errorf("SpecText() = %q, want it to exclude %q", got, e)
+				}
+			}
+			if len(test.want) == 0 && got != "" {
+				t.Errorf("SpecText() = %q, want empty text", got)
+			}
+		})
+	}
+}
+
+func TestSpecTextIsTruncated(t *testing.T) {
+	contents := strings.Repeat("é", MaxTextSize)
+	got := SpecText("text/plain", []byte(contents))
+	if len(got) > MaxTextSize {
+		t.Errorf("SpecText() returned %d bytes, want at most %d", len(got), MaxTextSize)
+	}
+	if !strings.HasPrefix(contents, got) {
+		t.Errorf("SpecText() split a character")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var auditEventFields = map[string]filtering.FieldType{
+	"method":     filtering.String,
+	"resource":   filtering.String,
+	"project_id": filtering.String,
+	"actor":      filtering.String,
+	"request_id": filtering.String,
+	"event_time": filtering.Timestamp,
+}
+
+var auditEventColumns = filterColumns("audit_events", auditEventFields, nil)
+
+// RecordAuditEvent adds an event to the audit log.
+func (c *Client) RecordAuditEvent(ctx context.Context, v *models.AuditEvent) error {
+	return c.create(ctx, v)
+}
+
+// AuditEventList contains a page of audit events.
+type AuditEventList struct {
+	AuditEvents []models.AuditEvent
+	Token       string
+}
+
+// ListAuditEvents returns a page of audit events in the order they were recorded.
+// Events are never reordered, so pages remain consistent as new events are recorded.
+func (c *Client) ListAuditEvents(ctx context.Context, opts PageOptions) (AuditEventList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return AuditEventList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	if err := token.ValidateFilter(opts.Filter); err != nil {
+		return AuditEventList{}, status.Errorf(codes.InvalidArgument, "invalid filter %q: %s", opts.Filter, err)
+	} else {
+		token.Filter = opts.Filter
+	}
+
+	filter, err := filtering.NewFilter(opts.Filter, auditEventFields)
+	if err != nil {
+		return AuditEventList{}, err
+	}
+
+	response := AuditEventList{
+		AuditEvents: make([]models.AuditEvent, 0, opts.Size),
+	}
+
+	op, exact := c.where(c.db.WithContext(ctx), filter, auditEventColumns)
+	op = op.Order("id").Limit(limit(opts, exact))
+
+	for {
+		var page []models.AuditEvent
+		err := op.Offset(token.Offset).Find(&page).Error
+
+		if err != nil {
+			return AuditEventList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
+		} else if len(page) == 0 {
+			break
+		}
+
+		for _, v := range page {
+			match, err := filter.Matches(auditEventMap(v))
+			if err != nil {
+				return AuditEventList{}, err
+			} else if !match {
+				token.Offset++
+				continue
+			}
+
+			if len(response.AuditEvents) == int(opts.Size) {
+				response.Token, err = encodeToken(token)
+				if err != nil {
+					return AuditEventList{}, status.Error(codes.Internal, err.Error())
+				}
+				return response, nil
+			}
+
+			token.Offset++
+			response.AuditEvents = append(response.AuditEvents, v)
+		}
+		if op.RowsAffected < int64(opts.Size) {
+			break
+		}
+	}
+
+	return response, nil
+}
+
+func auditEventMap(e models.AuditEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"method":     e.Method,
+		"resource":   e.Resource,
+		"project_id": e.ProjectID,
+		"actor":      e.Actor,
+		"request_id": e.RequestID,
+		"event_time": e.EventTime,
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// blobMigrationBatchSize is the number of blobs read at a time when moving
+// contents out of the blobs table.
+const blobMigrationBatchSize = 100
+
+// blobReference is the number of blobs that refer to some contents.
+type blobReference struct {
+	ContentsRef string
+	Count       int64
+}
+
+// acquireBlobContents replaces the contents of a blob with a reference to
+// the BlobContents with the same hash, creating them if necessary, so that
+// blobs with identical contents share a single copy.
+func (c *Client) acquireBlobContents(ctx context.Context, v *models.Blob) error {
+	if len(v.Contents) == 0 {
+		return nil
+	}
+	contents := &models.BlobContents{
+		Key:         BlobKey(v.Contents),
+		SizeInBytes: int64(len(v.Contents)),
+		RefCount:    1,
+		CreateTime:  time.Now().Round(time.Microsecond),
+	}
+	if c.blobs == nil {
+		contents.Contents = v.Contents
+	}
+	// The reference is added before the contents are stored, so contents that
+	// are being deleted from the store are deleted before they are stored again.
+	// If the transaction is rolled back, the stored contents are left without
+	// a reference and are deleted by DeleteOrphanedBlobContents.
+	err := c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "key"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"ref_count": gorm.Expr("blob_contents.ref_count + 1"),
+		}),
+	}).Create(contents).Error
+	if err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "save contents of %s", v.Key))
+	}
+	if c.blobs != nil {
+		if err := c.blobs.Put(ctx, contents.Key, v.Contents); err != nil {
+			return status.Errorf(codes.Unavailable, "store contents of %s: %s", v.Key, err)
+		}
+	}
+	v.Contents, v.ContentsRef = nil, contents.Key
+	return nil
+}
+
+// releaseBlobContents removes the references held by the blobs selected by
+// query, which must be called before the blobs are deleted or replaced.
+// Contents kept in the database are deleted with their last reference.
+// Contents in a blob store are deleted by DeleteUnreferencedBlobContents
+// because the store can't be rolled back with the transaction.
+func (c *Client) releaseBlobContents(ctx context.Context, query *gorm.DB) error {
+	var refs []blobReference
+	if err := query.Model(&models.Blob{}).
+		Select("contents_ref, COUNT(*) AS count").
+		Where("COALESCE(contents_ref, '') <> ''").
+		Group("contents_ref").
+		Scan(&refs).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "list blob references"))
+	}
+	return c.releaseReferences(ctx, refs)
+}
+
+// releaseReferences removes references to contents, deleting contents kept
+// in the database with their last reference.
+func (c *Client) releaseReferences(ctx context.Context, refs []blobReference) error {
+	for _, r := range refs {
+		if err := c.db.WithContext(ctx).Model(&models.BlobContents{}).
+			Where("key = ?", r.ContentsRef).
+			Update("ref_count", gorm.Expr("ref_count - ?", r.Count)).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "release contents %s", r.ContentsRef))
+		}
+		if err := c.db.WithContext(ctx).
+			Where("key = ?", r.ContentsRef).
+			Where("ref_count <= 0").
+			Where("contents IS NOT NULL").
+			Delete(&models.BlobContents{}).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete contents %s", r.ContentsRef))
+		}
+	}
+	return nil
+}
+
+// saveBlob saves a blob, releasing the contents of any blob that it replaces.
+func (c *Client) saveBlob(ctx context.Context, v *models.Blob) error {
+	if err := c.acquireBlobContents(ctx, v); err != nil {
+		return err
+	}
+	if err := c.releaseBlobContents(ctx, c.db.WithContext(ctx).Where("key = ?", v.Key)); err != nil {
+		return err
+	}
+	return c.save(ctx, v)
+}
+
+// loadBlobContents reads the contents that a blob refers to.
+func (c *Client) loadBlobContents(ctx context.Context, v *models.Blob) error {
+	if v.ContentsRef == "" {
+		return nil
+	}
+	contents := new(models.BlobContents)
+	if err := c.db.WithContext(ctx).Take(contents, "key = ?", v.ContentsRef).Error; err == nil && contents.Contents != nil {
+		v.Contents = contents.Contents
+		return nil
+	} else if err != nil && err != gorm.ErrRecordNotFound {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "get contents of %s", v.Key))
+	}
+	if c.blobs == nil {
+		return status.Errorf(codes.FailedPrecondition, "contents of %s are in a blob store, but no blob store is configured", v.Key)
+	}
+	b, err := c.blobs.Get(ctx, v.ContentsRef)
+	if errors.Is(err, ErrBlobNotFound) {
+		return status.Errorf(codes.Internal, "contents of %s are missing from the blob store", v.Key)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "load contents of %s: %s", v.Key, err)
+	}
+	v.Contents = b
+	return nil
+}
+
+// DeleteUnreferencedBlobContents deletes contents without references from
+// the blob store and returns the number of contents deleted.
+func (c *Client) DeleteUnreferencedBlobContents(ctx context.Context) (int, error) {
+	if c.blobs == nil {
+		return 0, nil
+	}
+	var keys []string
+	if err := c.db.WithContext(ctx).Model(&models.BlobContents{}).
+		Where("ref_count <= 0").
+		Pluck("key", &keys).Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "list unreferenced contents"))
+	}
+	deleted := 0
+	for _, key := range keys {
+		// The row stays locked until the contents are deleted from the store,
+		// so new references wait and then store the contents again.
+		if err := c.Transaction(ctx, func(ctx context.Context, tx *Client) error {
+			op := tx.db.WithContext(ctx).
+				Where("key = ?", key).
+				Where("ref_count <= 0").
+				Delete(&models.BlobContents{})
+			if op.Error != nil || op.RowsAffected == 0 {
+				return op.Error
+			}
+			if err := c.blobs.Delete(ctx, key); err != nil {
+				return status.Errorf(codes.Unavailable, "delete contents %s: %s", key, err)
+			}
+			deleted++
+			return nil
+		}); err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteOrphanedBlobContents deletes contents from the blob store that have
+// no BlobContents, which are left when transactions that store contents are
+// rolled back, and returns the number of contents deleted.
+func (c *Client) DeleteOrphanedBlobContents(ctx context.Context) (int, error) {
+	if c.blobs == nil {
+		return 0, nil
+	}
+	deleted := 0
+	var batch []string
+	sweep := func() error {
+		var known []string
+		if err := c.db.WithContext(ctx).Model(&models.BlobContents{}).
+			Where("key IN ?", batch).
+			Pluck("key", &known).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrap(err, "list contents"))
+		}
+		skip := make(map[string]bool, len(known))
+		for _, key := range known {
+			skip[key] = true
+		}
+		for _, key := range batch {
+			if skip[key] {
+				continue
+			}
+			n, err := c.deleteOrphanedBlobContents(ctx, key)
+			if err != nil {
+				return err
+			}
+			deleted += n
+		}
+		batch = batch[:0]
+		return nil
+	}
+	if err := c.blobs.List(ctx, func(key string) error {
+		if batch = append(batch, key); len(batch) < blobMigrationBatchSize {
+			return nil
+		}
+		return sweep()
+	}); err != nil {
+		return deleted, status.Errorf(codes.Unavailable, "list blob store: %s", err)
+	}
+	if len(batch) > 0 {
+		return deleted, sweep()
+	}
+	return deleted, nil
+}
+
+// deleteOrphanedBlobContents deletes contents from the blob store if they
+// have no BlobContents. A placeholder without references is created while
+// the contents are deleted, so that transactions that are storing the same
+// contents are either seen or wait until the contents are deleted.
+func (c *Client) deleteOrphanedBlobContents(ctx context.Context, key string) (int, error) {
+	deleted := 0
+	err := c.Transaction(ctx, func(ctx context.Context, tx *Client) error {
+		op := tx.db.WithContext(ctx).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.BlobContents{Key: key, CreateTime: time.Now().Round(time.Microsecond)})
+		if op.Error != nil || op.RowsAffected == 0 {
+			return op.Error
+		}
+		if err := c.blobs.Delete(ctx, key); err != nil {
+			return status.Errorf(codes.Unavailable, "delete contents %s: %s", key, err)
+		}
+		if err := tx.db.WithContext(ctx).Where("key = ?", key).Delete(&models.BlobContents{}).Error; err != nil {
+			return err
+		}
+		deleted++
+		return nil
+	})
+	return deleted, err
+}
+
+// BlobContentsSize returns the total size of the contents of all blobs
+// (logical) and the size of the distinct contents that are stored (physical).
+func (c *Client) BlobContentsSize(ctx context.Context) (logical, physical int64, err error) {
+	var shared struct {
+		Logical  int64
+		Physical int64
+	}
+	if err := c.db.WithContext(ctx).Model(&models.BlobContents{}).
+		Select("COALESCE(SUM(size_in_bytes * ref_count), 0) AS logical, COALESCE(SUM(size_in_bytes), 0) AS physical").
+		Scan(&shared).Error; err != nil {
+		return 0, 0, grpcErrorForDBError(ctx, errors.Wrap(err, "sum contents sizes"))
+	}
+	// Contents that haven't been migrated are stored in their blobs.
+	var inline int64
+	if err := c.db.WithContext(ctx).Model(&models.Blob{}).
+		Select("COALESCE(SUM(LENGTH(contents)), 0)").
+		Where("COALESCE(contents_ref, '') = ''").
+		Scan(&inline).Error; err != nil {
+		return 0, 0, grpcErrorForDBError(ctx, errors.Wrap(err, "sum blob sizes"))
+	}
+	return shared.Logical + inline, shared.Physical + inline, nil
+}
+
+// migrateBlobContents moves contents that are stored in blobs to shared
+// contents and, if there is a blob store, moves shared contents to the store.
+func (c *Client) migrateBlobContents(ctx context.Context) error {
+	if err := c.countBlobReferences(ctx); err != nil {
+		return err
+	}
+	if err := c.moveContentsFromBlobs(ctx); err != nil {
+		return err
+	}
+	return c.moveContentsToBlobStore(ctx)
+}
+
+// countBlobReferences creates shared contents for references to contents
+// that were added to a blob store without them.
+func (c *Client) countBlobReferences(ctx context.Context) error {
+	var refs []blobReference
+	if err := c.db.WithContext(ctx).Model(&models.Blob{}).
+		Select("contents_ref, COUNT(*) AS count").
+		Where("COALESCE(contents_ref, '') <> ''").
+		Where("contents_ref NOT IN (?)", c.db.Model(&models.BlobContents{}).Select("key")).
+		Group("contents_ref").
+		Scan(&refs).Error; err != nil {
+		return err
+	}
+	for _, r := range refs {
+		contents := &models.BlobContents{
+			Key:        r.ContentsRef,
+			RefCount:   r.Count,
+			CreateTime: time.Now().Round(time.Microsecond),
+		}
+		if c.blobs != nil {
+			b, err := c.blobs.Get(ctx, r.ContentsRef)
+			if err != nil {
+				return errors.Wrapf(err, "get contents %s", r.ContentsRef)
+			}
+			contents.SizeInBytes = int64(len(b))
+		}
+		if err := c.db.WithContext(ctx).Create(contents).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveContentsFromBlobs replaces the contents stored in blobs with references.
+func (c *Client) moveContentsFromBlobs(ctx context.Context) error {
+	last := ""
+	for {
+		var blobs []*models.Blob
+		if err := c.db.WithContext(ctx).
+			Where("key > ?", last).
+			Where("contents IS NOT NULL").
+			Where("COALESCE(contents_ref, '') = ''").
+			Order("key").
+			Limit(blobMigrationBatchSize).
+			Find(&blobs).Error; err != nil {
+			return err
+		}
+		for _, v := range blobs {
+			last = v.Key
+			if len(v.Contents) == 0 {
+				continue
+			}
+			if err := c.acquireBlobContents(ctx, v); err != nil {
+				return err
+			}
+			if err := c.db.WithContext(ctx).Model(v).
+				Select("contents", "contents_ref").
+				Updates(v).Error; err != nil {
+				return err
+			}
+		}
+		if len(blobs) < blobMigrationBatchSize {
+			return nil
+		}
+	}
+}
+
+// moveContentsToBlobStore moves shared contents from the database to the blob store.
+func (c *Client) moveContentsToBlobStore(ctx context.Context) error {
+	if c.blobs == nil {
+		return nil
+	}
+	last := ""
+	for {
+		var contents []*models.BlobContents
+		if err := c.db.WithContext(ctx).
+			Where("key > ?", last).
+			Where("contents IS NOT NULL").
+			Order("key").
+			Limit(blobMigrationBatchSize).
+			Find(&contents).Error; err != nil {
+			return err
+		}
+		for _, v := range contents {
+			last = v.Key
+			if err := c.blobs.Put(ctx, v.Key, v.Contents); err != nil {
+				return errors.Wrapf(err, "store contents %s", v.Key)
+			}
+			if err := c.db.WithContext(ctx).Model(v).Update("contents", nil).Error; err != nil {
+				return err
+			}
+		}
+		if len(contents) < blobMigrationBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// ErrBlobNotFound is returned by a BlobStore for keys that it doesn't contain.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores the contents of spec revisions and artifacts outside of
+// the database. Contents are addressed by their BlobKey, so storing the same
+// contents twice stores them once.
+type BlobStore interface {
+	// Put stores contents under key, which must be BlobKey(contents).
+	Put(ctx context.Context, key string, contents []byte) error
+	// Get returns the contents stored under key or ErrBlobNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the contents stored under key, if any.
+	Delete(ctx context.Context, key string) error
+	// List calls fn with the key of each stored blob.
+	List(ctx context.Context, fn func(key string) error) error
+}
+
+// BlobKey returns the address of contents in a BlobStore, which is the
+// hex-encoded SHA-256 hash of the contents.
+func BlobKey(contents []byte) string {
+	h := sha256.Sum256(contents)
+	return hex.EncodeToString(h[:])
+}
+
+func validateBlobKey(key string) error {
+	if b, err := hex.DecodeString(key); err != nil || len(b) != sha256.Size {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
+
+// fileBlobStore stores blobs as files named by their keys in a directory tree.
+type fileBlobStore struct {
+	root string
+}
+
+// NewFileBlobStore returns a BlobStore that keeps blobs in files below root,
+// creating the directory if necessary.
+func NewFileBlobStore(root string) (BlobStore, error) {
+	if root == "" {
+		return nil, errors.New("blob store directory is required")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &fileBlobStore{root: root}, nil
+}
+
+// path spreads blobs over subdirectories named by the first byte of their keys.
+func (s *fileBlobStore) path(key string) (string, error) {
+	if err := validateBlobKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, key[:2], key), nil
+}
+
+func (s *fileBlobStore) Put(ctx context.Context, key string, contents []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil // blobs are immutable, so existing files are already correct
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	// Write to a temporary file and rename it so that readers never see partial blobs.
+	f, err := os.CreateTemp(dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(contents); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *fileBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrBlobNotFound
+	}
+	return b, err
+}
+
+func (s *fileBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileBlobStore) List(ctx context.Context, fn func(key string) error) error {
+	return filepath.WalkDir(s.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		key := d.Name()
+		// Skip temporary files and anything else that isn't a blob.
+		if !d.Type().IsRegular() || validateBlobKey(key) != nil || filepath.Base(filepath.Dir(path)) != key[:2] {
+			return nil
+		}
+		return fn(key)
+	})
+}
@@ -0,0 +1,289 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// S3Config configures a BlobStore that uses an S3-compatible object store.
+type S3Config struct {
+	// Endpoint is the URL of the service, like "https://s3.us-east-1.amazonaws.com"
+	// or "http://localhost:9000". Buckets are addressed by path.
+	Endpoint string
+	// Bucket that holds the blobs. It must already exist.
+	Bucket string
+	// Prefix is prepended to blob keys to form object names.
+	Prefix string
+	// Region used to sign requests. Default: "us-east-1".
+	Region string
+	// Credentials used to sign requests.
+	AccessKeyID     string
+	SecretAccessKey string
+	// Client sends requests. Default: a client with a 30 second timeout.
+	Client *http.Client
+}
+
+type s3BlobStore struct {
+	endpoint *url.URL
+	config   S3Config
+}
+
+// NewS3BlobStore returns a BlobStore that keeps blobs as objects in a bucket
+// of an S3-compatible object store such as Amazon S3 or MinIO.
+func NewS3BlobStore(config S3Config) (BlobStore, error) {
+	u, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid endpoint %q: must be an http or https URL", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &s3BlobStore{endpoint: u, config: config}, nil
+}
+
+func (s *s3BlobStore) url(key string) *url.URL {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.config.Bucket + "/" + s.config.Prefix + key
+	u.RawPath = ""
+	return &u
+}
+
+func (s *s3BlobStore) do(ctx context.Context, method, key string, body []byte) (*http.Response, error) {
+	if err := validateBlobKey(key); err != nil {
+		return nil, err
+	}
+	return s.send(ctx, method, s.url(key), body)
+}
+
+func (s *s3BlobStore) send(ctx context.Context, method string, u *url.URL, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		req.Body, req.GetBody = nil, nil
+	}
+	signS3Request(req, body, s.config.Region, s.config.AccessKeyID, s.config.SecretAccessKey, time.Now())
+	return s.config.Client.Do(req)
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key string, contents []byte) error {
+	if contents == nil {
+		contents = []byte{}
+	}
+	resp, err := s.do(ctx, http.MethodPut, key, contents)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s3Error(resp)
+	}
+	return nil
+}
+
+func (s *s3BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, ErrBlobNotFound
+	default:
+		return nil, s3Error(resp)
+	}
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s3Error(resp)
+	}
+}
+
+// s3ListResult is the response to a ListObjectsV2 request.
+type s3ListResult struct {
+	Contents []struct {
+		Key string
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+// List lists the objects in the bucket that have the prefix of the store,
+// one page at a time.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectsV2.html
+func (s *s3BlobStore) List(ctx context.Context, fn func(key string) error) error {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.config.Bucket
+	u.RawPath = ""
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {s.config.Prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		u.RawQuery = canonicalQuery(query)
+		page, err := s.listPage(ctx, &u)
+		if err != nil {
+			return err
+		}
+		for _, object := range page.Contents {
+			key := strings.TrimPrefix(object.Key, s.config.Prefix)
+			if validateBlobKey(key) != nil {
+				continue // not a blob
+			}
+			if err := fn(key); err != nil {
+				return err
+			}
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return nil
+		}
+		token = page.NextContinuationToken
+	}
+}
+
+func (s *s3BlobStore) listPage(ctx context.Context, u *url.URL) (*s3ListResult, error) {
+	resp, err := s.send(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3Error(resp)
+	}
+	page := new(s3ListResult)
+	if err := xml.NewDecoder(resp.Body).Decode(page); err != nil {
+		return nil, errors.Wrap(err, "s3: decode object list")
+	}
+	return page, nil
+}
+
+func s3Error(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3: %s %s: %s: %s", resp.Request.Method, resp.Request.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// signS3Request adds AWS Signature Version 4 headers to a request.
+// All headers already set on the request are signed.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func signS3Request(req *http.Request, body []byte, region, accessKeyID, secretAccessKey string, now time.Time) {
+	const service = "s3"
+	timestamp := now.UTC().Format("20060102T150405Z")
+	date := timestamp[:8]
+	payloadHash := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Date", timestamp)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var canonicalHeaders strings.Builder
+	for _, k := range keys {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(keys, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + timestamp + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, s3Escape(k)+"="+s3Escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3Escape percent-encodes all characters except unreserved ones, as required for signing.
+func s3Escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -16,7 +16,9 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 
 	_ "github.com/GoogleCloudPlatform/cloudsql-proxy/proxy/dialers/postgres"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
@@ -40,11 +42,59 @@ var entities = []interface{}{
 	&models.DeploymentRevisionTag{},
 	&models.Artifact{},
 	&models.Blob{},
+	&models.BlobContents{},
+	&models.SearchDocument{},
+	&models.Change{},
+	&models.NotificationCursor{},
+	&models.AuditEvent{},
+	&models.Snapshot{},
 }
 
 // Client represents a connection to a storage provider.
 type Client struct {
-	db *gorm.DB
+	db     *gorm.DB
+	writer *gorm.DB  // used for transactions and migrations; the same as db except for SQLite
+	blobs  BlobStore // holds blob contents if set; otherwise they are kept in the database
+}
+
+// sqliteReaders is the number of connections used for reading SQLite databases.
+const sqliteReaders = 8
+
+// Parameters for SQLite connections. Each connection enables foreign keys and
+// waits for locks held by other connections instead of failing immediately.
+const (
+	sqliteConnectionParams = "_foreign_keys=on&_busy_timeout=10000"
+	// Write transactions take the write lock when they begin so that they
+	// wait for other writers instead of failing when upgrading their lock.
+	sqliteWriterParams = sqliteConnectionParams + "&_journal_mode=WAL&_txlock=immediate"
+	sqliteReaderParams = sqliteConnectionParams + "&_query_only=on"
+)
+
+// openSQLite opens a pool of at most n connections to a SQLite database.
+// The connection parameters are added to the query parameters in dsn.
+func openSQLite(ctx context.Context, dsn, params string, n int) (*gorm.DB, error) {
+	if strings.Contains(dsn, "?") {
+		dsn += "&" + params
+	} else {
+		dsn += "?" + params
+	}
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger:      NewGormLogger(ctx),
+		PrepareStmt: true,
+	})
+	if err != nil {
+		return nil, grpcErrorForDBError(ctx, err)
+	}
+	if err := applyConnectionLimits(db, n); err != nil {
+		c := &Client{db: db}
+		c.close()
+		return nil, grpcErrorForDBError(ctx, err)
+	}
+	return db, nil
+}
+
+func isSQLiteMemory(dsn string) bool {
+	return strings.Contains(dsn, ":memory:") || strings.Contains(dsn, "mode=memory")
 }
 
 // NewClient creates a new database session using the provided driver and data source name.
@@ -55,28 +105,30 @@ type Client struct {
 func NewClient(ctx context.Context, driver, dsn string) (*Client, error) {
 	switch driver {
 	case "sqlite3":
-		db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
-			Logger:      NewGormLogger(ctx),
-			PrepareStmt: true,
-		})
+		// SQLite allows many readers but only one writer at a time. Reads use a pool
+		// of connections and writes use a single connection, which serializes write
+		// transactions. Connections to in-memory databases don't share data, so a
+		// single connection is used for both.
+		if isSQLiteMemory(dsn) {
+			db, err := openSQLite(ctx, dsn, sqliteConnectionParams, 1)
+			if err != nil {
+				return nil, err
+			}
+			return &Client{db: db, writer: db}, nil
+		}
+		// The writer is opened first so that the database is created and
+		// switched to WAL mode before readers connect.
+		writer, err := openSQLite(ctx, dsn, sqliteWriterParams, 1)
 		if err != nil {
-			c := &Client{db: db}
-			c.close()
-			return nil, grpcErrorForDBError(ctx, err)
+			return nil, err
 		}
-		// Sets to 1 to disallow multiple connections on SQLite.
-		// Any automatically opened connections, such a connection pool
-		// or SetConnMaxLifetime() will not apply post-connect
-		// PRAGMA commands (eg. "foreign_keys = ON").
-		if err := applyConnectionLimits(db, 1); err != nil {
-			c := &Client{db: db}
+		db, err := openSQLite(ctx, dsn, sqliteReaderParams, sqliteReaders)
+		if err != nil {
+			c := &Client{db: writer}
 			c.close()
-			return nil, grpcErrorForDBError(ctx, err)
-		}
-		if err := db.Exec("PRAGMA foreign_keys = ON").Error; err != nil {
-			return nil, grpcErrorForDBError(ctx, err)
+			return nil, err
 		}
-		return &Client{db: db}, nil
+		return &Client{db: db, writer: writer}, nil
 	case "postgres", "cloudsqlpostgres":
 		db, err := gorm.Open(postgres.New(postgres.Config{
 			DriverName: driver,
@@ -95,7 +147,7 @@ func NewClient(ctx context.Context, driver, dsn string) (*Client, error) {
 			c.close()
 			return nil, grpcErrorForDBError(ctx, err)
 		}
-		return &Client{db: db}, nil
+		return &Client{db: db, writer: db}, nil
 	default:
 		return nil, fmt.Errorf("unsupported database %s", driver)
 	}
@@ -112,6 +164,13 @@ func applyConnectionLimits(db *gorm.DB, n int) error {
 	return nil
 }
 
+// SetBlobStore configures the client to keep blob contents in a BlobStore.
+// Contents that are already in the database remain readable and are moved
+// to the store by Migrate.
+func (c *Client) SetBlobStore(s BlobStore) {
+	c.blobs = s
+}
+
 // Close closes a database session.
 func (c *Client) Close() {
 	c.close()
@@ -120,11 +179,15 @@ func (c *Client) Close() {
 func (c *Client) close() {
 	sqlDB, _ := c.db.DB()
 	sqlDB.Close()
+	if c.writer != nil && c.writer != c.db {
+		sqlDB, _ := c.writer.DB()
+		sqlDB.Close()
+	}
 }
 
 func (c *Client) ensureTable(ctx context.Context, v interface{}) error {
-	if !c.db.Migrator().HasTable(v) {
-		if err := c.db.Migrator().CreateTable(v); err != nil {
+	if !c.writer.Migrator().HasTable(v) {
+		if err := c.writer.Migrator().CreateTable(v); err != nil {
 			return grpcErrorForDBError(ctx, errors.Wrapf(err, "create table %#v", v))
 		}
 	}
@@ -138,19 +201,35 @@ func (c *Client) EnsureTables(ctx context.Context) error {
 			return err
 		}
 	}
+	if err := c.ensureSearchIndex(ctx); err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrap(err, "create search index"))
+	}
 	return nil
 }
 
 func (c *Client) Migrate(ctx context.Context) error {
-	if err := c.db.WithContext(ctx).AutoMigrate(entities...); err != nil {
+	w := &Client{db: c.writer, writer: c.writer, blobs: c.blobs}
+	if err := w.db.WithContext(ctx).AutoMigrate(entities...); err != nil {
+		return grpcErrorForDBError(ctx, err)
+	}
+
+	if err := w.ensureForeignKeys(ctx); err != nil {
 		return grpcErrorForDBError(ctx, err)
 	}
 
-	if err := c.ensureForeignKeys(ctx); err != nil {
+	if err := w.migrateArtifactsToRevisions(ctx); err != nil {
 		return grpcErrorForDBError(ctx, err)
 	}
 
-	if err := c.migrateArtifactsToRevisions(ctx); err != nil {
+	if err := w.migrateBlobContents(ctx); err != nil {
+		return grpcErrorForDBError(ctx, err)
+	}
+
+	if err := w.ensureSearchIndex(ctx); err != nil {
+		return grpcErrorForDBError(ctx, err)
+	}
+
+	if err := w.migrateSearchDocuments(ctx); err != nil {
 		return grpcErrorForDBError(ctx, err)
 	}
 
@@ -244,7 +323,7 @@ func (c *Client) TableNames(ctx context.Context) ([]string, error) {
 			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "tables"))
 		}
 	case "sqlite":
-		if err := c.db.WithContext(ctx).Table("sqlite_schema").Where("type = 'table' AND name NOT LIKE 'sqlite_%'").Order("name").Pluck("name", &tableNames).Error; err != nil {
+		if err := c.db.WithContext(ctx).Table("sqlite_schema").Where("type = 'table' AND name NOT LIKE 'sqlite_%' AND name NOT LIKE ?", searchIndexTable+"%").Order("name").Pluck("name", &tableNames).Error; err != nil {
 			return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "tables"))
 		}
 	default:
@@ -260,8 +339,22 @@ func (c *Client) RowCount(ctx context.Context, tableName string) (int64, error)
 }
 
 func (c *Client) Transaction(ctx context.Context, fn func(context.Context, *Client) error) error {
-	err := c.db.Transaction(func(tx *gorm.DB) error {
-		return fn(ctx, &Client{db: tx})
+	err := c.writer.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctx, &Client{db: tx, writer: tx, blobs: c.blobs})
 	})
 	return grpcErrorForDBError(ctx, err)
 }
+
+// ReadTransaction runs fn in a read-only transaction that sees a consistent
+// snapshot of the database. It doesn't block writers.
+func (c *Client) ReadTransaction(ctx context.Context, fn func(context.Context, *Client) error) error {
+	opts := &sql.TxOptions{ReadOnly: true}
+	if c.db.Name() == "postgres" {
+		// PostgreSQL's default isolation level takes a new snapshot for each statement.
+		opts.Isolation = sql.LevelRepeatableRead
+	}
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctx, &Client{db: tx, writer: tx, blobs: c.blobs})
+	}, opts)
+	return grpcErrorForDBError(ctx, err)
+}
@@ -17,38 +17,89 @@ package storage
 import (
 	"context"
 
+	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"github.com/pkg/errors"
 )
 
 func (c *Client) CreateProject(ctx context.Context, v *models.Project) error {
 	v.Key = v.Name()
+	// A deleted project with the same name is replaced.
+	if err := c.purgeTrash(ctx, projectSubtree(names.Project{ProjectID: v.ProjectID})); err != nil {
+		return err
+	}
 	return c.create(ctx, v)
 }
 
 func (c *Client) CreateApi(ctx context.Context, v *models.Api) error {
 	v.Key = v.Name()
-	return c.create(ctx, v)
+	// A deleted API with the same name is replaced.
+	if err := c.purgeTrash(ctx, apiSubtree(names.Api{ProjectID: v.ProjectID, ApiID: v.ApiID})); err != nil {
+		return err
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.indexApi(ctx, v)
 }
 
 func (c *Client) CreateVersion(ctx context.Context, v *models.Version) error {
 	v.Key = v.Name()
+	// A deleted version with the same name is replaced.
+	if err := c.purgeTrash(ctx, versionSubtree(names.Version{
+		ProjectID: v.ProjectID,
+		ApiID:     v.ApiID,
+		VersionID: v.VersionID,
+	})); err != nil {
+		return err
+	}
 	return c.create(ctx, v)
 }
 
 func (c *Client) CreateArtifact(ctx context.Context, v *models.Artifact) error {
 	v.Key = v.Name()
-	return c.create(ctx, v)
+	// A deleted artifact with the same name is replaced.
+	name, err := names.ParseArtifact(v.Name())
+	if err != nil {
+		return err
+	}
+	if err := c.purgeTrash(ctx, artifactSubtree(name)); err != nil {
+		return err
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.indexArtifact(ctx, v)
 }
 
 func (c *Client) CreateDeploymentRevision(ctx context.Context, v *models.Deployment) error {
 	v.Key = v.RevisionName()
+	// A deleted deployment with the same name is replaced.
+	if err := c.purgeTrash(ctx, deploymentSubtree(names.Deployment{
+		ProjectID:    v.ProjectID,
+		ApiID:        v.ApiID,
+		DeploymentID: v.DeploymentID,
+	})); err != nil {
+		return err
+	}
 	return c.create(ctx, v)
 }
 
 func (c *Client) CreateSpecRevision(ctx context.Context, v *models.Spec) error {
 	v.Key = v.RevisionName()
-	return c.create(ctx, v)
+	// A deleted spec with the same name is replaced.
+	if err := c.purgeTrash(ctx, specSubtree(names.Spec{
+		ProjectID: v.ProjectID,
+		ApiID:     v.ApiID,
+		VersionID: v.VersionID,
+		SpecID:    v.SpecID,
+	})); err != nil {
+		return err
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.indexSpec(ctx, v)
 }
 
 func (c *Client) create(ctx context.Context, v interface{}) error {
@@ -17,6 +17,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/apigee/registry/pkg/names"
 	"github.com/apigee/registry/server/registry/internal/storage/models"
@@ -44,156 +45,387 @@ func reason(counts []int64, tables []interface{}) string {
 	return r
 }
 
-func (c *Client) DeleteProject(ctx context.Context, name names.Project, cascade bool) error {
-	tables := []interface{}{
-		models.Project{},
-		models.Blob{},
-		models.Artifact{},
-	}
-	counts := make([]int64, len(tables))
-	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID)
-		if err := op.Delete(model).Error; err != nil {
+// deleteRows deletes the rows of a model that are selected by op,
+// releasing the contents of any blobs that are deleted.
+func (c *Client) deleteRows(ctx context.Context, op *gorm.DB, model interface{}) error {
+	if _, ok := model.(models.Blob); ok {
+		if err := c.releaseBlobContents(ctx, op.Session(&gorm.Session{})); err != nil {
 			return err
 		}
-		if _, ok := model.(models.Project); ok && op.RowsAffected == 0 {
-			return status.Errorf(codes.NotFound, "%q not found in database", name)
-		}
-		counts[i] = op.RowsAffected
 	}
+	return op.Delete(model).Error
+}
 
-	if sum(counts) > 1 && !cascade {
-		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode", name)
+// A subtree is a resource together with the rows that belong to it:
+// its child resources, revisions, tags, contents and search documents.
+type subtree struct {
+	name     string
+	root     interface{}   // model of the resource
+	children []interface{} // models of child resources, which block non-cascading deletes
+	tables   []interface{} // models of all rows in the subtree, including the root
+	where    func(op *gorm.DB) *gorm.DB
+}
+
+func projectSubtree(name names.Project) subtree {
+	return subtree{
+		name:     name.String(),
+		root:     models.Project{},
+		children: []interface{}{models.Api{}, models.Version{}, models.Spec{}, models.Deployment{}, models.Artifact{}},
+		tables: []interface{}{
+			models.Project{},
+			models.Api{},
+			models.Version{},
+			models.Spec{},
+			models.SpecRevisionTag{},
+			models.Deployment{},
+			models.DeploymentRevisionTag{},
+			models.Artifact{},
+			models.Blob{},
+			models.SearchDocument{},
+		},
+		where: func(op *gorm.DB) *gorm.DB {
+			return op.Where("project_id = ?", name.ProjectID)
+		},
 	}
+}
 
-	// Tags aren't API resources, so they do not block non-cascading deletes.
-	for _, model := range []interface{}{
-		models.DeploymentRevisionTag{},
-		models.SpecRevisionTag{},
-	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
+func apiSubtree(name names.Api) subtree {
+	return subtree{
+		name:     name.String(),
+		root:     models.Api{},
+		children: []interface{}{models.Version{}, models.Spec{}, models.Deployment{}, models.Artifact{}},
+		tables: []interface{}{
+			models.Api{},
+			models.Version{},
+			models.Spec{},
+			models.SpecRevisionTag{},
+			models.Deployment{},
+			models.DeploymentRevisionTag{},
+			models.Artifact{},
+			models.Blob{},
+			models.SearchDocument{},
+		},
+		where: func(op *gorm.DB) *gorm.DB {
+			return op.Where("project_id = ?", name.ProjectID).
+				Where("api_id = ?", name.ApiID)
+		},
 	}
+}
 
-	return nil
+func versionSubtree(name names.Version) subtree {
+	return subtree{
+		name:     name.String(),
+		root:     models.Version{},
+		children: []interface{}{models.Spec{}, models.Artifact{}},
+		tables: []interface{}{
+			models.Version{},
+			models.Spec{},
+			models.SpecRevisionTag{},
+			models.Artifact{},
+			models.Blob{},
+			models.SearchDocument{},
+		},
+		where: func(op *gorm.DB) *gorm.DB {
+			return op.Where("project_id = ?", name.ProjectID).
+				Where("api_id = ?", name.ApiID).
+				Where("version_id = ?", name.VersionID)
+		},
+	}
 }
 
-func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool) error {
-	tables := []interface{}{
-		models.Api{},
-		models.Blob{},
-		models.Artifact{},
+func specSubtree(name names.Spec) subtree {
+	return subtree{
+		name:     name.String(),
+		root:     models.Spec{},
+		children: []interface{}{models.Artifact{}},
+		tables: []interface{}{
+			models.Spec{},
+			models.SpecRevisionTag{},
+			models.Artifact{},
+			models.Blob{},
+			models.SearchDocument{},
+		},
+		where: func(op *gorm.DB) *gorm.DB {
+			return op.Where("project_id = ?", name.ProjectID).
+				Where("api_id = ?", name.ApiID).
+				Where("version_id = ?", name.VersionID).
+				Where("spec_id = ?", name.SpecID)
+		},
 	}
+}
 
-	counts := make([]int64, len(tables))
-	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
-		if _, ok := model.(models.Api); ok && op.RowsAffected == 0 {
-			return status.Errorf(codes.NotFound, "%q not found in database", name)
-		}
-		counts[i] = op.RowsAffected
+func deploymentSubtree(name names.Deployment) subtree {
+	return subtree{
+		name:     name.String(),
+		root:     models.Deployment{},
+		children: []interface{}{models.Artifact{}},
+		tables: []interface{}{
+			models.Deployment{},
+			models.DeploymentRevisionTag{},
+			models.Artifact{},
+			models.Blob{},
+			models.SearchDocument{},
+		},
+		where: func(op *gorm.DB) *gorm.DB {
+			return op.Where("project_id = ?", name.ProjectID).
+				Where("api_id = ?", name.ApiID).
+				Where("deployment_id = ?", name.DeploymentID)
+		},
 	}
+}
 
-	if sum(counts) > 1 && !cascade {
-		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode: %s", name, reason(counts, tables))
+func artifactSubtree(name names.Artifact) subtree {
+	return subtree{
+		name: name.String(),
+		root: models.Artifact{},
+		tables: []interface{}{
+			models.Artifact{},
+			models.Blob{},
+			models.SearchDocument{},
+		},
+		where: func(op *gorm.DB) *gorm.DB {
+			return op.Where("project_id = ?", name.ProjectID()).
+				Where("api_id = ?", name.ApiID()).
+				Where("version_id = ?", name.VersionID()).
+				Where("spec_id = ?", name.SpecID()).
+				Where("deployment_id = ?", name.DeploymentID()).
+				Where("artifact_id = ?", name.ArtifactID())
+		},
 	}
+}
 
-	for _, model := range []interface{}{
-		models.DeploymentRevisionTag{},
-		models.SpecRevisionTag{},
-	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
+// trashTables are the models of all rows that can be moved to the trash.
+var trashTables = projectSubtree(names.Project{}).tables
+
+// checkDelete verifies that the root of a subtree exists and that it has no
+// child resources unless they are to be deleted with it.
+func (c *Client) checkDelete(ctx context.Context, t subtree, cascade bool) error {
+	var count int64
+	if err := t.where(c.db.WithContext(ctx).Model(t.root)).Count(&count).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", t.name))
+	} else if count == 0 {
+		return status.Errorf(codes.NotFound, "%q not found in database", t.name)
 	}
 
+	counts := make([]int64, len(t.children))
+	for i, model := range t.children {
+		if err := t.where(c.db.WithContext(ctx).Model(model)).Count(&counts[i]).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", t.name))
+		}
+	}
+	if sum(counts) > 0 && !cascade {
+		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode: %s", t.name, reason(counts, t.children))
+	}
 	return nil
 }
 
-func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade bool) error {
-	tables := []interface{}{
-		models.Version{},
-		models.Blob{},
-		models.Artifact{},
-	}
-	counts := make([]int64, len(tables))
-	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID).
-			Where("version_id = ?", name.VersionID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
+// trash moves the rows of a subtree to the trash, where they are hidden
+// until they are restored or purged after they expire. Rows that are
+// already in the trash keep their own deletion and expiration times.
+func (c *Client) trash(ctx context.Context, t subtree, expire time.Time) error {
+	now := time.Now().UTC().Round(time.Microsecond)
+	for _, model := range t.tables {
+		op := t.where(c.db.WithContext(ctx).Model(model))
+		if err := op.Updates(map[string]interface{}{
+			"delete_time": now,
+			"expire_time": expire,
+		}).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", t.name))
 		}
-		if _, ok := model.(models.Version); ok && op.RowsAffected == 0 {
-			return status.Errorf(codes.NotFound, "%q not found in database", name)
+	}
+	return nil
+}
+
+// purge permanently deletes all rows of a subtree, including any in the trash.
+func (c *Client) purge(ctx context.Context, t subtree) error {
+	for _, model := range t.tables {
+		op := t.where(c.db.WithContext(ctx).Unscoped())
+		if err := c.deleteRows(ctx, op, model); err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", t.name))
 		}
-		counts[i] = op.RowsAffected
 	}
+	return nil
+}
 
-	if sum(counts) > 1 && !cascade {
-		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode: %s", name, reason(counts, tables))
+// purgeTrash permanently deletes a subtree if its root is in the trash,
+// which allows a new resource to be created with the name of a deleted one.
+func (c *Client) purgeTrash(ctx context.Context, t subtree) error {
+	var count int64
+	op := t.where(c.db.WithContext(ctx).Unscoped().Model(t.root)).Where("delete_time IS NOT NULL")
+	if err := op.Count(&count).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "purge %s", t.name))
+	} else if count == 0 {
+		return nil
 	}
+	return c.purge(ctx, t)
+}
 
-	for _, model := range []interface{}{
-		models.SpecRevisionTag{},
-	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID).
-			Where("version_id = ?", name.VersionID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
+// restore returns the rows of a subtree that were deleted with its root from the trash.
+func (c *Client) restore(ctx context.Context, t subtree) error {
+	var count int64
+	if err := t.where(c.db.WithContext(ctx).Model(t.root)).Count(&count).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "undelete %s", t.name))
+	} else if count > 0 {
+		return status.Errorf(codes.AlreadyExists, "%q is not deleted", t.name)
+	}
+
+	var deleted []time.Time
+	op := t.where(c.db.WithContext(ctx).Unscoped().Model(t.root)).
+		Where("delete_time IS NOT NULL").
+		Order("delete_time desc")
+	if err := op.Pluck("delete_time", &deleted).Error; err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "undelete %s", t.name))
+	} else if len(deleted) == 0 {
+		return status.Errorf(codes.NotFound, "%q not found in trash", t.name)
 	}
 
+	for _, model := range t.tables {
+		op := t.where(c.db.WithContext(ctx).Unscoped().Model(model)).
+			Where("delete_time = ?", deleted[0])
+		if err := op.Updates(map[string]interface{}{
+			"delete_time": nil,
+			"expire_time": nil,
+		}).Error; err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(err, "undelete %s", t.name))
+		}
+	}
 	return nil
 }
 
-func (c *Client) DeleteSpec(ctx context.Context, name names.Spec, cascade bool) error {
-	for _, model := range []interface{}{
-		models.Spec{},
-		models.SpecRevisionTag{},
-		models.Blob{},
-	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID).
-			Where("version_id = ?", name.VersionID).
-			Where("spec_id = ?", name.SpecID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
-		if _, ok := model.(models.Spec); ok && op.RowsAffected == 0 {
-			return status.Errorf(codes.NotFound, "%q not found in database", name)
-		}
+// DeleteProject moves a project and its child resources to the trash until expire.
+func (c *Client) DeleteProject(ctx context.Context, name names.Project, cascade bool, expire time.Time) error {
+	t := projectSubtree(name)
+	if err := c.checkDelete(ctx, t, cascade); err != nil {
+		return err
 	}
+	return c.trash(ctx, t, expire)
+}
 
-	tables := []interface{}{
-		models.Artifact{},
-		models.Blob{},
+// DeleteApi moves an API and its child resources to the trash until expire.
+func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool, expire time.Time) error {
+	t := apiSubtree(name)
+	if err := c.checkDelete(ctx, t, cascade); err != nil {
+		return err
 	}
-	counts := make([]int64, len(tables))
-	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID).
-			Where("version_id = ?", name.VersionID).
-			Where("spec_id = ?", name.SpecID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
-		counts[i] = op.RowsAffected
+	return c.trash(ctx, t, expire)
+}
+
+// DeleteVersion moves a version and its child resources to the trash until expire.
+func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade bool, expire time.Time) error {
+	t := versionSubtree(name)
+	if err := c.checkDelete(ctx, t, cascade); err != nil {
+		return err
 	}
+	return c.trash(ctx, t, expire)
+}
 
-	if sum(counts) > 0 && !cascade {
-		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode: %s", name, reason(counts, tables))
+// DeleteSpec moves a spec, its revisions and its child resources to the trash until expire.
+func (c *Client) DeleteSpec(ctx context.Context, name names.Spec, cascade bool, expire time.Time) error {
+	t := specSubtree(name)
+	if err := c.checkDelete(ctx, t, cascade); err != nil {
+		return err
+	}
+	return c.trash(ctx, t, expire)
+}
+
+// UndeleteProject restores a deleted project and the resources that were deleted with it.
+func (c *Client) UndeleteProject(ctx context.Context, name names.Project) error {
+	return c.restore(ctx, projectSubtree(name))
+}
+
+// UndeleteApi restores a deleted API and the resources that were deleted with it.
+// The project of the API must not be deleted.
+func (c *Client) UndeleteApi(ctx context.Context, name names.Api) error {
+	if _, err := c.GetProject(ctx, name.Project()); status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.FailedPrecondition, "cannot undelete %s: its parent is deleted", name)
+	} else if err != nil {
+		return err
+	}
+	return c.restore(ctx, apiSubtree(name))
+}
+
+// UndeleteVersion restores a deleted version and the resources that were deleted with it.
+// The API of the version must not be deleted.
+func (c *Client) UndeleteVersion(ctx context.Context, name names.Version) error {
+	if _, err := c.GetApi(ctx, name.Api()); status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.FailedPrecondition, "cannot undelete %s: its parent is deleted", name)
+	} else if err != nil {
+		return err
+	}
+	return c.restore(ctx, versionSubtree(name))
+}
+
+// UndeleteSpec restores a deleted spec and the resources that were deleted with it.
+// The version of the spec must not be deleted.
+func (c *Client) UndeleteSpec(ctx context.Context, name names.Spec) error {
+	if _, err := c.GetVersion(ctx, name.Version()); status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.FailedPrecondition, "cannot undelete %s: its parent is deleted", name)
+	} else if err != nil {
+		return err
+	}
+	return c.restore(ctx, specSubtree(name))
+}
+
+// UndeleteDeployment restores a deleted deployment and the resources that were deleted with it.
+// The API of the deployment must not be deleted.
+func (c *Client) UndeleteDeployment(ctx context.Context, name names.Deployment) error {
+	if _, err := c.GetApi(ctx, name.Api()); status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.FailedPrecondition, "cannot undelete %s: its parent is deleted", name)
+	} else if err != nil {
+		return err
+	}
+	return c.restore(ctx, deploymentSubtree(name))
+}
+
+// UndeleteArtifact restores a deleted artifact. The parent of the artifact must not be deleted.
+func (c *Client) UndeleteArtifact(ctx context.Context, name names.Artifact) error {
+	if err := c.checkArtifactParent(ctx, name); status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.FailedPrecondition, "cannot undelete %s: its parent is deleted", name)
+	} else if err != nil {
+		return err
+	}
+	return c.restore(ctx, artifactSubtree(name))
+}
+
+// checkArtifactParent returns NotFound if the parent of an artifact doesn't exist.
+func (c *Client) checkArtifactParent(ctx context.Context, name names.Artifact) error {
+	var err error
+	switch {
+	case name.DeploymentID() != "":
+		_, err = c.GetDeployment(ctx, names.Deployment{
+			ProjectID:    name.ProjectID(),
+			ApiID:        name.ApiID(),
+			DeploymentID: name.DeploymentID(),
+		})
+	case name.SpecID() != "":
+		_, err = c.GetSpec(ctx, names.Spec{
+			ProjectID: name.ProjectID(),
+			ApiID:     name.ApiID(),
+			VersionID: name.VersionID(),
+			SpecID:    name.SpecID(),
+		})
+	case name.VersionID() != "":
+		_, err = c.GetVersion(ctx, names.Version{
+			ProjectID: name.ProjectID(),
+			ApiID:     name.ApiID(),
+			VersionID: name.VersionID(),
+		})
+	case name.ApiID() != "":
+		_, err = c.GetApi(ctx, names.Api{ProjectID: name.ProjectID(), ApiID: name.ApiID()})
+	default:
+		_, err = c.GetProject(ctx, names.Project{ProjectID: name.ProjectID()})
 	}
+	return err
+}
 
+// PurgeExpiredResources permanently deletes resources in the trash that expired before a time.
+func (c *Client) PurgeExpiredResources(ctx context.Context, expired time.Time) error {
+	for _, model := range trashTables {
+		op := c.db.WithContext(ctx).Unscoped().Where("expire_time < ?", expired)
+		if err := c.deleteRows(ctx, op, model); err != nil {
+			return grpcErrorForDBError(ctx, errors.Wrap(err, "purge expired resources"))
+		}
+	}
 	return nil
 }
 
@@ -207,7 +439,8 @@ func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision
 		models.Spec{},
 		models.SpecRevisionTag{},
 	} {
-		op := c.db.WithContext(ctx).
+		// Revisions are deleted permanently.
+		op := c.db.WithContext(ctx).Unscoped().
 			Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("version_id = ?", name.VersionID).
@@ -221,8 +454,13 @@ func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision
 		}
 	}
 
+	op := c.db.WithContext(ctx).Unscoped().Where("key = ?", name.String())
+	if err := c.deleteRows(ctx, op, models.Blob{}); err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
+	}
+
 	// if we deleted the last revision, return an error to cancel the transaction
-	op := c.db.WithContext(ctx).
+	op = c.db.WithContext(ctx).
 		Where("project_id = ?", name.ProjectID).
 		Where("api_id = ?", name.ApiID).
 		Where("version_id = ?", name.VersionID).
@@ -235,46 +473,17 @@ func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision
 		return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
 	}
 
-	return nil
+	// the spec is searchable by the contents of its latest remaining revision
+	return c.reindexSpec(ctx, v)
 }
 
-func (c *Client) DeleteDeployment(ctx context.Context, name names.Deployment, cascade bool) error {
-	for _, model := range []interface{}{
-		models.Deployment{},
-		models.DeploymentRevisionTag{},
-	} {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID).
-			Where("deployment_id = ?", name.DeploymentID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
-		if _, ok := model.(models.Deployment); ok && op.RowsAffected == 0 {
-			return status.Errorf(codes.NotFound, "%q not found in database", name)
-		}
-	}
-
-	tables := []interface{}{
-		models.Artifact{},
-		models.Blob{},
-	}
-	counts := make([]int64, len(tables))
-	for i, model := range tables {
-		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID).
-			Where("deployment_id = ?", name.DeploymentID)
-		if err := op.Delete(model).Error; err != nil {
-			return err
-		}
-
-		counts[i] += op.RowsAffected
-	}
-
-	if sum(counts) > 0 && !cascade {
-		return status.Errorf(codes.FailedPrecondition, "cannot delete child resources of %s in non-cascading mode: %s", name, reason(counts, tables))
+// DeleteDeployment moves a deployment, its revisions and its child resources to the trash until expire.
+func (c *Client) DeleteDeployment(ctx context.Context, name names.Deployment, cascade bool, expire time.Time) error {
+	t := deploymentSubtree(name)
+	if err := c.checkDelete(ctx, t, cascade); err != nil {
+		return err
 	}
-
-	return nil
+	return c.trash(ctx, t, expire)
 }
 
 func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.DeploymentRevision) error {
@@ -287,7 +496,8 @@ func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.Deploy
 		models.Deployment{},
 		models.DeploymentRevisionTag{},
 	} {
-		op := c.db.WithContext(ctx).
+		// Revisions are deleted permanently.
+		op := c.db.WithContext(ctx).Unscoped().
 			Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("deployment_id = ?", name.DeploymentID).
@@ -316,25 +526,11 @@ func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.Deploy
 	return nil
 }
 
-func (c *Client) DeleteArtifact(ctx context.Context, name names.Artifact) error {
-	for _, model := range []interface{}{
-		models.Artifact{},
-		models.Blob{},
-	} {
-		op := c.db.WithContext(ctx).
-			Where("project_id = ?", name.ProjectID()).
-			Where("api_id = ?", name.ApiID()).
-			Where("version_id = ?", name.VersionID()).
-			Where("spec_id = ?", name.SpecID()).
-			Where("deployment_id = ?", name.DeploymentID()).
-			Where("artifact_id = ?", name.ArtifactID())
-		if err := op.Delete(model).Error; err != nil {
-			return grpcErrorForDBError(ctx, errors.Wrapf(err, "delete %s", name))
-		}
-		if _, ok := model.(models.Artifact); ok && op.RowsAffected == 0 {
-			return status.Errorf(codes.NotFound, "%q not found in database", name)
-		}
+// DeleteArtifact moves an artifact to the trash until expire.
+func (c *Client) DeleteArtifact(ctx context.Context, name names.Artifact, expire time.Time) error {
+	t := artifactSubtree(name)
+	if err := c.checkDelete(ctx, t, false); err != nil {
+		return err
 	}
-
-	return nil
+	return c.trash(ctx, t, expire)
 }
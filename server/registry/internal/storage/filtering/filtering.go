@@ -35,6 +35,8 @@ const (
 
 type Filter struct {
 	program cel.Program
+	expr    *exprpb.Expr
+	fields  map[string]FieldType
 }
 
 func (f *Filter) Matches(model map[string]interface{}) (bool, error) {
@@ -91,5 +93,5 @@ func NewFilter(filter string, fields map[string]FieldType) (Filter, error) {
 		return Filter{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return Filter{program: prg}, nil
+	return Filter{program: prg, expr: ast.Expr(), fields: fields}, nil
 }
@@ -0,0 +1,411 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/overloads"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// Dialect identifies the SQL dialect that a filter is translated into.
+// Values match the names of the corresponding gorm dialectors.
+type Dialect string
+
+const (
+	SQLite   Dialect = "sqlite"
+	Postgres Dialect = "postgres"
+)
+
+// Clause is a SQL condition derived from a filter expression.
+type Clause struct {
+	// Query is a condition that can be passed to gorm's Where.
+	Query string
+	// Args are the values bound to the placeholders in Query.
+	Args []interface{}
+	// Exact is true if Query selects exactly the rows matched by the filter.
+	// Otherwise Query selects a superset of them and each row must also be
+	// checked with Matches.
+	Exact bool
+}
+
+// SQL translates the filter into a condition that can be evaluated by the database.
+// The supported subset includes comparisons of fields with literal values (including
+// timestamp literals), startsWith and contains, label lookups, and combinations of
+// these with &&, || and !. Parts of the expression outside of this subset are left
+// to Matches, so the returned clause always selects a superset of the matching rows.
+//
+// The columns map associates field names with the database columns that store them.
+// Fields without a column are never translated. The boolean result is false if no
+// useful condition could be produced.
+func (f *Filter) SQL(dialect Dialect, columns map[string]string) (Clause, bool) {
+	if f.expr == nil {
+		return Clause{}, false
+	}
+	if dialect != SQLite && dialect != Postgres {
+		return Clause{}, false
+	}
+
+	t := &translator{dialect: dialect, fields: f.fields, columns: columns}
+	c, ok := t.translate(f.expr)
+	return c, ok
+}
+
+type translator struct {
+	dialect Dialect
+	fields  map[string]FieldType
+	columns map[string]string
+}
+
+func (t *translator) translate(e *exprpb.Expr) (Clause, bool) {
+	switch e.ExprKind.(type) {
+	case *exprpb.Expr_CallExpr:
+		return t.translateCall(e.GetCallExpr())
+	case *exprpb.Expr_SelectExpr:
+		// has(labels.key)
+		s := e.GetSelectExpr()
+		if !s.GetTestOnly() {
+			return Clause{}, false
+		}
+		column, ok := t.column(s.GetOperand(), StringMap)
+		if !ok {
+			return Clause{}, false
+		}
+		return t.labelClause(column, labelKeyPattern(s.GetField())), true
+	default:
+		return Clause{}, false
+	}
+}
+
+func (t *translator) translateCall(call *exprpb.Expr_Call) (Clause, bool) {
+	args := call.GetArgs()
+	switch call.GetFunction() {
+	case operators.LogicalAnd:
+		l, lok := t.translate(args[0])
+		r, rok := t.translate(args[1])
+		switch {
+		case lok && rok:
+			return Clause{
+				Query: fmt.Sprintf("(%s AND %s)", l.Query, r.Query),
+				Args:  append(l.Args, r.Args...),
+				Exact: l.Exact && r.Exact,
+			}, true
+		case lok:
+			// The right side is still checked by Matches.
+			l.Exact = false
+			return l, true
+		case rok:
+			r.Exact = false
+			return r, true
+		}
+		return Clause{}, false
+
+	case operators.LogicalOr:
+		l, lok := t.translate(args[0])
+		r, rok := t.translate(args[1])
+		if !lok || !rok {
+			// A row could match through the untranslated side, so nothing can be excluded.
+			return Clause{}, false
+		}
+		return Clause{
+			Query: fmt.Sprintf("(%s OR %s)", l.Query, r.Query),
+			Args:  append(l.Args, r.Args...),
+			Exact: l.Exact && r.Exact,
+		}, true
+
+	case operators.LogicalNot:
+		// Negating a superset would exclude matching rows, so only exact clauses are negated.
+		c, ok := t.translate(args[0])
+		if !ok || !c.Exact {
+			return Clause{}, false
+		}
+		c.Query = fmt.Sprintf("NOT %s", c.Query)
+		return c, true
+
+	case operators.Equals, operators.NotEquals,
+		operators.Less, operators.LessEquals,
+		operators.Greater, operators.GreaterEquals:
+		return t.translateComparison(call.GetFunction(), args[0], args[1])
+
+	case operators.In:
+		// "key" in labels
+		key, ok := stringConstant(args[0])
+		if !ok {
+			return Clause{}, false
+		}
+		column, ok := t.column(args[1], StringMap)
+		if !ok {
+			return Clause{}, false
+		}
+		return t.labelClause(column, labelKeyPattern(key)), true
+
+	case overloads.StartsWith:
+		column, ok := t.column(call.GetTarget(), String)
+		if !ok || len(args) != 1 {
+			return Clause{}, false
+		}
+		prefix, ok := stringConstant(args[0])
+		if !ok {
+			return Clause{}, false
+		}
+		// substr counts characters in both dialects and, unlike LIKE, is case-sensitive.
+		return Clause{
+			Query: fmt.Sprintf("substr(%s, 1, ?) = ?", column),
+			Args:  []interface{}{utf8.RuneCountInString(prefix), prefix},
+			Exact: true,
+		}, true
+
+	case overloads.Contains:
+		column, ok := t.column(call.GetTarget(), String)
+		if !ok || len(args) != 1 {
+			return Clause{}, false
+		}
+		substr, ok := stringConstant(args[0])
+		if !ok {
+			return Clause{}, false
+		}
+		fn := "instr(%s, ?) > 0"
+		if t.dialect == Postgres {
+			fn = "strpos(%s, ?) > 0"
+		}
+		return Clause{
+			Query: fmt.Sprintf(fn, column),
+			Args:  []interface{}{substr},
+			Exact: true,
+		}, true
+	}
+
+	return Clause{}, false
+}
+
+var sqlOperators = map[string]string{
+	operators.Equals:        "=",
+	operators.NotEquals:     "<>",
+	operators.Less:          "<",
+	operators.LessEquals:    "<=",
+	operators.Greater:       ">",
+	operators.GreaterEquals: ">=",
+}
+
+// reversed maps each comparison to the one that is equivalent when its operands are swapped.
+var reversed = map[string]string{
+	operators.Equals:        operators.Equals,
+	operators.NotEquals:     operators.NotEquals,
+	operators.Less:          operators.Greater,
+	operators.LessEquals:    operators.GreaterEquals,
+	operators.Greater:       operators.Less,
+	operators.GreaterEquals: operators.LessEquals,
+}
+
+func (t *translator) translateComparison(op string, lhs, rhs *exprpb.Expr) (Clause, bool) {
+	if c, ok := t.translateFieldComparison(op, lhs, rhs); ok {
+		return c, true
+	}
+	return t.translateFieldComparison(reversed[op], rhs, lhs)
+}
+
+// translateFieldComparison translates comparisons of the form `field op literal`.
+func (t *translator) translateFieldComparison(op string, field, value *exprpb.Expr) (Clause, bool) {
+	// labels["key"] == "value" or labels.key == "value"
+	if m, key, ok := mapLookup(field); ok {
+		if op != operators.Equals {
+			return Clause{}, false
+		}
+		column, ok := t.column(m, StringMap)
+		if !ok {
+			return Clause{}, false
+		}
+		v, ok := stringConstant(value)
+		if !ok {
+			return Clause{}, false
+		}
+		return t.labelValueClause(column, key, v), true
+	}
+
+	name := field.GetIdentExpr().GetName()
+	column, ok := t.columns[name]
+	if !ok || column == "" {
+		return Clause{}, false
+	}
+
+	switch t.fields[name] {
+	case String:
+		v, ok := stringConstant(value)
+		if !ok {
+			return Clause{}, false
+		}
+		if t.dialect == Postgres && op != operators.Equals && op != operators.NotEquals {
+			// Order strings by their bytes, as CEL does, rather than by the database collation.
+			column += ` COLLATE "C"`
+		}
+		return Clause{
+			Query: fmt.Sprintf("%s %s ?", column, sqlOperators[op]),
+			Args:  []interface{}{v},
+			Exact: true,
+		}, true
+
+	case Int:
+		c := value.GetConstExpr()
+		if c == nil {
+			return Clause{}, false
+		}
+		v, ok := c.ConstantKind.(*exprpb.Constant_Int64Value)
+		if !ok {
+			return Clause{}, false
+		}
+		return Clause{
+			Query: fmt.Sprintf("%s %s ?", column, sqlOperators[op]),
+			Args:  []interface{}{v.Int64Value},
+			Exact: true,
+		}, true
+
+	case Timestamp:
+		v, ok := timestampConstant(value)
+		if !ok {
+			return Clause{}, false
+		}
+		if t.dialect == Postgres {
+			return Clause{
+				Query: fmt.Sprintf("%s %s ?", column, sqlOperators[op]),
+				Args:  []interface{}{v},
+				Exact: true,
+			}, true
+		}
+		// SQLite stores timestamps as text that may use different zone offsets,
+		// so they are compared with julianday(), which normalizes them but only
+		// keeps millisecond precision. Strict comparisons are relaxed to keep
+		// the clause a superset and the exact check is left to Matches.
+		switch op {
+		case operators.Less:
+			op = operators.LessEquals
+		case operators.Greater:
+			op = operators.GreaterEquals
+		case operators.NotEquals:
+			return Clause{}, false
+		}
+		return Clause{
+			Query: fmt.Sprintf("julianday(%s) %s julianday(?)", column, sqlOperators[op]),
+			Args:  []interface{}{v},
+			Exact: false,
+		}, true
+	}
+
+	return Clause{}, false
+}
+
+// column returns the column storing the field referenced by e if the field has the expected type.
+func (t *translator) column(e *exprpb.Expr, fieldType FieldType) (string, bool) {
+	name := e.GetIdentExpr().GetName()
+	if name == "" || t.fields[name] != fieldType {
+		return "", false
+	}
+	column, ok := t.columns[name]
+	return column, ok && column != ""
+}
+
+// labelClause matches rows where the serialized labels in column contain pattern.
+// Labels are stored as serialized rpc.Map messages, so this only narrows the rows
+// that Matches must check.
+func (t *translator) labelClause(column string, pattern []byte) Clause {
+	return Clause{
+		Query: t.containsBytes(column) + " > 0",
+		Args:  []interface{}{pattern},
+		Exact: false,
+	}
+}
+
+// labelValueClause matches rows where the labels in column map key to value.
+// Looking up a missing key is an error, so rows without the key are also
+// selected to let Matches report it.
+func (t *translator) labelValueClause(column, key, value string) Clause {
+	return Clause{
+		Query: fmt.Sprintf("(%s IS NULL OR %s > 0 OR %s = 0)", column, t.containsBytes(column), t.containsBytes(column)),
+		Args:  []interface{}{labelEntryPattern(key, value), labelKeyPattern(key)},
+		Exact: false,
+	}
+}
+
+// containsBytes returns an expression for the position of a bound byte string in column.
+func (t *translator) containsBytes(column string) string {
+	if t.dialect == Postgres {
+		return fmt.Sprintf("position(? in %s)", column)
+	}
+	return fmt.Sprintf("instr(%s, ?)", column)
+}
+
+// labelKeyPattern returns the bytes that begin the serialized map entry for key.
+func labelKeyPattern(key string) []byte {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendString(b, key)
+	return protowire.AppendTag(b, 2, protowire.BytesType)
+}
+
+// labelEntryPattern returns the serialized map entry for key and value.
+func labelEntryPattern(key, value string) []byte {
+	entry := protowire.AppendTag(nil, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, key)
+	entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+	entry = protowire.AppendString(entry, value)
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	return protowire.AppendBytes(b, entry)
+}
+
+// mapLookup returns the map and key of expressions like `m["key"]` and `m.key`.
+func mapLookup(e *exprpb.Expr) (*exprpb.Expr, string, bool) {
+	if s := e.GetSelectExpr(); s != nil && !s.GetTestOnly() {
+		return s.GetOperand(), s.GetField(), true
+	}
+	if call := e.GetCallExpr(); call != nil && call.GetFunction() == operators.Index && len(call.GetArgs()) == 2 {
+		key, ok := stringConstant(call.GetArgs()[1])
+		return call.GetArgs()[0], key, ok
+	}
+	return nil, "", false
+}
+
+func stringConstant(e *exprpb.Expr) (string, bool) {
+	c := e.GetConstExpr()
+	if c == nil {
+		return "", false
+	}
+	v, ok := c.ConstantKind.(*exprpb.Constant_StringValue)
+	if !ok {
+		return "", false
+	}
+	return v.StringValue, true
+}
+
+// timestampConstant returns the value of a `timestamp("...")` literal.
+func timestampConstant(e *exprpb.Expr) (time.Time, bool) {
+	call := e.GetCallExpr()
+	if call == nil || call.GetFunction() != overloads.TypeConvertTimestamp || call.GetTarget() != nil || len(call.GetArgs()) != 1 {
+		return time.Time{}, false
+	}
+	s, ok := stringConstant(call.GetArgs()[0])
+	if !ok {
+		return time.Time{}, false
+	}
+	// This is the layout accepted by CEL's timestamp conversion.
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return v, true
+}
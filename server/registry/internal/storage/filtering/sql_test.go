@@ -0,0 +1,223 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+)
+
+var sqlTestFields = map[string]FieldType{
+	"name":        String,
+	"description": String,
+	"size_bytes":  Int,
+	"create_time": Timestamp,
+	"labels":      StringMap,
+	"filename":    String,
+}
+
+var sqlTestColumns = map[string]string{
+	"name":        "t.key",
+	"description": "t.description",
+	"size_bytes":  "t.size_in_bytes",
+	"create_time": "t.create_time",
+	"labels":      "t.labels",
+}
+
+func TestFilter_SQL(t *testing.T) {
+	ts := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		desc    string
+		filter  string
+		dialect Dialect
+		want    Clause
+	}{
+		{
+			desc:    "equal to String",
+			filter:  `name == "a"`,
+			dialect: SQLite,
+			want:    Clause{Query: "t.key = ?", Args: []interface{}{"a"}, Exact: true},
+		},
+		{
+			desc:    "reversed operands",
+			filter:  `"a" < name`,
+			dialect: SQLite,
+			want:    Clause{Query: "t.key > ?", Args: []interface{}{"a"}, Exact: true},
+		},
+		{
+			desc:    "String ordering in postgres",
+			filter:  `name >= "a"`,
+			dialect: Postgres,
+			want:    Clause{Query: `t.key COLLATE "C" >= ?`, Args: []interface{}{"a"}, Exact: true},
+		},
+		{
+			desc:    "not equal to Int",
+			filter:  `size_bytes != 10`,
+			dialect: SQLite,
+			want:    Clause{Query: "t.size_in_bytes <> ?", Args: []interface{}{int64(10)}, Exact: true},
+		},
+		{
+			desc:    "startsWith",
+			filter:  `name.startsWith("projects/ä")`,
+			dialect: SQLite,
+			want:    Clause{Query: "substr(t.key, 1, ?) = ?", Args: []interface{}{10, "projects/ä"}, Exact: true},
+		},
+		{
+			desc:    "contains in sqlite",
+			filter:  `description.contains("x")`,
+			dialect: SQLite,
+			want:    Clause{Query: "instr(t.description, ?) > 0", Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:    "contains in postgres",
+			filter:  `description.contains("x")`,
+			dialect: Postgres,
+			want:    Clause{Query: "strpos(t.description, ?) > 0", Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:    "Timestamp in postgres",
+			filter:  `create_time < timestamp("2021-01-01T00:00:00Z")`,
+			dialect: Postgres,
+			want:    Clause{Query: "t.create_time < ?", Args: []interface{}{ts}, Exact: true},
+		},
+		{
+			desc:    "Timestamp in sqlite",
+			filter:  `create_time < timestamp("2021-01-01T00:00:00Z")`,
+			dialect: SQLite,
+			want:    Clause{Query: "julianday(t.create_time) <= julianday(?)", Args: []interface{}{ts}},
+		},
+		{
+			desc:    "and",
+			filter:  `name == "a" && size_bytes > 1`,
+			dialect: SQLite,
+			want:    Clause{Query: "(t.key = ? AND t.size_in_bytes > ?)", Args: []interface{}{"a", int64(1)}, Exact: true},
+		},
+		{
+			desc:    "and with untranslated side",
+			filter:  `name == "a" && name.endsWith("b")`,
+			dialect: SQLite,
+			want:    Clause{Query: "t.key = ?", Args: []interface{}{"a"}},
+		},
+		{
+			desc:    "or",
+			filter:  `name == "a" || name == "b"`,
+			dialect: SQLite,
+			want:    Clause{Query: "(t.key = ? OR t.key = ?)", Args: []interface{}{"a", "b"}, Exact: true},
+		},
+		{
+			desc:    "not",
+			filter:  `!(name == "a")`,
+			dialect: SQLite,
+			want:    Clause{Query: "NOT t.key = ?", Args: []interface{}{"a"}, Exact: true},
+		},
+		{
+			desc:    "label value",
+			filter:  `labels["k"] == "v"`,
+			dialect: SQLite,
+			want: Clause{
+				Query: "(t.labels IS NULL OR instr(t.labels, ?) > 0 OR instr(t.labels, ?) = 0)",
+				Args:  []interface{}{[]byte("\x0a\x06\x0a\x01k\x12\x01v"), []byte("\x0a\x01k\x12")},
+			},
+		},
+		{
+			desc:    "label key in postgres",
+			filter:  `"k" in labels`,
+			dialect: Postgres,
+			want:    Clause{Query: "position(? in t.labels) > 0", Args: []interface{}{[]byte("\x0a\x01k\x12")}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			f, err := NewFilter(test.filter, sqlTestFields)
+			if err != nil {
+				t.Fatalf("NewFilter(%q) returned error: %s", test.filter, err)
+			}
+			got, ok := f.SQL(test.dialect, sqlTestColumns)
+			if !ok {
+				t.Fatalf("NewFilter(%q).SQL() was not translated", test.filter)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("NewFilter(%q).SQL() returned unexpected diff: (-want +got):\n%s", test.filter, diff)
+			}
+		})
+	}
+}
+
+func TestFilter_SQLNotTranslated(t *testing.T) {
+	tests := []struct {
+		desc   string
+		filter string
+	}{
+		{desc: "empty", filter: ``},
+		{desc: "field without column", filter: `filename == "a"`},
+		{desc: "unsupported function", filter: `name.endsWith("a")`},
+		{desc: "or with untranslated side", filter: `name == "a" || name.endsWith("b")`},
+		{desc: "not of inexact clause", filter: `!("k" in labels)`},
+		{desc: "comparison of fields", filter: `name == description`},
+		{desc: "label inequality", filter: `labels.k != "v"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			f, err := NewFilter(test.filter, sqlTestFields)
+			if err != nil {
+				t.Fatalf("NewFilter(%q) returned error: %s", test.filter, err)
+			}
+			if got, ok := f.SQL(SQLite, sqlTestColumns); ok {
+				t.Errorf("NewFilter(%q).SQL() returned unexpected clause %+v", test.filter, got)
+			}
+		})
+	}
+}
+
+// Label clauses depend on how labels are stored, so check the patterns against serialized maps.
+func TestLabelPatterns(t *testing.T) {
+	b, err := proto.Marshal(&rpc.Map{Entries: map[string]string{
+		"a":    "1",
+		"k":    "v",
+		"long": strings.Repeat("x", 200),
+	}})
+	if err != nil {
+		t.Fatalf("Setup: failed to marshal labels: %s", err)
+	}
+
+	for _, p := range [][]byte{
+		labelKeyPattern("k"),
+		labelKeyPattern("long"),
+		labelEntryPattern("k", "v"),
+		labelEntryPattern("long", strings.Repeat("x", 200)),
+	} {
+		if !bytes.Contains(b, p) {
+			t.Errorf("serialized labels %q do not contain pattern %q", b, p)
+		}
+	}
+
+	for _, p := range [][]byte{
+		labelKeyPattern("v"),
+		labelEntryPattern("k", "1"),
+		labelEntryPattern("a", ""),
+	} {
+		if bytes.Contains(b, p) {
+			t.Errorf("serialized labels %q unexpectedly contain pattern %q", b, p)
+		}
+	}
+}
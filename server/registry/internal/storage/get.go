@@ -112,6 +112,10 @@ func (c *Client) GetSpecRevisionContents(ctx context.Context, name names.SpecRev
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
 	}
 
+	if err := c.loadBlobContents(ctx, v); err != nil {
+		return nil, err
+	}
+
 	return v, nil
 }
 
@@ -239,5 +243,9 @@ func (c *Client) GetArtifactContents(ctx context.Context, name names.Artifact) (
 		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
 	}
 
+	if err := c.loadBlobContents(ctx, v); err != nil {
+		return nil, err
+	}
+
 	return v, nil
 }
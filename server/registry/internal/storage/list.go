@@ -150,6 +150,46 @@ var revisionedArtifactFields = map[string]filtering.FieldType{
 	"revision_update_time": filtering.Timestamp,
 }
 
+// Columns used to evaluate filters in the database. Names of spec and deployment
+// revisions aren't stored directly and artifact labels aren't available to filters,
+// so these fields are only evaluated in memory.
+var (
+	projectColumns    = filterColumns("projects", projectFields, map[string]string{"name": "key"})
+	apiColumns        = filterColumns("apis", apiFields, map[string]string{"name": "key"})
+	versionColumns    = filterColumns("versions", versionFields, map[string]string{"name": "key"})
+	specColumns       = filterColumns("specs", specFields, map[string]string{"name": "", "filename": "file_name", "size_bytes": "size_in_bytes"})
+	deploymentColumns = filterColumns("deployments", deploymentFields, map[string]string{"name": ""})
+	artifactColumns   = filterColumns("artifacts", artifactFields, map[string]string{"name": "key", "size_bytes": "size_in_bytes", "labels": ""})
+)
+
+// filterColumns returns the qualified columns that store each filter field of a table.
+// Fields are stored in columns with the same name unless renamed. Fields renamed to ""
+// have no column.
+func filterColumns(table string, fields map[string]filtering.FieldType, renames map[string]string) map[string]string {
+	columns := make(map[string]string, len(fields))
+	for field := range fields {
+		column, ok := renames[field]
+		if !ok {
+			column = field
+		}
+		if column != "" {
+			columns[field] = table + "." + column
+		}
+	}
+	return columns
+}
+
+// where adds the parts of a filter that can be evaluated by the database to a query.
+// It returns true if the database evaluates the filter exactly. Rows returned by the
+// query must still be checked with filter.Matches.
+func (c *Client) where(op *gorm.DB, filter filtering.Filter, columns map[string]string) (*gorm.DB, bool) {
+	clause, ok := filter.SQL(filtering.Dialect(c.db.Dialector.Name()), columns)
+	if !ok {
+		return op, false
+	}
+	return op.Where(clause.Query, clause.Args...), clause.Exact
+}
+
 // gormOrdering accepts a user-specified order_by string and returns a gorm-compatible equivalent.
 // For example, the user-specified string `name,description` returns `key,description`.
 // An error is returned if the string is invalid or refers to a field that isn't included in the `fields` map.
@@ -203,10 +243,11 @@ func gormOrdering(ordering, table string) (string, error) {
 }
 
 // limit returns the database page size to use for a listing request.
-func limit(opts PageOptions) int {
-	// Without filters, read exactly enough rows to fill the page,
-	// plus an extra row to check if another page exists.
-	if opts.Filter == "" {
+// Exact is true if any filter is evaluated exactly by the database.
+func limit(opts PageOptions, exact bool) int {
+	// Without filters (or with filters applied by the database), read exactly
+	// enough rows to fill the page, plus an extra row to check if another page exists.
+	if opts.Filter == "" || exact {
 		return int(opts.Size) + 1
 	}
 
@@ -252,9 +293,15 @@ func (c *Client) ListProjects(ctx context.Context, opts PageOptions) (ProjectLis
 		Projects: make([]models.Project, 0, opts.Size),
 	}
 
+	op := c.db.WithContext(ctx)
+	if opts.ShowDeleted {
+		op = op.Unscoped()
+	}
+	op, exact := c.where(op, filter, projectColumns)
+	op = op.Order(order).Limit(limit(opts, exact))
+
 	for {
 		var page []models.Project
-		op := c.db.WithContext(ctx).Order(order).Limit(limit(opts))
 		err := op.Offset(token.Offset).Find(&page).Error
 
 		if err != nil {
@@ -326,8 +373,10 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 		token.Order = opts.Order
 	}
 
-	op := c.db.WithContext(ctx).
-		Limit(limit(opts))
+	op := c.db.WithContext(ctx)
+	if opts.ShowDeleted {
+		op = op.Unscoped()
+	}
 
 	if parent.ProjectID != "-" {
 		op = op.Where("project_id = ?", parent.ProjectID)
@@ -341,6 +390,9 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 		return ApiList{}, err
 	}
 
+	op, exact := c.where(op, filter, apiColumns)
+	op = op.Limit(limit(opts, exact))
+
 	if order, err := gormOrdering(opts.Order, "apis"); err != nil {
 		return ApiList{}, err
 	} else {
@@ -453,7 +505,12 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 		return VersionList{}, err
 	}
 
-	op := c.db.WithContext(ctx).Limit(limit(opts))
+	op := c.db.WithContext(ctx)
+	if opts.ShowDeleted {
+		op = op.Unscoped()
+	}
+	op, exact := c.where(op, filter, versionColumns)
+	op = op.Limit(limit(opts, exact))
 	if parent.ProjectID != "-" {
 		op = op.Where("project_id = ?", parent.ProjectID)
 	}
@@ -585,8 +642,13 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 		AND specs.api_id = latest.api_id
 		AND specs.version_id = latest.version_id
 		AND specs.spec_id = latest.spec_id
-		AND specs.revision_id = latest.revision_id`, c.latestSpecRevisionsQuery(ctx)).
-		Limit(limit(opts))
+		AND specs.revision_id = latest.revision_id`, c.latestSpecRevisionsQuery(ctx))
+	if opts.ShowDeleted {
+		op = op.Unscoped()
+	}
+
+	op, exact := c.where(op, filter, specColumns)
+	op = op.Limit(limit(opts, exact))
 
 	if parent.ProjectID != "-" {
 		op = op.Where("specs.project_id = ?", parent.ProjectID)
@@ -717,8 +779,8 @@ func (c *Client) ListSpecRevisions(ctx context.Context, parent names.SpecRevisio
 		return SpecList{}, err
 	}
 
-	op := c.db.WithContext(ctx).
-		Offset(token.Offset).
+	op, _ := c.where(c.db.WithContext(ctx), filter, specColumns)
+	op = op.Offset(token.Offset).
 		Limit(int(opts.Size) + 1)
 
 	if id := parent.ProjectID; id != "-" {
@@ -835,8 +897,13 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 		ON deployments.project_id = latest.project_id
 		AND deployments.api_id = latest.api_id
 		AND deployments.deployment_id = latest.deployment_id
-		AND deployments.revision_id = latest.revision_id`, c.latestDeploymentRevisionsQuery(ctx)).
-		Limit(limit(opts))
+		AND deployments.revision_id = latest.revision_id`, c.latestDeploymentRevisionsQuery(ctx))
+	if opts.ShowDeleted {
+		op = op.Unscoped()
+	}
+
+	op, exact := c.where(op, filter, deploymentColumns)
+	op = op.Limit(limit(opts, exact))
 
 	if parent.ProjectID != "-" {
 		op = op.Where("deployments.project_id = ?", parent.ProjectID)
@@ -960,8 +1027,8 @@ func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.Deplo
 		}
 	}
 
-	op := c.db.WithContext(ctx).
-		Offset(token.Offset).
+	op, _ := c.where(c.db.WithContext(ctx), filter, deploymentColumns)
+	op = op.Offset(token.Offset).
 		Limit(int(opts.Size) + 1)
 
 	if id := parent.ProjectID; id != "-" {
@@ -1396,9 +1463,14 @@ func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, opts PageOption
 		Artifacts: make([]models.Artifact, 0, opts.Size),
 	}
 
+	if opts.ShowDeleted {
+		op = op.Unscoped()
+	}
+	op, exact := c.where(op, filter, artifactColumns)
+	op = op.Limit(limit(opts, exact))
+
 	for {
 		var page []models.Artifact
-		op.Limit(limit(opts))
 		err := op.Offset(token.Offset).Find(&page).Error
 
 		if err != nil {
@@ -23,11 +23,12 @@ import (
 
 func (c *Client) lockTable(ctx context.Context, name string) *Client {
 	// The LOCK TABLE statement below is unavailable in SQLite.
-	// For SQLite, we instead lock all mutating transactions. See RegistryServer.runInTransaction().
+	// SQLite transactions are instead serialized by using a single connection for writes.
 	if c.DatabaseName(ctx) == "sqlite" {
 		return c
 	}
-	return &Client{db: c.db.Exec(fmt.Sprintf("LOCK TABLE %s IN ACCESS EXCLUSIVE MODE", name))}
+	db := c.db.Exec(fmt.Sprintf("LOCK TABLE %s IN ACCESS EXCLUSIVE MODE", name))
+	return &Client{db: db, writer: db, blobs: c.blobs}
 }
 
 func (c *Client) LockProjects(ctx context.Context) *Client {
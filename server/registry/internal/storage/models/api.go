@@ -21,22 +21,25 @@ import (
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Api is the storage-side representation of an API.
 type Api struct {
-	Key                   string    `gorm:"primaryKey"`
-	ProjectID             string    // Uniquely identifies a project.
-	ApiID                 string    // Uniquely identifies an api within a project.
-	DisplayName           string    // A human-friendly name.
-	Description           string    // A detailed description.
-	CreateTime            time.Time // Creation time.
-	UpdateTime            time.Time // Time of last change.
-	Availability          string    // Availability of the API.
-	RecommendedVersion    string    // Recommended API version.
-	RecommendedDeployment string    // Recommended API deployment.
-	Labels                []byte    // Serialized labels.
-	Annotations           []byte    // Serialized annotations.
+	Key                   string         `gorm:"primaryKey"`
+	ProjectID             string         // Uniquely identifies a project.
+	ApiID                 string         // Uniquely identifies an api within a project.
+	DisplayName           string         // A human-friendly name.
+	Description           string         // A detailed description.
+	CreateTime            time.Time      // Creation time.
+	UpdateTime            time.Time      // Time of last change.
+	DeleteTime            gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime            *time.Time     // Time after which a deleted row is purged.
+	Availability          string         // Availability of the API.
+	RecommendedVersion    string         // Recommended API version.
+	RecommendedDeployment string         // Recommended API deployment.
+	Labels                []byte         // Serialized labels.
+	Annotations           []byte         // Serialized annotations.
 	ParentProjectKey      string
 	ParentProject         *Project `gorm:"foreignKey:ParentProjectKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -89,7 +92,9 @@ func (api *Api) Message() (message *rpc.Api, err error) {
 		RecommendedDeployment: api.RecommendedDeployment,
 		CreateTime:            timestamppb.New(api.CreateTime),
 		UpdateTime:            timestamppb.New(api.UpdateTime),
+		Etag:                  api.Etag(),
 	}
+	message.DeleteTime, message.ExpireTime = deletionTimestamps(api.DeleteTime, api.ExpireTime)
 
 	message.Labels, err = api.LabelsMap()
 	if err != nil {
@@ -24,25 +24,28 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Artifact is the storage-side representation of an artifact.
 type Artifact struct {
-	Key          string    `gorm:"primaryKey"`
-	ProjectID    string    // Project associated with artifact (required).
-	ApiID        string    `gorm:"index"` // Api associated with artifact (if appropriate).
-	VersionID    string    // Version associated with artifact (if appropriate).
-	SpecID       string    // Spec associated with artifact (if appropriate).
-	RevisionID   string    // Revision associated with parent (if appropriate).
-	DeploymentID string    // Deployment associated with artifact (if appropriate).
-	ArtifactID   string    // Artifact identifier (required).
-	CreateTime   time.Time // Creation time.
-	UpdateTime   time.Time // Time of last change.
-	MimeType     string    // MIME type of artifact
-	SizeInBytes  int32     // Size of the spec.
-	Hash         string    // A hash of the spec.
-	Labels       []byte    // Serialized labels.
-	Annotations  []byte    // Serialized annotations.
+	Key          string         `gorm:"primaryKey"`
+	ProjectID    string         // Project associated with artifact (required).
+	ApiID        string         `gorm:"index"` // Api associated with artifact (if appropriate).
+	VersionID    string         // Version associated with artifact (if appropriate).
+	SpecID       string         // Spec associated with artifact (if appropriate).
+	RevisionID   string         // Revision associated with parent (if appropriate).
+	DeploymentID string         // Deployment associated with artifact (if appropriate).
+	ArtifactID   string         // Artifact identifier (required).
+	CreateTime   time.Time      // Creation time.
+	UpdateTime   time.Time      // Time of last change.
+	DeleteTime   gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime   *time.Time     // Time after which a deleted row is purged.
+	MimeType     string         // MIME type of artifact
+	SizeInBytes  int32          // Size of the spec.
+	Hash         string         // A hash of the spec.
+	Labels       []byte         // Serialized labels.
+	Annotations  []byte         // Serialized annotations.
 }
 
 // NewArtifact initializes a new resource.
@@ -119,7 +122,9 @@ func (artifact *Artifact) Message() (message *rpc.Artifact, err error) {
 		Hash:       artifact.Hash,
 		CreateTime: timestamppb.New(artifact.CreateTime),
 		UpdateTime: timestamppb.New(artifact.UpdateTime),
+		Etag:       artifact.Etag(),
 	}
+	message.DeleteTime, message.ExpireTime = deletionTimestamps(artifact.DeleteTime, artifact.ExpireTime)
 
 	message.Labels, err = artifact.LabelsMap()
 	if err != nil {
@@ -0,0 +1,70 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditEvent is the storage-side representation of an audit event.
+// Events are written in the same transaction as the changes they describe.
+type AuditEvent struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"` // Order in which events were recorded.
+	Method        string    // Name of the method that made the change.
+	Resource      string    `gorm:"index"` // Name of the changed resource.
+	ProjectID     string    `gorm:"index"` // Project of the changed resource.
+	Actor         string    // Identity of the caller.
+	RequestID     string    // ID of the request that made the change.
+	ChangedFields string    // Comma-separated paths of the changed fields.
+	EventTime     time.Time // Time of the change.
+}
+
+// NewAuditEvent creates a new AuditEvent object.
+func NewAuditEvent(method, resource, actor, requestID string, changedFields []string) *AuditEvent {
+	projectID := ""
+	if parts := strings.Split(resource, "/"); len(parts) > 1 && parts[0] == "projects" {
+		projectID = parts[1]
+	}
+	return &AuditEvent{
+		Method:        method,
+		Resource:      resource,
+		ProjectID:     projectID,
+		Actor:         actor,
+		RequestID:     requestID,
+		ChangedFields: strings.Join(changedFields, ","),
+		EventTime:     time.Now().Round(time.Microsecond),
+	}
+}
+
+// Message returns a message representing an audit event.
+func (e *AuditEvent) Message() *rpc.AuditEvent {
+	message := &rpc.AuditEvent{
+		Method:        e.Method,
+		Resource:      e.Resource,
+		Actor:         e.Actor,
+		RequestId:     e.RequestID,
+		ChangedFields: &fieldmaskpb.FieldMask{},
+		EventTime:     timestamppb.New(e.EventTime),
+	}
+	if e.ChangedFields != "" {
+		message.ChangedFields.Paths = strings.Split(e.ChangedFields, ",")
+	}
+	return message
+}
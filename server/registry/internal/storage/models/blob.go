@@ -14,23 +14,30 @@
 
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 // Blob is the storage-side representation of a blob.
 type Blob struct {
-	Key          string    `gorm:"primaryKey"`
-	ProjectID    string    // Uniquely identifies a project.
-	ApiID        string    // Uniquely identifies an API within a project.
-	VersionID    string    // Uniquely identifies a version of an API.
-	SpecID       string    // Uniquely identifies a spec of a version.
-	RevisionID   string    // Uniquely identifies a revision of a spec.
-	DeploymentID string    // Uniquely identifies a deployment of an API.
-	ArtifactID   string    // Uniquely identifies an artifact on a resource.
-	Hash         string    // Hash of the blob contents.
-	SizeInBytes  int32     // Size of the blob contents.
-	Contents     []byte    // The contents of the blob.
-	CreateTime   time.Time // Creation time.
-	UpdateTime   time.Time // Time of last change.
+	Key          string         `gorm:"primaryKey"`
+	ProjectID    string         // Uniquely identifies a project.
+	ApiID        string         // Uniquely identifies an API within a project.
+	VersionID    string         // Uniquely identifies a version of an API.
+	SpecID       string         // Uniquely identifies a spec of a version.
+	RevisionID   string         // Uniquely identifies a revision of a spec.
+	DeploymentID string         // Uniquely identifies a deployment of an API.
+	ArtifactID   string         // Uniquely identifies an artifact on a resource.
+	Hash         string         // Hash of the blob contents.
+	SizeInBytes  int32          // Size of the blob contents.
+	Contents     []byte         // The contents of the blob; empty for blobs with a ContentsRef.
+	ContentsRef  string         // Key of the BlobContents that hold the contents of the blob.
+	CreateTime   time.Time      // Creation time.
+	UpdateTime   time.Time      // Time of last change.
+	DeleteTime   gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime   *time.Time     // Time after which a deleted row is purged.
 }
 
 // NewBlobForSpec creates a new Blob object to store spec contents.
@@ -68,3 +75,12 @@ func NewBlobForArtifact(artifact *Artifact, contents []byte) *Blob {
 		UpdateTime:   now,
 	}
 }
+
+// BlobContents holds contents that are shared by all blobs with the same hash.
+type BlobContents struct {
+	Key         string    `gorm:"primaryKey"` // Hash that addresses the contents.
+	Contents    []byte    // The contents, unless they are in a blob store.
+	SizeInBytes int64     // Size of the contents.
+	RefCount    int64     `gorm:"index"` // Number of blobs that refer to the contents.
+	CreateTime  time.Time // Creation time.
+}
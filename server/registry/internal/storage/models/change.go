@@ -0,0 +1,46 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "time"
+
+// Change is the storage-side representation of a change recorded in the outbox.
+// Changes are written in the same transaction as the resources they describe.
+type Change struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement"` // Order in which changes were recorded.
+	Sequence   *int64    `gorm:"uniqueIndex"`              // Position in the sequence of changes, assigned after commit.
+	Type       int32     // Type of the change.
+	Resource   string    // Name of the changed resource.
+	ChangeTime time.Time // Time of the change.
+	Delivered  bool      `gorm:"index"` // True after the change has been delivered to all notifiers.
+}
+
+// NotificationCursor is the position of a notifier in the sequence of changes.
+// Each notifier receives changes in order from its own position, so that a
+// notifier that is unavailable doesn't delay the others.
+type NotificationCursor struct {
+	Sink     string `gorm:"primaryKey"` // Name of the notifier.
+	Sequence int64  // Sequence number of the last change handled by the notifier.
+	Attempts int32  // Number of failed attempts to deliver the next change.
+}
+
+// NewChange creates a new Change object.
+func NewChange(changeType int32, resource string) *Change {
+	return &Change{
+		Type:       changeType,
+		Resource:   resource,
+		ChangeTime: time.Now().Round(time.Microsecond),
+	}
+}
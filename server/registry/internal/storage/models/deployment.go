@@ -22,27 +22,30 @@ import (
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Deployment is the storage-side representation of a deployment.
 type Deployment struct {
-	Key                string    `gorm:"primaryKey"`
-	ProjectID          string    // Uniquely identifies a project.
-	ApiID              string    `gorm:"index:idx_latest"` // Uniquely identifies an api within a project.
-	DeploymentID       string    `gorm:"index:idx_latest"` // Uniquely identifies a deployment within an api.
-	RevisionID         string    // Uniquely identifies a revision of a deployment.
-	DisplayName        string    // A human-friendly name.
-	Description        string    // A detailed description.
-	CreateTime         time.Time // Creation time.
-	RevisionCreateTime time.Time `gorm:"index:idx_latest,sort:desc"` // Revision creation time.
-	RevisionUpdateTime time.Time // Time of last change.
-	ApiSpecRevision    string    // The spec being served by the deployment.
-	EndpointURI        string    // The address where the deployment is serving.
-	ExternalChannelURI string    // The address of the external channel of the API.
-	IntendedAudience   string    // The intended audience of the API.
-	AccessGuidance     string    // A brief description of how to access the endpoint.
-	Labels             []byte    // Serialized labels.
-	Annotations        []byte    // Serialized annotations.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Uniquely identifies a project.
+	ApiID              string         `gorm:"index:idx_latest"` // Uniquely identifies an api within a project.
+	DeploymentID       string         `gorm:"index:idx_latest"` // Uniquely identifies a deployment within an api.
+	RevisionID         string         // Uniquely identifies a revision of a deployment.
+	DisplayName        string         // A human-friendly name.
+	Description        string         // A detailed description.
+	CreateTime         time.Time      // Creation time.
+	RevisionCreateTime time.Time      `gorm:"index:idx_latest,sort:desc"` // Revision creation time.
+	RevisionUpdateTime time.Time      // Time of last change.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime         *time.Time     // Time after which a deleted row is purged.
+	ApiSpecRevision    string         // The spec being served by the deployment.
+	EndpointURI        string         // The address where the deployment is serving.
+	ExternalChannelURI string         // The address of the external channel of the API.
+	IntendedAudience   string         // The intended audience of the API.
+	AccessGuidance     string         // A brief description of how to access the endpoint.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
 	ParentApiKey       string
 	ParentApi          *Api `gorm:"foreignKey:ParentApiKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -128,12 +131,14 @@ func (s *Deployment) BasicMessage(name string) (message *rpc.ApiDeployment, err
 		CreateTime:         timestamppb.New(s.CreateTime),
 		RevisionCreateTime: timestamppb.New(s.RevisionCreateTime),
 		RevisionUpdateTime: timestamppb.New(s.RevisionUpdateTime),
+		Etag:               s.Etag(),
 		ApiSpecRevision:    s.ApiSpecRevision,
 		EndpointUri:        s.EndpointURI,
 		ExternalChannelUri: s.ExternalChannelURI,
 		IntendedAudience:   s.IntendedAudience,
 		AccessGuidance:     s.AccessGuidance,
 	}
+	message.DeleteTime, message.ExpireTime = deletionTimestamps(s.DeleteTime, s.ExpireTime)
 
 	message.Labels, err = mapForBytes(s.Labels)
 	if err != nil {
@@ -203,14 +208,16 @@ func (s *Deployment) LabelsMap() (map[string]string, error) {
 
 // DeploymentRevisionTag is the storage-side representation of a deployment revision tag.
 type DeploymentRevisionTag struct {
-	Key                 string    `gorm:"primaryKey"`
-	ProjectID           string    // Uniquely identifies a project.
-	ApiID               string    // Uniquely identifies an api within a project.
-	DeploymentID        string    // Uniquely identifies a deployment within an api.
-	RevisionID          string    // Uniquely identifies a revision of a deployment.
-	Tag                 string    // The tag to use for the revision.
-	CreateTime          time.Time // Creation time.
-	UpdateTime          time.Time // Time of last change.
+	Key                 string         `gorm:"primaryKey"`
+	ProjectID           string         // Uniquely identifies a project.
+	ApiID               string         // Uniquely identifies an api within a project.
+	DeploymentID        string         // Uniquely identifies a deployment within an api.
+	RevisionID          string         // Uniquely identifies a revision of a deployment.
+	Tag                 string         // The tag to use for the revision.
+	CreateTime          time.Time      // Creation time.
+	UpdateTime          time.Time      // Time of last change.
+	DeleteTime          gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime          *time.Time     // Time after which a deleted row is purged.
 	ParentDeploymentKey string
 	ParentDeployment    *Deployment `gorm:"foreignKey:ParentDeploymentKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
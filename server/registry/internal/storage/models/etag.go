@@ -0,0 +1,59 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// etag returns an etag for the state of a resource at the time of its last change.
+// Each change sets a new update time, so etags change whenever resources do.
+func etag(name string, updateTime time.Time) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s@%d", name, updateTime.UnixNano())))
+	return hex.EncodeToString(h[:8])
+}
+
+// Etag returns the current etag of the project.
+func (p *Project) Etag() string {
+	return etag(p.Name(), p.UpdateTime)
+}
+
+// Etag returns the current etag of the api.
+func (api *Api) Etag() string {
+	return etag(api.Name(), api.UpdateTime)
+}
+
+// Etag returns the current etag of the version.
+func (v *Version) Etag() string {
+	return etag(v.Name(), v.UpdateTime)
+}
+
+// Etag returns the current etag of the spec revision.
+func (s *Spec) Etag() string {
+	return etag(s.RevisionName(), s.RevisionUpdateTime)
+}
+
+// Etag returns the current etag of the deployment revision.
+func (s *Deployment) Etag() string {
+	return etag(s.RevisionName(), s.RevisionUpdateTime)
+}
+
+// Etag returns the current etag of the artifact.
+func (artifact *Artifact) Etag() string {
+	return etag(artifact.Name(), artifact.UpdateTime)
+}
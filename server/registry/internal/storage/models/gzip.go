@@ -29,3 +29,16 @@ func GUnzippedBytes(input []byte) ([]byte, error) {
 	}
 	return io.ReadAll(zr)
 }
+
+// GZippedBytes compresses a slice of bytes.
+func GZippedBytes(input []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(input); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -21,16 +21,19 @@ import (
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Project is the storage-side representation of a project.
 type Project struct {
-	Key         string    `gorm:"primaryKey"`
-	ProjectID   string    // Uniquely identifies a project.
-	DisplayName string    // A human-friendly name.
-	Description string    // A detailed description.
-	CreateTime  time.Time // Creation time.
-	UpdateTime  time.Time // Time of last change.
+	Key         string         `gorm:"primaryKey"`
+	ProjectID   string         // Uniquely identifies a project.
+	DisplayName string         // A human-friendly name.
+	Description string         // A detailed description.
+	CreateTime  time.Time      // Creation time.
+	UpdateTime  time.Time      // Time of last change.
+	DeleteTime  gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime  *time.Time     // Time after which a deleted row is purged.
 }
 
 // NewProject initializes a new resource.
@@ -54,13 +57,29 @@ func (p *Project) Name() string {
 
 // Message returns a message representing a project.
 func (p *Project) Message() *rpc.Project {
-	return &rpc.Project{
+	message := &rpc.Project{
 		Name:        p.Name(),
 		DisplayName: p.DisplayName,
 		Description: p.Description,
 		CreateTime:  timestamppb.New(p.CreateTime),
 		UpdateTime:  timestamppb.New(p.UpdateTime),
+		Etag:        p.Etag(),
 	}
+	message.DeleteTime, message.ExpireTime = deletionTimestamps(p.DeleteTime, p.ExpireTime)
+	return message
+}
+
+// deletionTimestamps returns the deletion and expiration times of a resource
+// in the trash, or nil for resources that are not deleted.
+func deletionTimestamps(deleted gorm.DeletedAt, expires *time.Time) (*timestamppb.Timestamp, *timestamppb.Timestamp) {
+	if !deleted.Valid {
+		return nil, nil
+	}
+	var expireTime *timestamppb.Timestamp
+	if expires != nil {
+		expireTime = timestamppb.New(*expires)
+	}
+	return timestamppb.New(deleted.Time), expireTime
 }
 
 // Update modifies a project using the contents of a message.
@@ -0,0 +1,121 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SearchDocument is the searchable text of an API, spec or artifact.
+type SearchDocument struct {
+	ID           int64          `gorm:"primaryKey;autoIncrement"`
+	Key          string         `gorm:"uniqueIndex"` // Name of the resource.
+	Kind         string         // Type of the resource: "api", "spec" or "artifact".
+	ProjectID    string         `gorm:"index"` // Project associated with the resource.
+	ApiID        string         // Api associated with the resource (if appropriate).
+	VersionID    string         // Version associated with the resource (if appropriate).
+	SpecID       string         // Spec associated with the resource (if appropriate).
+	DeploymentID string         // Deployment associated with the resource (if appropriate).
+	ArtifactID   string         // Artifact identifier (if appropriate).
+	ResourceID   string         // Identifier of the resource within its parent.
+	Title        string         // Display name or file name of the resource.
+	Description  string         // A detailed description.
+	Labels       string         // Labels and annotations as "key value" lines.
+	Contents     string         // Text extracted from the contents of the resource.
+	UpdateTime   time.Time      // Time of last change.
+	DeleteTime   gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime   *time.Time     // Time after which a deleted row is purged.
+}
+
+// NewSearchDocumentForApi creates a SearchDocument for an API.
+func NewSearchDocumentForApi(api *Api) (*SearchDocument, error) {
+	labels, err := searchLabels(api.Labels, api.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchDocument{
+		Key:         api.Name(),
+		Kind:        "api",
+		ProjectID:   api.ProjectID,
+		ApiID:       api.ApiID,
+		ResourceID:  api.ApiID,
+		Title:       api.DisplayName,
+		Description: api.Description,
+		Labels:      labels,
+		UpdateTime:  api.UpdateTime,
+	}, nil
+}
+
+// NewSearchDocumentForSpec creates a SearchDocument for a spec revision.
+// Documents of specs are named by their specs, not by their revisions.
+func NewSearchDocumentForSpec(spec *Spec) (*SearchDocument, error) {
+	labels, err := searchLabels(spec.Labels, spec.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchDocument{
+		Key:         spec.Name(),
+		Kind:        "spec",
+		ProjectID:   spec.ProjectID,
+		ApiID:       spec.ApiID,
+		VersionID:   spec.VersionID,
+		SpecID:      spec.SpecID,
+		ResourceID:  spec.SpecID,
+		Title:       spec.FileName,
+		Description: spec.Description,
+		Labels:      labels,
+		UpdateTime:  spec.RevisionUpdateTime,
+	}, nil
+}
+
+// NewSearchDocumentForArtifact creates a SearchDocument for an artifact.
+func NewSearchDocumentForArtifact(artifact *Artifact) (*SearchDocument, error) {
+	labels, err := searchLabels(artifact.Labels, artifact.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchDocument{
+		Key:          artifact.Name(),
+		Kind:         "artifact",
+		ProjectID:    artifact.ProjectID,
+		ApiID:        artifact.ApiID,
+		VersionID:    artifact.VersionID,
+		SpecID:       artifact.SpecID,
+		DeploymentID: artifact.DeploymentID,
+		ArtifactID:   artifact.ArtifactID,
+		ResourceID:   artifact.ArtifactID,
+		Labels:       labels,
+		UpdateTime:   artifact.UpdateTime,
+	}, nil
+}
+
+func searchLabels(labels, annotations []byte) (string, error) {
+	var lines []string
+	for _, b := range [][]byte{labels, annotations} {
+		m, err := mapForBytes(b)
+		if err != nil {
+			return "", err
+		}
+		for k, v := range m {
+			lines = append(lines, k+" "+v)
+		}
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n"), nil
+}
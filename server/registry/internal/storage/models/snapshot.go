@@ -0,0 +1,64 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Snapshot is the storage-side representation of a snapshot.
+// Snapshots are not owned by their projects, so they are kept when the
+// project is deleted.
+type Snapshot struct {
+	Key           string    `gorm:"primaryKey"`
+	ProjectID     string    `gorm:"index"` // Uniquely identifies a project.
+	SnapshotID    string    // Uniquely identifies a snapshot of a project.
+	Description   string    // A detailed description.
+	ResourceCount int32     // Number of resources in the snapshot.
+	Rows          []byte    // The JSON-encoded rows of the project.
+	CreateTime    time.Time // Creation time.
+}
+
+// NewSnapshot initializes a new resource.
+func NewSnapshot(name names.Snapshot, body *rpc.Snapshot) *Snapshot {
+	return &Snapshot{
+		ProjectID:   name.ProjectID,
+		SnapshotID:  name.SnapshotID,
+		Description: body.GetDescription(),
+		CreateTime:  time.Now().Round(time.Microsecond),
+	}
+}
+
+// Name returns the resource name of the snapshot.
+func (s *Snapshot) Name() string {
+	return names.Snapshot{
+		ProjectID:  s.ProjectID,
+		SnapshotID: s.SnapshotID,
+	}.String()
+}
+
+// Message returns a message representing a snapshot.
+func (s *Snapshot) Message() *rpc.Snapshot {
+	return &rpc.Snapshot{
+		Name:          s.Name(),
+		Description:   s.Description,
+		ResourceCount: s.ResourceCount,
+		CreateTime:    timestamppb.New(s.CreateTime),
+	}
+}
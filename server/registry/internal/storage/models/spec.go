@@ -27,27 +27,30 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Spec is the storage-side representation of a spec.
 type Spec struct {
-	Key                string    `gorm:"primaryKey"`
-	ProjectID          string    // Uniquely identifies a project.
-	ApiID              string    `gorm:"index"` // Uniquely identifies an api within a project.
-	VersionID          string    // Uniquely identifies a version within an api.
-	SpecID             string    // Uniquely identifies a spec within a version.
-	RevisionID         string    // Uniquely identifies a revision of a spec.
-	Description        string    // A detailed description.
-	CreateTime         time.Time // Creation time.
-	RevisionCreateTime time.Time // Revision creation time.
-	RevisionUpdateTime time.Time // Time of last change.
-	MimeType           string    // Spec format.
-	SizeInBytes        int32     // Size of the spec.
-	Hash               string    // A hash of the spec.
-	FileName           string    // Name of spec file.
-	SourceURI          string    // The original source URI of the spec.
-	Labels             []byte    // Serialized labels.
-	Annotations        []byte    // Serialized annotations.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Uniquely identifies a project.
+	ApiID              string         `gorm:"index"` // Uniquely identifies an api within a project.
+	VersionID          string         // Uniquely identifies a version within an api.
+	SpecID             string         // Uniquely identifies a spec within a version.
+	RevisionID         string         // Uniquely identifies a revision of a spec.
+	Description        string         // A detailed description.
+	CreateTime         time.Time      // Creation time.
+	RevisionCreateTime time.Time      // Revision creation time.
+	RevisionUpdateTime time.Time      // Time of last change.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime         *time.Time     // Time after which a deleted row is purged.
+	MimeType           string         // Spec format.
+	SizeInBytes        int32          // Size of the spec.
+	Hash               string         // A hash of the spec.
+	FileName           string         // Name of spec file.
+	SourceURI          string         // The original source URI of the spec.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
 	ParentVersionKey   string
 	ParentVersion      *Version `gorm:"foreignKey:ParentVersionKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -149,7 +152,9 @@ func (s *Spec) BasicMessage(name string) (message *rpc.ApiSpec, err error) {
 		CreateTime:         timestamppb.New(s.CreateTime),
 		RevisionCreateTime: timestamppb.New(s.RevisionCreateTime),
 		RevisionUpdateTime: timestamppb.New(s.RevisionUpdateTime),
+		Etag:               s.Etag(),
 	}
+	message.DeleteTime, message.ExpireTime = deletionTimestamps(s.DeleteTime, s.ExpireTime)
 
 	message.Labels, err = mapForBytes(s.Labels)
 	if err != nil {
@@ -248,15 +253,17 @@ func hashForBytes(b []byte) string {
 
 // SpecRevisionTag is the storage-side representation of a spec revision tag.
 type SpecRevisionTag struct {
-	Key           string    `gorm:"primaryKey"`
-	ProjectID     string    // Uniquely identifies a project.
-	ApiID         string    // Uniquely identifies an api within a project.
-	VersionID     string    // Uniquely identifies a version within an api.
-	SpecID        string    // Uniquely identifies a spec within a version.
-	RevisionID    string    // Uniquely identifies a revision of a spec.
-	Tag           string    // The tag to use for the revision.
-	CreateTime    time.Time // Creation time.
-	UpdateTime    time.Time // Time of last change.
+	Key           string         `gorm:"primaryKey"`
+	ProjectID     string         // Uniquely identifies a project.
+	ApiID         string         // Uniquely identifies an api within a project.
+	VersionID     string         // Uniquely identifies a version within an api.
+	SpecID        string         // Uniquely identifies a spec within a version.
+	RevisionID    string         // Uniquely identifies a revision of a spec.
+	Tag           string         // The tag to use for the revision.
+	CreateTime    time.Time      // Creation time.
+	UpdateTime    time.Time      // Time of last change.
+	DeleteTime    gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime    *time.Time     // Time after which a deleted row is purged.
 	ParentSpecKey string
 	ParentSpec    *Spec `gorm:"foreignKey:ParentSpecKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
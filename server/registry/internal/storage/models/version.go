@@ -21,22 +21,25 @@ import (
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Version is the storage-side representation of a version.
 type Version struct {
-	Key          string    `gorm:"primaryKey"`
-	ProjectID    string    // Uniquely identifies a project.
-	ApiID        string    // Uniquely identifies an api within a project.
-	VersionID    string    // Uniquely identifies a version within an api.
-	DisplayName  string    // A human-friendly name.
-	Description  string    // A detailed description.
-	CreateTime   time.Time // Creation time.
-	UpdateTime   time.Time // Time of last change.
-	State        string    // Lifecycle stage.
-	Labels       []byte    // Serialized labels.
-	Annotations  []byte    // Serialized annotations.
-	PrimarySpec  string    // Primary Spec for this version.
+	Key          string         `gorm:"primaryKey"`
+	ProjectID    string         // Uniquely identifies a project.
+	ApiID        string         // Uniquely identifies an api within a project.
+	VersionID    string         // Uniquely identifies a version within an api.
+	DisplayName  string         // A human-friendly name.
+	Description  string         // A detailed description.
+	CreateTime   time.Time      // Creation time.
+	UpdateTime   time.Time      // Time of last change.
+	DeleteTime   gorm.DeletedAt `gorm:"index"` // Time of deletion, set while in the trash.
+	ExpireTime   *time.Time     // Time after which a deleted row is purged.
+	State        string         // Lifecycle stage.
+	Labels       []byte         // Serialized labels.
+	Annotations  []byte         // Serialized annotations.
+	PrimarySpec  string         // Primary Spec for this version.
 	ParentApiKey string
 	ParentApi    *Api `gorm:"foreignKey:ParentApiKey;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -89,7 +92,9 @@ func (v *Version) Message() (message *rpc.ApiVersion, err error) {
 		PrimarySpec: v.PrimarySpec,
 		CreateTime:  timestamppb.New(v.CreateTime),
 		UpdateTime:  timestamppb.New(v.UpdateTime),
+		Etag:        v.Etag(),
 	}
+	message.DeleteTime, message.ExpireTime = deletionTimestamps(v.DeleteTime, v.ExpireTime)
 
 	message.Labels, err = v.LabelsMap()
 	if err != nil {
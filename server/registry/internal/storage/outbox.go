@@ -0,0 +1,145 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+)
+
+// RecordChange adds a change to the outbox.
+func (c *Client) RecordChange(ctx context.Context, v *models.Change) error {
+	return c.create(ctx, v)
+}
+
+// SequenceChanges assigns consecutive sequence numbers to up to limit changes
+// that don't have them, in the order the changes were recorded, and returns
+// the number of changes that were sequenced. It should be called in a
+// transaction; concurrent callers conflict on the unique sequence index.
+func (c *Client) SequenceChanges(ctx context.Context, limit int) (int, error) {
+	var last sql.NullInt64
+	if err := c.db.WithContext(ctx).Model(&models.Change{}).Select("MAX(sequence)").Scan(&last).Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "sequence changes"))
+	}
+
+	var ids []int64
+	if err := c.db.WithContext(ctx).Model(&models.Change{}).
+		Where("sequence IS NULL").
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "sequence changes"))
+	}
+
+	for i, id := range ids {
+		if err := c.db.WithContext(ctx).Model(&models.Change{}).
+			Where("id = ?", id).
+			Update("sequence", last.Int64+int64(i)+1).Error; err != nil {
+			return 0, grpcErrorForDBError(ctx, errors.Wrapf(err, "sequence change %d", id))
+		}
+	}
+	return len(ids), nil
+}
+
+// LastChangeSequence returns the highest sequence number assigned to a change,
+// or zero if no changes have been sequenced.
+func (c *Client) LastChangeSequence(ctx context.Context) (int64, error) {
+	var last sql.NullInt64
+	if err := c.db.WithContext(ctx).Model(&models.Change{}).Select("MAX(sequence)").Scan(&last).Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "last change"))
+	}
+	return last.Int64, nil
+}
+
+// ListChanges returns up to limit sequenced changes that follow the change
+// with sequence number after, in sequence order.
+func (c *Client) ListChanges(ctx context.Context, after int64, limit int) ([]*models.Change, error) {
+	var v []*models.Change
+	if err := c.db.WithContext(ctx).
+		Where("sequence > ?", after).
+		Order("sequence").
+		Limit(limit).
+		Find(&v).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "list changes"))
+	}
+	return v, nil
+}
+
+// MarkChangesDelivered records that the changes with sequence numbers up to
+// and including through have been delivered to all notifiers.
+func (c *Client) MarkChangesDelivered(ctx context.Context, through int64) error {
+	err := c.db.WithContext(ctx).Model(&models.Change{}).
+		Where("sequence <= ? AND delivered = ?", through, false).
+		Update("delivered", true).Error
+	return grpcErrorForDBError(ctx, errors.Wrap(err, "mark changes delivered"))
+}
+
+// GetNotificationCursor returns the position of a notifier in the sequence of
+// changes. Notifiers without a recorded position start at the first change
+// that has not been delivered.
+func (c *Client) GetNotificationCursor(ctx context.Context, sink string) (*models.NotificationCursor, error) {
+	v := &models.NotificationCursor{Sink: sink}
+	op := c.db.WithContext(ctx).Where("sink = ?", sink).Limit(1).Find(v)
+	if err := op.Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "get notification cursor"))
+	}
+	if op.RowsAffected > 0 {
+		return v, nil
+	}
+	var first sql.NullInt64
+	if err := c.db.WithContext(ctx).Model(&models.Change{}).
+		Where("sequence IS NOT NULL AND delivered = ?", false).
+		Select("MIN(sequence)").Scan(&first).Error; err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrap(err, "get notification cursor"))
+	}
+	if first.Valid {
+		v.Sequence = first.Int64 - 1
+		return v, nil
+	}
+	last, err := c.LastChangeSequence(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v.Sequence = last
+	return v, nil
+}
+
+// SaveNotificationCursor records the position of a notifier in the sequence
+// of changes.
+func (c *Client) SaveNotificationCursor(ctx context.Context, v *models.NotificationCursor) error {
+	err := c.db.WithContext(ctx).Save(v).Error
+	return grpcErrorForDBError(ctx, errors.Wrap(err, "save notification cursor"))
+}
+
+// DeleteDeliveredChanges removes delivered changes made before a time.
+// The most recent change is always kept so that sequence numbers continue
+// to increase.
+func (c *Client) DeleteDeliveredChanges(ctx context.Context, before time.Time) (int64, error) {
+	last, err := c.LastChangeSequence(ctx)
+	if err != nil {
+		return 0, err
+	}
+	op := c.db.WithContext(ctx).
+		Where("delivered = ? AND change_time < ? AND sequence < ?", true, before, last).
+		Delete(&models.Change{})
+	if err := op.Error; err != nil {
+		return 0, grpcErrorForDBError(ctx, errors.Wrap(err, "delete delivered changes"))
+	}
+	return op.RowsAffected, nil
+}
@@ -34,6 +34,9 @@ type PageOptions struct {
 	// If specified, listing will continue from the end of the previous page. Otherwise,
 	// the first page in a listing series will be returned.
 	Token string
+	// ShowDeleted includes deleted resources that have not expired in the listing.
+	// It is supported for all resources except revisions.
+	ShowDeleted bool
 }
 
 // token contains information to share between sequential page iterators.
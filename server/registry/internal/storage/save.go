@@ -31,7 +31,10 @@ func (c *Client) SaveProject(ctx context.Context, v *models.Project) error {
 // SaveApi will return codes.NotFound if key not found
 func (c *Client) SaveApi(ctx context.Context, v *models.Api) error {
 	v.Key = v.Name()
-	return c.save(ctx, v)
+	if err := c.save(ctx, v); err != nil {
+		return err
+	}
+	return c.indexApi(ctx, v)
 }
 
 // SaveVersion will return codes.NotFound if key not found
@@ -41,16 +44,24 @@ func (c *Client) SaveVersion(ctx context.Context, v *models.Version) error {
 }
 
 // SaveSpecRevision will upsert if key not found
+// The revision must be the latest revision of its spec.
 func (c *Client) SaveSpecRevision(ctx context.Context, v *models.Spec) error {
 	v.Key = v.RevisionName()
-	return c.save(ctx, v)
+	if err := c.save(ctx, v); err != nil {
+		return err
+	}
+	return c.indexSpec(ctx, v)
 }
 
 // SaveSpecRevisionContents will create a new blob for the spec
+// The revision must be the latest revision of its spec.
 func (c *Client) SaveSpecRevisionContents(ctx context.Context, spec *models.Spec, contents []byte) error {
 	v := models.NewBlobForSpec(spec, contents)
 	v.Key = spec.RevisionName()
-	return c.save(ctx, v)
+	if err := c.saveBlob(ctx, v); err != nil {
+		return err
+	}
+	return c.indexSpecContents(ctx, spec, contents)
 }
 
 // SaveSpecRevisionTag will upsert if key not found
@@ -74,14 +85,17 @@ func (c *Client) SaveDeploymentRevisionTag(ctx context.Context, v *models.Deploy
 // SaveArtifact will upsert if key not found
 func (c *Client) SaveArtifact(ctx context.Context, v *models.Artifact) error {
 	v.Key = v.Name()
-	return c.save(ctx, v)
+	if err := c.save(ctx, v); err != nil {
+		return err
+	}
+	return c.indexArtifact(ctx, v)
 }
 
 // SaveSpecRevisionContents will create a new blob for the artifact
 func (c *Client) SaveArtifactContents(ctx context.Context, artifact *models.Artifact, contents []byte) error {
 	v := models.NewBlobForArtifact(artifact, contents)
 	v.Key = artifact.Name()
-	return c.save(ctx, v)
+	return c.saveBlob(ctx, v)
 }
 
 func (c *Client) save(ctx context.Context, v interface{}) error {
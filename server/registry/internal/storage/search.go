@@ -0,0 +1,433 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/search"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// searchIndexTable is the name of the SQLite full-text index of search_documents.
+const searchIndexTable = "search_index"
+
+// searchColumns are the indexed columns of search documents, in the order of searchWeights.
+var searchColumns = []string{"title", "resource_id", "description", "labels", "contents"}
+
+// searchWeights rank matches in titles and IDs above matches in descriptions,
+// labels and contents.
+var searchWeights = []float64{10, 10, 4, 2, 1}
+
+// searchDocumentColumns are updated when the metadata of a resource is saved.
+var searchDocumentColumns = []string{
+	"kind", "project_id", "api_id", "version_id", "spec_id", "deployment_id", "artifact_id",
+	"resource_id", "title", "description", "labels", "update_time",
+}
+
+// postgresSearchVector is the weighted text of a search document in Postgres.
+const postgresSearchVector = `(` +
+	`setweight(to_tsvector('english', coalesce(title, '') || ' ' || coalesce(resource_id, '')), 'A') || ` +
+	`setweight(to_tsvector('english', coalesce(description, '')), 'B') || ` +
+	`setweight(to_tsvector('english', coalesce(labels, '')), 'C') || ` +
+	`setweight(to_tsvector('english', coalesce(contents, '')), 'D'))`
+
+// ensureSearchIndex creates the full-text index of search documents. SQLite
+// uses FTS5 when it is available and FTS4 otherwise; Postgres uses a GIN
+// index of a weighted tsvector.
+func (c *Client) ensureSearchIndex(ctx context.Context) error {
+	db := c.writer.WithContext(ctx)
+	switch db.Name() {
+	case "postgres":
+		return db.Exec("CREATE INDEX IF NOT EXISTS idx_search_documents_text ON search_documents USING GIN (" + postgresSearchVector + ")").Error
+	case "sqlite":
+		if fts, err := c.sqliteSearchModule(db); err != nil || fts != "" {
+			return err
+		}
+		return db.Transaction(func(tx *gorm.DB) error {
+			columns := strings.Join(searchColumns, ", ")
+			values := func(row string) string {
+				v := make([]string, len(searchColumns))
+				for i, c := range searchColumns {
+					v[i] = row + "." + c
+				}
+				return strings.Join(v, ", ")
+			}
+			var fts5 bool
+			if err := tx.Raw("SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&fts5).Error; err != nil {
+				return err
+			}
+			var statements []string
+			if fts5 {
+				statements = []string{
+					fmt.Sprintf("CREATE VIRTUAL TABLE %s USING fts5(%s, content='search_documents', content_rowid='id', tokenize='porter unicode61')", searchIndexTable, columns),
+					fmt.Sprintf("CREATE TRIGGER search_documents_ai AFTER INSERT ON search_documents BEGIN INSERT INTO %[1]s(rowid, %[2]s) VALUES (new.id, %[3]s); END",
+						searchIndexTable, columns, values("new")),
+					fmt.Sprintf("CREATE TRIGGER search_documents_ad AFTER DELETE ON search_documents BEGIN INSERT INTO %[1]s(%[1]s, rowid, %[2]s) VALUES ('delete', old.id, %[3]s); END",
+						searchIndexTable, columns, values("old")),
+					fmt.Sprintf("CREATE TRIGGER search_documents_au AFTER UPDATE ON search_documents BEGIN INSERT INTO %[1]s(%[1]s, rowid, %[2]s) VALUES ('delete', old.id, %[3]s); INSERT INTO %[1]s(rowid, %[2]s) VALUES (new.id, %[4]s); END",
+						searchIndexTable, columns, values("old"), values("new")),
+				}
+			} else {
+				// FTS4 reads the old values of deleted rows from the content table,
+				// so they are removed from the index before rows change.
+				statements = []string{
+					fmt.Sprintf("CREATE VIRTUAL TABLE %s USING fts4(content='search_documents', %s, tokenize=porter)", searchIndexTable, columns),
+					fmt.Sprintf("CREATE TRIGGER search_documents_ai AFTER INSERT ON search_documents BEGIN INSERT INTO %[1]s(docid, %[2]s) VALUES (new.id, %[3]s); END",
+						searchIndexTable, columns, values("new")),
+					fmt.Sprintf("CREATE TRIGGER search_documents_bd BEFORE DELETE ON search_documents BEGIN DELETE FROM %s WHERE docid = old.id; END", searchIndexTable),
+					fmt.Sprintf("CREATE TRIGGER search_documents_bu BEFORE UPDATE ON search_documents BEGIN DELETE FROM %s WHERE docid = old.id; END", searchIndexTable),
+					fmt.Sprintf("CREATE TRIGGER search_documents_au AFTER UPDATE ON search_documents BEGIN INSERT INTO %[1]s(docid, %[2]s) VALUES (new.id, %[3]s); END",
+						searchIndexTable, columns, values("new")),
+				}
+			}
+			for _, s := range statements {
+				if err := tx.Exec(s).Error; err != nil {
+					return err
+				}
+			}
+			// Index any documents that were saved before the index existed.
+			return tx.Exec(fmt.Sprintf("INSERT INTO %[1]s(%[1]s) VALUES ('rebuild')", searchIndexTable)).Error
+		})
+	default:
+		return nil
+	}
+}
+
+// sqliteSearchModule returns the module of the SQLite search index
+// ("fts5" or "fts4"), or "" if the index doesn't exist.
+func (c *Client) sqliteSearchModule(db *gorm.DB) (string, error) {
+	var sql []string
+	if err := db.Table("sqlite_schema").Where("name = ?", searchIndexTable).Pluck("sql", &sql).Error; err != nil {
+		return "", err
+	}
+	if len(sql) == 0 {
+		return "", nil
+	}
+	if strings.Contains(strings.ToLower(sql[0]), "fts5") {
+		return "fts5", nil
+	}
+	return "fts4", nil
+}
+
+// saveSearchDocument adds or replaces the document of a resource, updating
+// only the given columns of an existing document.
+func (c *Client) saveSearchDocument(ctx context.Context, v *models.SearchDocument, columns []string) error {
+	err := c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns(columns),
+	}).Create(v).Error
+	return grpcErrorForDBError(ctx, errors.Wrapf(err, "index %s", v.Key))
+}
+
+func (c *Client) indexApi(ctx context.Context, api *models.Api) error {
+	v, err := models.NewSearchDocumentForApi(api)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return c.saveSearchDocument(ctx, v, searchDocumentColumns)
+}
+
+// indexSpec indexes the metadata of a spec revision, which must be the
+// latest revision of its spec.
+func (c *Client) indexSpec(ctx context.Context, spec *models.Spec) error {
+	v, err := models.NewSearchDocumentForSpec(spec)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return c.saveSearchDocument(ctx, v, searchDocumentColumns)
+}
+
+// indexSpecContents indexes the metadata and the text of a spec revision,
+// which must be the latest revision of its spec.
+func (c *Client) indexSpecContents(ctx context.Context, spec *models.Spec, contents []byte) error {
+	v, err := models.NewSearchDocumentForSpec(spec)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	v.Contents = search.SpecText(spec.MimeType, contents)
+	return c.saveSearchDocument(ctx, v, append(searchDocumentColumns, "contents"))
+}
+
+func (c *Client) indexArtifact(ctx context.Context, artifact *models.Artifact) error {
+	v, err := models.NewSearchDocumentForArtifact(artifact)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return c.saveSearchDocument(ctx, v, searchDocumentColumns)
+}
+
+// reindexSpec indexes the latest revision of a spec and its contents.
+func (c *Client) reindexSpec(ctx context.Context, spec *models.Spec) error {
+	blob := new(models.Blob)
+	if err := c.db.WithContext(ctx).Take(blob, "key = ?", spec.RevisionName()).Error; err == gorm.ErrRecordNotFound {
+		return c.indexSpecContents(ctx, spec, nil)
+	} else if err != nil {
+		return grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", spec.RevisionName()))
+	}
+	if err := c.loadBlobContents(ctx, blob); err != nil {
+		return err
+	}
+	return c.indexSpecContents(ctx, spec, blob.Contents)
+}
+
+// migrateSearchDocuments indexes resources that were saved before they were
+// indexed for search.
+func (c *Client) migrateSearchDocuments(ctx context.Context) error {
+	var keys []string
+	if err := c.db.WithContext(ctx).Model(&models.SearchDocument{}).Pluck("key", &keys).Error; err != nil {
+		return err
+	}
+	indexed := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		indexed[k] = true
+	}
+
+	var apis []*models.Api
+	if err := c.db.WithContext(ctx).FindInBatches(&apis, blobMigrationBatchSize, func(*gorm.DB, int) error {
+		for _, v := range apis {
+			if !indexed[v.Name()] {
+				if err := c.indexApi(ctx, v); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}).Error; err != nil {
+		return err
+	}
+
+	latest := make(map[string]*models.Spec)
+	var specs []*models.Spec
+	if err := c.db.WithContext(ctx).FindInBatches(&specs, blobMigrationBatchSize, func(*gorm.DB, int) error {
+		for _, v := range specs {
+			name := v.Name()
+			if indexed[name] {
+				continue
+			}
+			if l, ok := latest[name]; !ok || v.RevisionCreateTime.After(l.RevisionCreateTime) {
+				latest[name] = v
+			}
+		}
+		return nil
+	}).Error; err != nil {
+		return err
+	}
+	for _, v := range latest {
+		if err := c.reindexSpec(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	var artifacts []*models.Artifact
+	return c.db.WithContext(ctx).FindInBatches(&artifacts, blobMigrationBatchSize, func(*gorm.DB, int) error {
+		for _, v := range artifacts {
+			if !indexed[v.Name()] {
+				if err := c.indexArtifact(ctx, v); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}).Error
+}
+
+// SearchResult is a resource that matches a search query.
+type SearchResult struct {
+	Name        string
+	Kind        string
+	Title       string
+	Description string
+	Score       float64
+}
+
+// SearchResultList contains a page of search results.
+type SearchResultList struct {
+	Results []SearchResult
+	Token   string
+}
+
+var searchTerm = regexp.MustCompile(`[\pL\pN]+`)
+
+// searchTerms returns the words of a query, which must all match.
+func searchTerms(query string) []string {
+	terms := searchTerm.FindAllString(strings.ToLower(query), -1)
+	if len(terms) > 32 {
+		terms = terms[:32]
+	}
+	return terms
+}
+
+// SearchResources returns the APIs, specs and artifacts that contain all of
+// the words in a query, most relevant first.
+func (c *Client) SearchResources(ctx context.Context, parent names.Project, query string, opts PageOptions) (SearchResultList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+	// Pages are only consistent if they are results of the same query.
+	if err := token.ValidateFilter(query); err != nil {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid query %q: %s", query, err)
+	}
+	token.Filter = query
+
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid query %q: must contain words to search for", query)
+	}
+
+	if parent.ProjectID != "-" {
+		if _, err := c.GetProject(ctx, parent); err != nil {
+			return SearchResultList{}, err
+		}
+	}
+
+	var results []SearchResult
+	db := c.db.WithContext(ctx)
+	switch db.Name() {
+	case "postgres":
+		results, err = c.searchPostgres(db, parent, terms, token.Offset, int(opts.Size)+1)
+	case "sqlite":
+		results, err = c.searchSQLite(db, parent, terms, token.Offset, int(opts.Size)+1)
+	default:
+		return SearchResultList{}, status.Errorf(codes.Unimplemented, "search is not supported by %s", db.Name())
+	}
+	if err != nil {
+		return SearchResultList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "search %q", query))
+	}
+
+	response := SearchResultList{Results: results}
+	if len(results) > int(opts.Size) {
+		response.Results = results[:opts.Size]
+		token.Offset += int(opts.Size)
+		if response.Token, err = encodeToken(token); err != nil {
+			return SearchResultList{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return response, nil
+}
+
+func (c *Client) searchPostgres(db *gorm.DB, parent names.Project, terms []string, offset, limit int) ([]SearchResult, error) {
+	query := strings.Join(terms, " ")
+	op := db.Model(&models.SearchDocument{}).
+		Select("key AS name, kind, title, description, ts_rank("+postgresSearchVector+", plainto_tsquery('english', ?)) AS score", query).
+		Where(postgresSearchVector+" @@ plainto_tsquery('english', ?)", query)
+	if parent.ProjectID != "-" {
+		op = op.Where("project_id = ?", parent.ProjectID)
+	}
+	var results []SearchResult
+	err := op.Order("score DESC, key").Offset(offset).Limit(limit).Scan(&results).Error
+	return results, err
+}
+
+func (c *Client) searchSQLite(db *gorm.DB, parent names.Project, terms []string, offset, limit int) ([]SearchResult, error) {
+	module, err := c.sqliteSearchModule(db)
+	if err != nil {
+		return nil, err
+	} else if module == "" {
+		return nil, status.Error(codes.FailedPrecondition, "search index does not exist")
+	}
+
+	quoted := make([]string, len(terms))
+	for i, t := range terms {
+		quoted[i] = `"` + t + `"`
+	}
+	op := db.Table(searchIndexTable).
+		Joins("JOIN search_documents ON search_documents.id = "+searchIndexTable+".rowid").
+		Where(searchIndexTable+" MATCH ?", strings.Join(quoted, " ")).
+		// The index table has no model, so deleted documents are excluded here.
+		Where("search_documents.delete_time IS NULL")
+	if parent.ProjectID != "-" {
+		op = op.Where("search_documents.project_id = ?", parent.ProjectID)
+	}
+	const fields = "search_documents.key AS name, search_documents.kind, search_documents.title, search_documents.description"
+
+	if module == "fts5" {
+		weights := make([]string, len(searchWeights))
+		for i, w := range searchWeights {
+			weights[i] = fmt.Sprint(w)
+		}
+		// bm25 returns better matches as lower (negative) numbers.
+		var results []SearchResult
+		err := op.Select(fields + ", -bm25(" + searchIndexTable + ", " + strings.Join(weights, ", ") + ") AS score").
+			Order("score DESC, search_documents.key").
+			Offset(offset).Limit(limit).
+			Scan(&results).Error
+		return results, err
+	}
+
+	// FTS4 has no ranking function, so matches are ranked here.
+	var rows []struct {
+		SearchResult
+		MatchInfo []byte
+	}
+	if err := op.Select(fields + ", matchinfo(" + searchIndexTable + ", 'pcx') AS match_info").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	results := make([]SearchResult, len(rows))
+	for i, r := range rows {
+		results[i] = r.SearchResult
+		results[i].Score = matchScore(r.MatchInfo)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	if offset >= len(results) {
+		return nil, nil
+	}
+	results = results[offset:]
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// matchScore scores an FTS4 match by the weighted share of the hits of each
+// term that are in the matching row, using the "pcx" format of matchinfo.
+func matchScore(info []byte) float64 {
+	values := make([]uint32, len(info)/4)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint32(info[4*i:])
+	}
+	if len(values) < 2 {
+		return 0
+	}
+	phrases, columns := int(values[0]), int(values[1])
+	score := 0.0
+	for p := 0; p < phrases; p++ {
+		for c := 0; c < columns && c < len(searchWeights); c++ {
+			i := 2 + 3*(p*columns+c)
+			if i+1 >= len(values) || values[i+1] == 0 {
+				continue
+			}
+			score += searchWeights[c] * float64(values[i]) / float64(values[i+1])
+		}
+	}
+	return score
+}
@@ -0,0 +1,360 @@
+// Copyright 2020 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/pkg/names"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// snapshotBatchSize is the number of rows inserted at a time when a
+// snapshot is restored.
+const snapshotBatchSize = 100
+
+// snapshotRows are the rows of a project that are copied into a snapshot.
+// Blobs are copied without their contents, which the snapshot shares with
+// the project by holding a reference to them.
+type snapshotRows struct {
+	Projects               []models.Project
+	Apis                   []models.Api
+	Versions               []models.Version
+	Specs                  []models.Spec
+	SpecRevisionTags       []models.SpecRevisionTag
+	Deployments            []models.Deployment
+	DeploymentRevisionTags []models.DeploymentRevisionTag
+	Artifacts              []models.Artifact
+	Blobs                  []models.Blob
+}
+
+// tables returns the rows of each table, parents before children.
+func (r *snapshotRows) tables() []interface{} {
+	return []interface{}{
+		&r.Projects,
+		&r.Apis,
+		&r.Versions,
+		&r.Specs,
+		&r.SpecRevisionTags,
+		&r.Deployments,
+		&r.DeploymentRevisionTags,
+		&r.Artifacts,
+		&r.Blobs,
+	}
+}
+
+// resourceCount returns the number of resources in the rows, counting each
+// revision of a spec or deployment separately.
+func (r *snapshotRows) resourceCount() int32 {
+	return int32(len(r.Projects) + len(r.Apis) + len(r.Versions) + len(r.Specs) + len(r.Deployments) + len(r.Artifacts))
+}
+
+// references returns the number of references that the blobs hold to each contents.
+func (r *snapshotRows) references() []blobReference {
+	counts := make(map[string]int64)
+	for _, b := range r.Blobs {
+		if b.ContentsRef != "" {
+			counts[b.ContentsRef]++
+		}
+	}
+	refs := make([]blobReference, 0, len(counts))
+	for k, n := range counts {
+		refs = append(refs, blobReference{ContentsRef: k, Count: n})
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].ContentsRef < refs[j].ContentsRef })
+	return refs
+}
+
+// versions returns a string for each resource in the rows that changes
+// whenever the resource or any of its revisions, tags or contents change.
+func (r *snapshotRows) versions() map[string]string {
+	parts := make(map[string][]string)
+	add := func(name, key string, t time.Time, extra ...string) {
+		parts[name] = append(parts[name], strings.Join(append([]string{key, t.UTC().Format(time.RFC3339Nano)}, extra...), " "))
+	}
+	for _, v := range r.Projects {
+		add(v.Name(), v.Key, v.UpdateTime)
+	}
+	for _, v := range r.Apis {
+		add(v.Name(), v.Key, v.UpdateTime)
+	}
+	for _, v := range r.Versions {
+		add(v.Name(), v.Key, v.UpdateTime)
+	}
+	for _, v := range r.Specs {
+		add(v.Name(), v.Key, v.RevisionUpdateTime, v.Hash)
+	}
+	for _, v := range r.SpecRevisionTags {
+		name := names.Spec{ProjectID: v.ProjectID, ApiID: v.ApiID, VersionID: v.VersionID, SpecID: v.SpecID}
+		add(name.String(), v.Key, v.UpdateTime, v.RevisionID)
+	}
+	for _, v := range r.Deployments {
+		add(v.Name(), v.Key, v.RevisionUpdateTime)
+	}
+	for _, v := range r.DeploymentRevisionTags {
+		name := names.Deployment{ProjectID: v.ProjectID, ApiID: v.ApiID, DeploymentID: v.DeploymentID}
+		add(name.String(), v.Key, v.UpdateTime, v.RevisionID)
+	}
+	for _, v := range r.Artifacts {
+		add(v.Name(), v.Key, v.UpdateTime, v.Hash)
+	}
+	versions := make(map[string]string, len(parts))
+	for name, p := range parts {
+		sort.Strings(p)
+		versions[name] = strings.Join(p, "\n")
+	}
+	return versions
+}
+
+// readProjectRows reads the rows of a project that are not in the trash.
+func (c *Client) readProjectRows(ctx context.Context, name names.Project) (*snapshotRows, error) {
+	rows := new(snapshotRows)
+	for _, table := range rows.tables() {
+		op := c.db.WithContext(ctx).Where("project_id = ?", name.ProjectID).Order("key")
+		if err := op.Find(table).Error; err != nil {
+			return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "read %s", name))
+		}
+	}
+	return rows, nil
+}
+
+// addReferences adds references to contents.
+func (c *Client) addReferences(ctx context.Context, refs []blobReference) error {
+	for _, r := range refs {
+		op := c.db.WithContext(ctx).Model(&models.BlobContents{}).
+			Where("key = ?", r.ContentsRef).
+			Update("ref_count", gorm.Expr("ref_count + ?", r.Count))
+		if op.Error != nil {
+			return grpcErrorForDBError(ctx, errors.Wrapf(op.Error, "acquire contents %s", r.ContentsRef))
+		} else if op.RowsAffected == 0 {
+			return status.Errorf(codes.Internal, "contents %s are missing", r.ContentsRef)
+		}
+	}
+	return nil
+}
+
+// CreateSnapshot copies the rows of a project into a new snapshot, which
+// holds references to the contents of the project's blobs.
+func (c *Client) CreateSnapshot(ctx context.Context, v *models.Snapshot) error {
+	v.Key = v.Name()
+	project := names.Project{ProjectID: v.ProjectID}
+	rows, err := c.readProjectRows(ctx, project)
+	if err != nil {
+		return err
+	} else if len(rows.Projects) == 0 {
+		return status.Errorf(codes.NotFound, "%q not found in database", project)
+	}
+
+	// Contents that haven't been migrated are moved to shared contents so
+	// that the snapshot can refer to them.
+	for i := range rows.Blobs {
+		b := &rows.Blobs[i]
+		if b.ContentsRef != "" {
+			continue
+		}
+		if err := c.acquireBlobContents(ctx, b); err != nil {
+			return err
+		}
+		if err := c.save(ctx, b); err != nil {
+			return err
+		}
+	}
+
+	v.ResourceCount = rows.resourceCount()
+	v.Rows, err = json.Marshal(rows)
+	if err != nil {
+		return status.Errorf(codes.Internal, "encode %s: %s", v.Key, err)
+	}
+	if err := c.create(ctx, v); err != nil {
+		return err
+	}
+	return c.addReferences(ctx, rows.references())
+}
+
+// GetSnapshot returns a snapshot without its rows.
+func (c *Client) GetSnapshot(ctx context.Context, name names.Snapshot) (*models.Snapshot, error) {
+	v := new(models.Snapshot)
+	if err := c.db.WithContext(ctx).Omit("rows").Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
+	} else if err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
+	}
+	return v, nil
+}
+
+// getSnapshotRows returns the rows that were copied into a snapshot.
+func (c *Client) getSnapshotRows(ctx context.Context, name names.Snapshot) (*snapshotRows, error) {
+	v := new(models.Snapshot)
+	if err := c.db.WithContext(ctx).Take(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
+	} else if err != nil {
+		return nil, grpcErrorForDBError(ctx, errors.Wrapf(err, "get %s", name))
+	}
+	rows := new(snapshotRows)
+	if err := json.Unmarshal(v.Rows, rows); err != nil {
+		return nil, status.Errorf(codes.Internal, "decode %s: %s", name, err)
+	}
+	return rows, nil
+}
+
+// SnapshotList contains a page of snapshots.
+type SnapshotList struct {
+	Snapshots []models.Snapshot
+	Token     string
+}
+
+// ListSnapshots returns a page of the snapshots of a project, oldest first.
+// Snapshots can be listed after their project has been deleted.
+func (c *Client) ListSnapshots(ctx context.Context, parent names.Project, opts PageOptions) (SnapshotList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return SnapshotList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	var page []models.Snapshot
+	op := c.db.WithContext(ctx).Omit("rows").
+		Where("project_id = ?", parent.ProjectID).
+		Order("create_time, key").
+		Offset(token.Offset).
+		Limit(int(opts.Size) + 1)
+	if err := op.Find(&page).Error; err != nil {
+		return SnapshotList{}, grpcErrorForDBError(ctx, errors.Wrapf(err, "find %#v", token))
+	}
+
+	response := SnapshotList{Snapshots: page}
+	if len(page) > int(opts.Size) {
+		response.Snapshots = page[:opts.Size]
+		token.Offset += int(opts.Size)
+		response.Token, err = encodeToken(token)
+		if err != nil {
+			return SnapshotList{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return response, nil
+}
+
+// DeleteSnapshot deletes a snapshot and releases its references to contents.
+func (c *Client) DeleteSnapshot(ctx context.Context, name names.Snapshot) error {
+	rows, err := c.getSnapshotRows(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := c.releaseReferences(ctx, rows.references()); err != nil {
+		return err
+	}
+	op := c.db.WithContext(ctx).Where("key = ?", name.String()).Delete(&models.Snapshot{})
+	return grpcErrorForDBError(ctx, errors.Wrapf(op.Error, "delete %s", name))
+}
+
+// SnapshotChanges lists the resources that were changed by restoring a snapshot.
+type SnapshotChanges struct {
+	Created []string
+	Updated []string
+	Deleted []string
+}
+
+// RestoreSnapshot replaces the rows of a project with the rows in a snapshot
+// and returns the resources that were changed. Resources in the trash are
+// permanently deleted. The project is created if it doesn't exist.
+func (c *Client) RestoreSnapshot(ctx context.Context, name names.Snapshot) (SnapshotChanges, error) {
+	rows, err := c.getSnapshotRows(ctx, name)
+	if err != nil {
+		return SnapshotChanges{}, err
+	}
+	project := name.Project()
+	current, err := c.readProjectRows(ctx, project)
+	if err != nil {
+		return SnapshotChanges{}, err
+	}
+
+	if err := c.purge(ctx, projectSubtree(project)); err != nil {
+		return SnapshotChanges{}, err
+	}
+	for _, table := range rows.tables() {
+		if reflect.ValueOf(table).Elem().Len() == 0 {
+			continue
+		}
+		op := c.db.WithContext(ctx).CreateInBatches(table, snapshotBatchSize)
+		if op.Error != nil {
+			return SnapshotChanges{}, grpcErrorForDBError(ctx, errors.Wrapf(op.Error, "restore %s", name))
+		}
+	}
+	if err := c.addReferences(ctx, rows.references()); err != nil {
+		return SnapshotChanges{}, err
+	}
+	if err := c.indexProjectRows(ctx, rows); err != nil {
+		return SnapshotChanges{}, err
+	}
+
+	return snapshotChanges(current.versions(), rows.versions()), nil
+}
+
+// indexProjectRows indexes the resources in the rows of a project for search.
+func (c *Client) indexProjectRows(ctx context.Context, rows *snapshotRows) error {
+	for i := range rows.Apis {
+		if err := c.indexApi(ctx, &rows.Apis[i]); err != nil {
+			return err
+		}
+	}
+	latest := make(map[string]*models.Spec)
+	for i := range rows.Specs {
+		v := &rows.Specs[i]
+		if l, ok := latest[v.Name()]; !ok || v.RevisionCreateTime.After(l.RevisionCreateTime) {
+			latest[v.Name()] = v
+		}
+	}
+	for _, v := range latest {
+		if err := c.reindexSpec(ctx, v); err != nil {
+			return err
+		}
+	}
+	for i := range rows.Artifacts {
+		if err := c.indexArtifact(ctx, &rows.Artifacts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotChanges compares the versions of resources before and after a
+// restore. Each list of changes is sorted by resource name.
+func snapshotChanges(before, after map[string]string) SnapshotChanges {
+	var changes SnapshotChanges
+	for name, v := range after {
+		if old, ok := before[name]; !ok {
+			changes.Created = append(changes.Created, name)
+		} else if old != v {
+			changes.Updated = append(changes.Updated, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changes.Deleted = append(changes.Deleted, name)
+		}
+	}
+	sort.Strings(changes.Created)
+	sort.Strings(changes.Updated)
+	sort.Strings(changes.Deleted)
+	return changes
+}
@@ -17,54 +17,29 @@ package registry
 import (
 	"context"
 
-	"cloud.google.com/go/pubsub"
 	"github.com/apigee/registry/pkg/log"
 	"github.com/apigee/registry/rpc"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
 )
 
 const TopicName = "registry-events"
 
-func (s *RegistryServer) notify(ctx context.Context, change rpc.Notification_Change, resource string) {
-	if !s.notifyEnabled {
-		return
-	}
-
-	logger := log.FromContext(ctx)
-	if s.projectID == "" {
-		logger.Warn("Notifications are enabled but project ID is not set. Skipping notification.")
-		return
-	}
-
-	client, err := s.getPubSubClient(ctx)
-	if err != nil {
-		logger.WithError(err).Error("Failed to get PubSub client.")
-		return
-	}
-
-	notification := &rpc.Notification{
-		Change:     change,
-		Resource:   resource,
-		ChangeTime: timestamppb.Now(),
-	}
-
-	msg, err := protojson.Marshal(notification)
-	if err != nil {
-		logger.WithError(err).Errorf("Failed to serialize notification: %v", notification)
-		return
-	}
-
-	topic := client.Topic(TopicName)
-	result := topic.Publish(ctx, &pubsub.Message{
-		Data: msg,
-	})
+// notify records a change in the outbox. It must be called in the transaction
+// that makes the change so that notifications are only sent for committed
+// changes. Recorded changes are delivered by the outbox dispatcher.
+func (s *RegistryServer) notify(ctx context.Context, db *storage.Client, change rpc.Notification_Change, resource string) error {
+	return db.RecordChange(ctx, models.NewChange(int32(change), resource))
+}
 
-	id, err := result.Get(ctx)
-	if err != nil {
-		logger.WithError(err).Error("Failed to publish notification.")
-		return
+// checkNotifications logs problems with the configuration of notifications.
+func (s *RegistryServer) checkNotifications(ctx context.Context) {
+	if s.notifyEnabled {
+		logger := log.FromContext(ctx)
+		if s.projectID == "" {
+			logger.Warn("Notifications are enabled but project ID is not set. Skipping notification.")
+		} else if _, err := s.getPubSubClient(ctx); err != nil {
+			logger.WithError(err).Error("Failed to get PubSub client.")
+		}
 	}
-
-	logger.Infof("Published notification with message ID: %s", id)
 }
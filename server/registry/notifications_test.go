@@ -24,6 +24,7 @@ import (
 	"cloud.google.com/go/pubsub/pstest"
 	"github.com/apigee/registry/pkg/log"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -46,7 +47,6 @@ func TestNotifications(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer server.Close()
 
 	topicName := fmt.Sprintf("projects/%s/topics/%s", projectID, TopicName)
 	topic, err := pubSubTest.GServer.GetTopic(ctx, &pubsub.GetTopicRequest{Topic: topicName})
@@ -57,7 +57,13 @@ func TestNotifications(t *testing.T) {
 		t.Errorf("Topic %q not found", TopicName)
 	}
 
-	server.notify(ctx, rpc.Notification_CREATED, "resource")
+	if err := server.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		return server.notify(ctx, db, rpc.Notification_CREATED, "resource")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Notifications are delivered asynchronously; closing the server waits for delivery.
+	server.Close()
 	pubSubTest.Wait()
 
 	ms := pubSubTest.Messages()
@@ -73,7 +79,7 @@ func TestNotificationErrors(t *testing.T) {
 	server := RegistryServer{
 		notifyEnabled: true,
 	}
-	server.notify(ctx, rpc.Notification_CREATED, "resource")
+	server.checkNotifications(ctx)
 
 	entry := rec.LastEntry()
 	want := "Notifications are enabled but project ID is not set. Skipping notification."
@@ -82,7 +88,7 @@ func TestNotificationErrors(t *testing.T) {
 	}
 
 	server.projectID = "id"
-	server.notify(ctx, rpc.Notification_CREATED, "resource")
+	server.checkNotifications(ctx)
 	entry = rec.LastEntry()
 	want = "Failed to get PubSub client."
 	if want != entry.Message() {
@@ -0,0 +1,221 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Notifier delivers notifications of changes to an external system.
+type Notifier interface {
+	// Notify delivers a notification. It is called from a single goroutine
+	// for each notifier and may block until the notification is delivered.
+	Notify(ctx context.Context, n *rpc.Notification) error
+	// Close releases any resources held by the notifier.
+	Close() error
+}
+
+const (
+	// defaultNotificationQueueSize is the number of notifications that can be
+	// waiting for delivery to a notifier.
+	defaultNotificationQueueSize = 1000
+	// notificationDrainTimeout bounds the time spent delivering queued
+	// notifications when the server is closed.
+	notificationDrainTimeout = 10 * time.Second
+)
+
+var errNotifierClosed = errors.New("notifier is closed")
+
+var (
+	notificationsDelivered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "registry_notifications_delivered_total",
+		Help: "Number of notifications delivered, by sink.",
+	}, []string{"sink"})
+	notificationsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "registry_notifications_failed_total",
+		Help: "Number of notifications that could not be delivered, by sink.",
+	}, []string{"sink"})
+	notificationsDeadLettered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "registry_notifications_dead_lettered_total",
+		Help: "Number of notifications abandoned after repeated delivery failures, by sink.",
+	}, []string{"sink"})
+	notificationQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "registry_notifications_queue_depth",
+		Help: "Number of notifications waiting for delivery, by sink.",
+	}, []string{"sink"})
+	notificationDeliverySeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "registry_notifications_delivery_seconds",
+		Help: "Time spent delivering notifications, including retries, by sink.",
+	}, []string{"sink"})
+)
+
+// queuedNotification is a notification waiting for delivery.
+// The result of the delivery is sent to result.
+type queuedNotification struct {
+	logger       log.Logger
+	notification *rpc.Notification
+	result       chan<- error
+}
+
+// notificationQueue delivers notifications to a notifier from a bounded queue
+// so that slow sinks don't delay other sinks.
+type notificationQueue struct {
+	name     string
+	notifier Notifier
+	events   chan queuedNotification
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func newNotificationQueue(name string, notifier Notifier, size int) *notificationQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &notificationQueue{
+		name:     name,
+		notifier: notifier,
+		events:   make(chan queuedNotification, size),
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+// enqueue adds a notification to the queue, waiting while the queue is full.
+func (q *notificationQueue) enqueue(ctx context.Context, e queuedNotification) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return errNotifierClosed
+	}
+	select {
+	case q.events <- e:
+		notificationQueueDepth.WithLabelValues(q.name).Inc()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *notificationQueue) run() {
+	defer close(q.done)
+	for e := range q.events {
+		notificationQueueDepth.WithLabelValues(q.name).Dec()
+		logger := e.logger.WithField("sink", q.name)
+		start := time.Now()
+		err := q.notifier.Notify(log.NewContext(q.ctx, logger), e.notification)
+		notificationDeliverySeconds.WithLabelValues(q.name).Observe(time.Since(start).Seconds())
+		if err != nil {
+			notificationsFailed.WithLabelValues(q.name).Inc()
+			logger.WithError(err).Errorf("Failed to deliver notification for %s.", e.notification.GetResource())
+		} else {
+			notificationsDelivered.WithLabelValues(q.name).Inc()
+		}
+		e.result <- err
+	}
+}
+
+// deliver queues a notification and waits for it to be handled.
+func (q *notificationQueue) deliver(ctx context.Context, n *rpc.Notification) error {
+	result := make(chan error, 1)
+	if err := q.enqueue(ctx, queuedNotification{logger: log.FromContext(ctx), notification: n, result: result}); err != nil {
+		return err
+	}
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close stops accepting notifications, delivers the ones already queued
+// and closes the notifier. Deliveries still in progress after the drain
+// timeout are canceled.
+func (q *notificationQueue) close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	close(q.events)
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+	case <-time.After(notificationDrainTimeout):
+		q.cancel()
+		<-q.done
+	}
+	q.cancel()
+	return q.notifier.Close()
+}
+
+// notificationDispatcher fans notifications out to a queue for each notifier.
+type notificationDispatcher struct {
+	queues []*notificationQueue
+}
+
+func newNotificationDispatcher(notifiers map[string]Notifier, size int) *notificationDispatcher {
+	if len(notifiers) == 0 {
+		return nil
+	}
+	if size <= 0 {
+		size = defaultNotificationQueueSize
+	}
+	d := &notificationDispatcher{}
+	for name, n := range notifiers {
+		d.queues = append(d.queues, newNotificationQueue(name, n, size))
+	}
+	return d
+}
+
+// sinks returns the queues of the notifiers.
+func (d *notificationDispatcher) sinks() []*notificationQueue {
+	if d == nil {
+		return nil
+	}
+	return d.queues
+}
+
+func (d *notificationDispatcher) close() {
+	if d == nil {
+		return
+	}
+	var wg sync.WaitGroup
+	for _, q := range d.queues {
+		wg.Add(1)
+		go func(q *notificationQueue) {
+			defer wg.Done()
+			if err := q.close(); err != nil {
+				log.NewLogger().WithError(err).WithField("sink", q.name).Error("Failed to close notifier.")
+			}
+		}(q)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Publisher publishes messages to a message broker.
+// It is the extension point for delivering notifications to brokers like
+// NATS or Kafka: wrap the broker's client library in a Publisher and pass
+// it to NewBrokerNotifier.
+type Publisher interface {
+	// Publish sends data to subscribers of a subject and returns once
+	// the broker has accepted it.
+	Publish(ctx context.Context, subject string, data []byte) error
+	// Close releases any resources held by the publisher.
+	Close() error
+}
+
+type brokerNotifier struct {
+	publisher Publisher
+	subject   string
+}
+
+// NewBrokerNotifier returns a Notifier that publishes notifications as JSON
+// to a subject using any message broker that has a Publisher.
+func NewBrokerNotifier(p Publisher, subject string) Notifier {
+	return &brokerNotifier{publisher: p, subject: subject}
+}
+
+func (b *brokerNotifier) Notify(ctx context.Context, n *rpc.Notification) error {
+	data, err := protojson.Marshal(n)
+	if err != nil {
+		return err
+	}
+	return b.publisher.Publish(ctx, b.subject, data)
+}
+
+func (b *brokerNotifier) Close() error {
+	return b.publisher.Close()
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type fileNotifier struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileNotifier returns a Notifier that appends notifications to a file
+// as JSON, one per line.
+func NewFileNotifier(path string) (Notifier, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileNotifier{file: f}, nil
+}
+
+func (f *fileNotifier) Notify(ctx context.Context, n *rpc.Notification) error {
+	b, err := protojson.Marshal(n)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err = f.file.Write(append(b, '\n'))
+	return err
+}
+
+func (f *fileNotifier) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
@@ -0,0 +1,51 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// pubsubNotifier publishes notifications to the Pub/Sub topic named TopicName.
+type pubsubNotifier struct {
+	topic *pubsub.Topic
+}
+
+func newPubsubNotifier(client *pubsub.Client) Notifier {
+	return &pubsubNotifier{topic: client.Topic(TopicName)}
+}
+
+func (p *pubsubNotifier) Notify(ctx context.Context, n *rpc.Notification) error {
+	msg, err := protojson.Marshal(n)
+	if err != nil {
+		return err
+	}
+	id, err := p.topic.Publish(ctx, &pubsub.Message{Data: msg}).Get(ctx)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Infof("Published notification with message ID: %s", id)
+	return nil
+}
+
+func (p *pubsubNotifier) Close() error {
+	p.topic.Stop()
+	return nil
+}
@@ -0,0 +1,338 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// recordingNotifier records notifications, optionally blocking until released.
+type recordingNotifier struct {
+	mu      sync.Mutex
+	got     []*rpc.Notification
+	release chan struct{}
+	closed  bool
+}
+
+func (r *recordingNotifier) Notify(ctx context.Context, n *rpc.Notification) error {
+	if r.release != nil {
+		<-r.release
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.got = append(r.got, n)
+	return nil
+}
+
+func (r *recordingNotifier) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	return nil
+}
+
+func (r *recordingNotifier) resources() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var names []string
+	for _, n := range r.got {
+		names = append(names, n.GetResource())
+	}
+	return names
+}
+
+func TestNotifiersReceiveChanges(t *testing.T) {
+	ctx := context.Background()
+	sink := &recordingNotifier{}
+	server, err := New(Config{
+		Database:  "sqlite3",
+		DBConfig:  fmt.Sprintf("%s/registry.db", t.TempDir()),
+		Notifiers: map[string]Notifier{"test": sink},
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "p"}); err != nil {
+		t.Fatalf("Setup: CreateProject() returned error: %s", err)
+	}
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: "projects/p"}); err != nil {
+		t.Fatalf("Setup: DeleteProject() returned error: %s", err)
+	}
+	server.Close()
+
+	want := []string{"projects/p", "projects/p"}
+	if diff := cmp.Diff(want, sink.resources()); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+	if !sink.closed {
+		t.Errorf("Notifier was not closed when the server was closed")
+	}
+}
+
+// failingNotifier fails to deliver notifications.
+type failingNotifier struct{}
+
+func (failingNotifier) Notify(ctx context.Context, n *rpc.Notification) error {
+	return errors.New("failed")
+}
+
+func (failingNotifier) Close() error {
+	return nil
+}
+
+func TestNotificationQueueDeliver(t *testing.T) {
+	sink := &recordingNotifier{}
+	d := newNotificationDispatcher(map[string]Notifier{
+		"recording": sink,
+		"failing":   failingNotifier{},
+	}, 1)
+	defer d.close()
+
+	notifications := []*rpc.Notification{{Resource: "projects/1"}, {Resource: "projects/2"}, {Resource: "projects/3"}}
+	for _, q := range d.sinks() {
+		for i, n := range notifications {
+			err := q.deliver(context.Background(), n)
+			if q.name == "failing" && err == nil {
+				t.Errorf("deliver() returned no error for notification %d, expected failure", i)
+			} else if q.name == "recording" && err != nil {
+				t.Errorf("deliver() returned error for notification %d: %s", i, err)
+			}
+		}
+	}
+
+	want := []string{"projects/1", "projects/2", "projects/3"}
+	if diff := cmp.Diff(want, sink.resources()); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestNotificationQueueFull(t *testing.T) {
+	sink := &recordingNotifier{release: make(chan struct{})}
+	q := newNotificationQueue("test", sink, 1)
+	logger := log.NewLogger()
+	results := make(chan error, 3)
+
+	// The worker takes the first notification and blocks, then the second fills the queue.
+	for _, name := range []string{"projects/1", "projects/2"} {
+		if err := q.enqueue(context.Background(), queuedNotification{logger: logger, notification: &rpc.Notification{Resource: name}, result: results}); err != nil {
+			t.Fatalf("enqueue(%q) returned error: %s", name, err)
+		}
+		for deadline := time.Now().Add(5 * time.Second); len(q.events) > 0 && name == "projects/1" && time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := q.enqueue(ctx, queuedNotification{logger: logger, notification: &rpc.Notification{Resource: "projects/3"}, result: results}); err != context.DeadlineExceeded {
+		t.Errorf("enqueue() to a full queue returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(sink.release)
+	if err := q.close(); err != nil {
+		t.Fatalf("close() returned error: %s", err)
+	}
+	if err := q.enqueue(context.Background(), queuedNotification{logger: logger, notification: &rpc.Notification{}, result: results}); err != errNotifierClosed {
+		t.Errorf("enqueue() to a closed queue returned %v, want %v", err, errNotifierClosed)
+	}
+
+	want := []string{"projects/1", "projects/2"}
+	if diff := cmp.Diff(want, sink.resources()); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	n := &rpc.Notification{Change: rpc.Notification_UPDATED, Resource: "projects/p"}
+	secret := "my-secret"
+
+	var mu sync.Mutex
+	attempts := 0
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Failed to read request: %s", err)
+		}
+		if got, want := r.Header.Get(WebhookSignatureHeader), SignWebhook(secret, body); got != want {
+			t.Errorf("Request has signature %q, want %q", got, want)
+		}
+		got := &rpc.Notification{}
+		if err := protojson.Unmarshal(body, got); err != nil {
+			t.Errorf("Failed to parse request: %s", err)
+		}
+		if diff := cmp.Diff(n, got, protocmp.Transform()); diff != "" {
+			t.Errorf("Webhook received unexpected diff (-want +got):\n%s", diff)
+		}
+	}))
+	defer hook.Close()
+
+	notifier := NewWebhookNotifier(WebhookConfig{
+		URL:            hook.URL,
+		Secret:         secret,
+		InitialBackoff: time.Millisecond,
+	})
+	defer notifier.Close()
+	if err := notifier.Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify() returned error: %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Webhook received %d attempts, want %d", attempts, 3)
+	}
+}
+
+func TestWebhookNotifierErrors(t *testing.T) {
+	tests := []struct {
+		desc   string
+		status int
+		want   int
+	}{
+		{desc: "retries server errors", status: http.StatusInternalServerError, want: 3},
+		{desc: "does not retry client errors", status: http.StatusBadRequest, want: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var mu sync.Mutex
+			attempts := 0
+			hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				attempts++
+				w.WriteHeader(test.status)
+			}))
+			defer hook.Close()
+
+			notifier := NewWebhookNotifier(WebhookConfig{
+				URL:            hook.URL,
+				MaxAttempts:    3,
+				InitialBackoff: time.Millisecond,
+			})
+			defer notifier.Close()
+			if err := notifier.Notify(context.Background(), &rpc.Notification{}); err == nil {
+				t.Errorf("Notify() succeeded, expected error")
+			}
+			if attempts != test.want {
+				t.Errorf("Webhook received %d attempts, want %d", attempts, test.want)
+			}
+		})
+	}
+}
+
+func TestFileNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	want := []*rpc.Notification{
+		{Change: rpc.Notification_CREATED, Resource: "projects/a"},
+		{Change: rpc.Notification_DELETED, Resource: "projects/b"},
+	}
+
+	// Notifications are appended across restarts.
+	for _, n := range want {
+		notifier, err := NewFileNotifier(path)
+		if err != nil {
+			t.Fatalf("NewFileNotifier(%q) returned error: %s", path, err)
+		}
+		if err := notifier.Notify(context.Background(), n); err != nil {
+			t.Fatalf("Notify() returned error: %s", err)
+		}
+		if err := notifier.Close(); err != nil {
+			t.Fatalf("Close() returned error: %s", err)
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %q: %s", path, err)
+	}
+	var got []*rpc.Notification
+	for _, line := range strings.Split(strings.TrimSuffix(string(b), "\n"), "\n") {
+		n := &rpc.Notification{}
+		if err := protojson.Unmarshal([]byte(line), n); err != nil {
+			t.Fatalf("Failed to parse line %q: %s", line, err)
+		}
+		got = append(got, n)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("File contains unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+// fakePublisher records the messages published with it.
+type fakePublisher struct {
+	subjects []string
+	messages [][]byte
+	closed   bool
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, subject string, data []byte) error {
+	p.subjects = append(p.subjects, subject)
+	p.messages = append(p.messages, data)
+	return nil
+}
+
+func (p *fakePublisher) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestBrokerNotifier(t *testing.T) {
+	publisher := &fakePublisher{}
+	notifier := NewBrokerNotifier(publisher, "registry.events")
+
+	n := &rpc.Notification{Change: rpc.Notification_CREATED, Resource: "projects/p"}
+	if err := notifier.Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify() returned error: %s", err)
+	}
+	if err := notifier.Close(); err != nil {
+		t.Fatalf("Close() returned error: %s", err)
+	}
+	if !publisher.closed {
+		t.Errorf("Close() did not close the publisher")
+	}
+	if len(publisher.messages) != 1 {
+		t.Fatalf("Notify() published %d messages, want 1", len(publisher.messages))
+	}
+	if publisher.subjects[0] != "registry.events" {
+		t.Errorf("Message published to %q, want %q", publisher.subjects[0], "registry.events")
+	}
+	m := &rpc.Notification{}
+	if err := protojson.Unmarshal(publisher.messages[0], m); err != nil {
+		t.Fatalf("Failed to parse message %q: %s", publisher.messages[0], err)
+	}
+	if diff := cmp.Diff(n, m, protocmp.Transform()); diff != "" {
+		t.Errorf("Message has unexpected diff (-want +got):\n%s", diff)
+	}
+}
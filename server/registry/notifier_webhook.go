@@ -0,0 +1,143 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// WebhookSignatureHeader contains the hex-encoded HMAC-SHA256 of the request
+// body computed with the webhook secret, prefixed with "sha256=".
+const WebhookSignatureHeader = "X-Registry-Signature"
+
+// WebhookConfig configures a webhook notifier.
+type WebhookConfig struct {
+	// URL receives notifications as JSON in POST requests.
+	URL string
+	// Secret is used to sign requests. If empty, requests are not signed.
+	Secret string
+	// MaxAttempts is the number of times delivery is attempted. Default: 5.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, which doubles
+	// with each subsequent retry. Default: 500ms.
+	InitialBackoff time.Duration
+	// Timeout bounds each attempt. Default: 10s.
+	Timeout time.Duration
+}
+
+// maxWebhookBackoff bounds the delay between webhook retries.
+const maxWebhookBackoff = 30 * time.Second
+
+type webhookNotifier struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+// NewWebhookNotifier returns a Notifier that posts notifications to a URL,
+// retrying failed requests with exponential backoff.
+func NewWebhookNotifier(config WebhookConfig) Notifier {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = 500 * time.Millisecond
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	return &webhookNotifier{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// SignWebhook returns the value of the signature header for a request body.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n *rpc.Notification) error {
+	body, err := protojson.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	backoff := w.config.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt == w.config.MaxAttempts {
+			return fmt.Errorf("webhook delivery failed after %d attempts: %w", attempt, err)
+		}
+		log.FromContext(ctx).WithError(err).Debugf("Webhook delivery failed, retrying in %s.", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxWebhookBackoff {
+			backoff = maxWebhookBackoff
+		}
+	}
+}
+
+// post sends a single request and reports whether a failure may be retried.
+func (w *webhookNotifier) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.config.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhook(w.config.Secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected response status %q", resp.Status)
+	default:
+		return false, fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+}
+
+func (w *webhookNotifier) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,354 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// outboxPollInterval is the time between checks for changes that were
+	// committed without waking the dispatcher, e.g. by other server instances.
+	outboxPollInterval = time.Second
+	// outboxMaxBackoff bounds the time between attempts after failures.
+	outboxMaxBackoff = time.Minute
+	// outboxBatchSize is the number of changes handled at a time.
+	outboxBatchSize = 100
+	// outboxRetention is the time delivered changes are kept for replay.
+	outboxRetention = 24 * time.Hour
+	// outboxPruneInterval is the time between removals of expired changes.
+	outboxPruneInterval = time.Hour
+	// outboxMaxAttempts is the number of times a notifier tries to deliver
+	// a change before it gives up and moves on to the next one.
+	outboxMaxAttempts = 20
+)
+
+// outbox delivers the changes recorded by notify after their transactions commit.
+// Changes are delivered at least once, in the order of their sequence numbers.
+type outbox struct {
+	wake    chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	watched int64     // sequence number of the last change published to watchers
+	pruned  time.Time // time of the last removal of expired changes
+
+	mu      sync.Mutex
+	backoff map[string]*sinkBackoff // notifiers waiting to retry, by name
+}
+
+// sinkBackoff delays retries of a notifier that failed to deliver a change.
+type sinkBackoff struct {
+	delay time.Duration
+	until time.Time
+}
+
+// sinkError reports changes that a notifier failed to deliver. The notifier
+// retries them after its own backoff, without delaying other notifiers.
+type sinkError struct {
+	sink string
+	err  error
+}
+
+func (e *sinkError) Error() string {
+	return fmt.Sprintf("failed to deliver changes to %s: %s", e.sink, e.err)
+}
+
+func (e *sinkError) Unwrap() error {
+	return e.err
+}
+
+// ready returns true if a notifier isn't waiting to retry.
+func (o *outbox) ready(sink string, now time.Time) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	b := o.backoff[sink]
+	return b == nil || !now.Before(b.until)
+}
+
+// failed delays the next attempt of a notifier and returns the delay.
+func (o *outbox) failed(sink string, now time.Time) time.Duration {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	b := o.backoff[sink]
+	if b == nil {
+		b = &sinkBackoff{delay: outboxPollInterval}
+		o.backoff[sink] = b
+	}
+	if b.delay *= 2; b.delay > outboxMaxBackoff {
+		b.delay = outboxMaxBackoff
+	}
+	b.until = now.Add(b.delay)
+	return b.delay
+}
+
+// succeeded ends the backoff of a notifier.
+func (o *outbox) succeeded(sink string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.backoff, sink)
+}
+
+// startOutbox starts a dispatcher for the changes recorded in the database.
+// Changes recorded before the server started are delivered to notifiers but
+// not published to watchers, which read them from the outbox when they resume.
+func (s *RegistryServer) startOutbox(ctx context.Context) error {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return err
+	}
+	last, err := db.LastChangeSequence(ctx)
+	if err != nil {
+		return err
+	}
+	o := &outbox{
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+		watched: last,
+		backoff: make(map[string]*sinkBackoff),
+	}
+	go s.runOutbox(o)
+	s.outbox = o
+	return nil
+}
+
+// signal wakes the dispatcher to deliver newly committed changes.
+func (o *outbox) signal() {
+	if o == nil {
+		return
+	}
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// stopOutbox stops the dispatcher and delivers any remaining changes.
+func (s *RegistryServer) stopOutbox() {
+	o := s.outbox
+	if o == nil {
+		return
+	}
+	s.outbox = nil
+	close(o.stop)
+	<-o.done
+	ctx := context.Background()
+	if err := s.dispatchChanges(ctx, o); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to deliver changes.")
+	}
+}
+
+func (s *RegistryServer) runOutbox(o *outbox) {
+	defer close(o.done)
+	ctx := context.Background()
+	logger := log.FromContext(ctx)
+	delay := outboxPollInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-o.stop:
+			return
+		case <-o.wake:
+			if delay > outboxPollInterval {
+				continue // wait for the backoff to expire
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-timer.C:
+		}
+
+		// Notifiers that fail are retried after their own backoff, which
+		// is logged when they fail. Other errors delay all deliveries.
+		var failed *sinkError
+		if err := s.dispatchChanges(ctx, o); err != nil && !errors.As(err, &failed) {
+			if delay *= 2; delay > outboxMaxBackoff {
+				delay = outboxMaxBackoff
+			}
+			logger.WithError(err).Warnf("Failed to deliver changes, retrying in %s.", delay)
+		} else {
+			delay = outboxPollInterval
+		}
+		timer.Reset(delay)
+	}
+}
+
+// dispatchChanges sequences committed changes, publishes them to watchers
+// and delivers them to notifiers. Each notifier receives the changes in order
+// from its own position, so a notifier that fails doesn't delay the others.
+// Changes that a notifier fails to deliver are retried, and may be delivered
+// more than once; after outboxMaxAttempts failures the notifier skips them.
+// Changes are marked delivered once every notifier has handled them.
+func (s *RegistryServer) dispatchChanges(ctx context.Context, o *outbox) error {
+	for {
+		var n int
+		if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			var err error
+			n, err = db.SequenceChanges(ctx, outboxBatchSize)
+			return err
+		}); err != nil {
+			return err
+		}
+		if n < outboxBatchSize {
+			break
+		}
+	}
+
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		changes, err := db.ListChanges(ctx, o.watched, outboxBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			s.watchers.publish(changeNotification(c))
+			o.watched = *c.Sequence
+		}
+		if len(changes) < outboxBatchSize {
+			break
+		}
+	}
+
+	through, err := db.LastChangeSequence(ctx)
+	if err != nil {
+		return err
+	}
+	sinks := s.notifications.sinks()
+	if len(sinks) > 0 {
+		s.checkNotifications(ctx)
+	}
+	cursors := make([]*models.NotificationCursor, len(sinks))
+	errs := make([]error, len(sinks))
+	var wg sync.WaitGroup
+	for i, q := range sinks {
+		wg.Add(1)
+		go func(i int, q *notificationQueue) {
+			defer wg.Done()
+			cursors[i], errs[i] = s.deliverChanges(ctx, o, q)
+		}(i, q)
+	}
+	wg.Wait()
+	for i, c := range cursors {
+		var failed *sinkError
+		if errs[i] != nil && !errors.As(errs[i], &failed) {
+			return errs[i]
+		}
+		if c.Sequence < through {
+			through = c.Sequence
+		}
+	}
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		return db.MarkChangesDelivered(ctx, through)
+	}); err != nil {
+		return err
+	}
+
+	if time.Since(o.pruned) > outboxPruneInterval {
+		if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			_, err := db.DeleteDeliveredChanges(ctx, time.Now().Add(-outboxRetention))
+			return err
+		}); err != nil {
+			return err
+		}
+		o.pruned = time.Now()
+	}
+	return errors.Join(errs...)
+}
+
+// deliverChanges delivers the changes that follow the position of a notifier
+// and returns its new position. It stops at the first change that the
+// notifier fails to deliver, unless the notifier has failed to deliver it
+// outboxMaxAttempts times. Notifiers waiting to retry are skipped.
+func (s *RegistryServer) deliverChanges(ctx context.Context, o *outbox, q *notificationQueue) (*models.NotificationCursor, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := db.GetNotificationCursor(ctx, q.name)
+	if err != nil {
+		return nil, err
+	}
+	if !o.ready(q.name, time.Now()) {
+		return cursor, nil
+	}
+	logger := log.FromContext(ctx).WithField("sink", q.name)
+	save := func() error {
+		return s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			return db.SaveNotificationCursor(ctx, cursor)
+		})
+	}
+	for {
+		changes, err := db.ListChanges(ctx, cursor.Sequence, outboxBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			if err := q.deliver(ctx, changeNotification(c)); err != nil {
+				cursor.Attempts++
+				if cursor.Attempts < outboxMaxAttempts {
+					if err := save(); err != nil {
+						return nil, err
+					}
+					delay := o.failed(q.name, time.Now())
+					logger.WithError(err).Warnf("Failed to deliver changes, retrying in %s.", delay)
+					return cursor, &sinkError{sink: q.name, err: err}
+				}
+				notificationsDeadLettered.WithLabelValues(q.name).Inc()
+				logger.WithError(err).Errorf("Abandoned notification %d for %s after %d attempts.", *c.Sequence, c.Resource, cursor.Attempts)
+			}
+			cursor.Sequence = *c.Sequence
+			cursor.Attempts = 0
+		}
+		if len(changes) > 0 {
+			if err := save(); err != nil {
+				return nil, err
+			}
+		}
+		if len(changes) < outboxBatchSize {
+			o.succeeded(q.name)
+			return cursor, nil
+		}
+	}
+}
+
+func changeNotification(c *models.Change) *rpc.Notification {
+	n := &rpc.Notification{
+		Change:     rpc.Notification_Change(c.Type),
+		Resource:   c.Resource,
+		ChangeTime: timestamppb.New(c.ChangeTime),
+	}
+	if c.Sequence != nil {
+		n.Sequence = *c.Sequence
+	}
+	return n
+}
@@ -0,0 +1,280 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakyNotifier records notifications and fails while fail is set.
+type flakyNotifier struct {
+	mu   sync.Mutex
+	fail bool
+	got  []int64
+}
+
+func (f *flakyNotifier) Notify(ctx context.Context, n *rpc.Notification) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.got = append(f.got, n.GetSequence())
+	if f.fail {
+		return errors.New("failed")
+	}
+	return nil
+}
+
+func (f *flakyNotifier) Close() error {
+	return nil
+}
+
+func (f *flakyNotifier) sequences() []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]int64(nil), f.got...)
+}
+
+func serverWithNotifier(t *testing.T, n Notifier) *RegistryServer {
+	t.Helper()
+	server, err := New(Config{
+		Database:  "sqlite3",
+		DBConfig:  fmt.Sprintf("%s/registry.db", t.TempDir()),
+		Notifiers: map[string]Notifier{"test": n},
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	return server
+}
+
+func TestOutboxOnlyDeliversCommittedChanges(t *testing.T) {
+	ctx := context.Background()
+	sink := &recordingNotifier{}
+	server := serverWithNotifier(t, sink)
+
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "p"}); err != nil {
+		t.Fatalf("Setup: CreateProject() returned error: %s", err)
+	}
+	// A failed request doesn't produce a notification.
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "p"}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("Setup: CreateProject() returned status code %q, want %q: %v", status.Code(err), codes.AlreadyExists, err)
+	}
+	// Neither does a change recorded in a transaction that is rolled back.
+	if err := server.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := server.notify(ctx, db, rpc.Notification_UPDATED, "projects/q"); err != nil {
+			return err
+		}
+		return status.Error(codes.Aborted, "rollback")
+	}); status.Code(err) != codes.Aborted {
+		t.Fatalf("Setup: runInTransaction() returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+	}
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: "projects/p"}); err != nil {
+		t.Fatalf("Setup: DeleteProject() returned error: %s", err)
+	}
+	server.Close()
+
+	type change struct {
+		Change   rpc.Notification_Change
+		Resource string
+		Sequence int64
+	}
+	var got []change
+	for _, n := range sink.got {
+		got = append(got, change{Change: n.GetChange(), Resource: n.GetResource(), Sequence: n.GetSequence()})
+	}
+	want := []change{
+		{Change: rpc.Notification_CREATED, Resource: "projects/p", Sequence: 1},
+		{Change: rpc.Notification_DELETED, Resource: "projects/p", Sequence: 2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutboxRedeliversFailedChanges(t *testing.T) {
+	ctx := context.Background()
+	sink := &flakyNotifier{fail: true}
+	server := serverWithNotifier(t, sink)
+	defer server.Close()
+
+	// Stop the background dispatcher so that delivery can be controlled.
+	o := server.outbox
+	server.stopOutbox()
+	sink.mu.Lock()
+	sink.got = nil
+	sink.mu.Unlock()
+
+	for _, id := range []string{"a", "b"} {
+		if err := server.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			return server.notify(ctx, db, rpc.Notification_CREATED, "projects/"+id)
+		}); err != nil {
+			t.Fatalf("Setup: runInTransaction() returned error: %s", err)
+		}
+	}
+
+	if err := server.dispatchChanges(ctx, o); err == nil {
+		t.Errorf("dispatchChanges() succeeded, expected error")
+	}
+	sink.mu.Lock()
+	sink.fail = false
+	sink.mu.Unlock()
+	// The notifier is retried after its backoff.
+	o.succeeded("test")
+	if err := server.dispatchChanges(ctx, o); err != nil {
+		t.Errorf("dispatchChanges() returned error: %s", err)
+	}
+	// Delivered changes are not delivered again.
+	if err := server.dispatchChanges(ctx, o); err != nil {
+		t.Errorf("dispatchChanges() returned error: %s", err)
+	}
+
+	// Delivery stops at the first failure, so the second change was only
+	// delivered after the first succeeded.
+	want := []int64{1, 1, 2}
+	if diff := cmp.Diff(want, sink.sequences()); diff != "" {
+		t.Errorf("Notifier received unexpected sequences (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutboxSequenceContinuesAfterPruning(t *testing.T) {
+	ctx := context.Background()
+	sink := &flakyNotifier{}
+	server := serverWithNotifier(t, sink)
+	defer server.Close()
+	o := server.outbox
+	server.stopOutbox()
+
+	record := func(resource string) {
+		t.Helper()
+		if err := server.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			return server.notify(ctx, db, rpc.Notification_CREATED, resource)
+		}); err != nil {
+			t.Fatalf("Setup: runInTransaction() returned error: %s", err)
+		}
+		if err := server.dispatchChanges(ctx, o); err != nil {
+			t.Fatalf("Setup: dispatchChanges() returned error: %s", err)
+		}
+	}
+	record("projects/a")
+	record("projects/b")
+
+	// Remove all delivered changes; the last one is kept.
+	var deleted int64
+	if err := server.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		var err error
+		deleted, err = db.DeleteDeliveredChanges(ctx, time.Now().Add(time.Hour))
+		return err
+	}); err != nil {
+		t.Fatalf("DeleteDeliveredChanges() returned error: %s", err)
+	}
+	if deleted != 1 {
+		t.Errorf("DeleteDeliveredChanges() removed %d changes, want %d", deleted, 1)
+	}
+	record("projects/c")
+
+	want := []int64{1, 2, 3}
+	if diff := cmp.Diff(want, sink.sequences()); diff != "" {
+		t.Errorf("Notifier received unexpected sequences (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutboxIsolatesFailingNotifiers(t *testing.T) {
+	ctx := context.Background()
+	healthy := &flakyNotifier{}
+	failing := &flakyNotifier{fail: true}
+	server, err := New(Config{
+		Database:  "sqlite3",
+		DBConfig:  fmt.Sprintf("%s/registry.db", t.TempDir()),
+		Notifiers: map[string]Notifier{"healthy": healthy, "failing": failing},
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	defer server.Close()
+	o := server.outbox
+	server.stopOutbox()
+	for _, f := range []*flakyNotifier{healthy, failing} {
+		f.mu.Lock()
+		f.got = nil
+		f.mu.Unlock()
+	}
+
+	record := func(resource string) {
+		t.Helper()
+		if err := server.runInTransaction(ctx, func(ctx context.Context, db *storage.Client) error {
+			return server.notify(ctx, db, rpc.Notification_CREATED, resource)
+		}); err != nil {
+			t.Fatalf("Setup: runInTransaction() returned error: %s", err)
+		}
+	}
+	record("projects/a")
+	record("projects/b")
+	if err := server.dispatchChanges(ctx, o); err == nil {
+		t.Errorf("dispatchChanges() succeeded, expected error")
+	}
+	// The failing notifier waits for its backoff, while the healthy one
+	// receives new changes without receiving the old ones again.
+	record("projects/c")
+	if err := server.dispatchChanges(ctx, o); err != nil {
+		t.Errorf("dispatchChanges() returned error: %s", err)
+	}
+	if diff := cmp.Diff([]int64{1, 2, 3}, healthy.sequences()); diff != "" {
+		t.Errorf("Healthy notifier received unexpected sequences (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int64{1}, failing.sequences()); diff != "" {
+		t.Errorf("Failing notifier received unexpected sequences (-want +got):\n%s", diff)
+	}
+
+	// After too many attempts, the failing notifier gives up on a change
+	// and moves on to the next one.
+	db, err := server.getStorageClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: getStorageClient() returned error: %s", err)
+	}
+	cursor, err := db.GetNotificationCursor(ctx, "failing")
+	if err != nil {
+		t.Fatalf("GetNotificationCursor() returned error: %s", err)
+	}
+	cursor.Attempts = outboxMaxAttempts - 1
+	if err := server.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		return db.SaveNotificationCursor(ctx, cursor)
+	}); err != nil {
+		t.Fatalf("SaveNotificationCursor() returned error: %s", err)
+	}
+	o.succeeded("failing")
+	if err := server.dispatchChanges(ctx, o); err == nil {
+		t.Errorf("dispatchChanges() succeeded, expected error")
+	}
+	if diff := cmp.Diff([]int64{1, 1, 2}, failing.sequences()); diff != "" {
+		t.Errorf("Failing notifier received unexpected sequences (-want +got):\n%s", diff)
+	}
+	cursor, err = db.GetNotificationCursor(ctx, "failing")
+	if err != nil {
+		t.Fatalf("GetNotificationCursor() returned error: %s", err)
+	}
+	if cursor.Sequence != 1 || cursor.Attempts != 1 {
+		t.Errorf("GetNotificationCursor() returned sequence %d with %d attempts, want 1 with 1", cursor.Sequence, cursor.Attempts)
+	}
+}
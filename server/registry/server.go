@@ -19,13 +19,15 @@ import (
 	"errors"
 	"log"
 	"net"
-	"sync"
+	"time"
 
 	"cloud.google.com/go/pubsub"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/auth"
 	"github.com/apigee/registry/server/registry/internal/storage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
@@ -39,6 +41,26 @@ type Config struct {
 	Notify    bool
 	ProjectID string
 	NoMigrate bool
+	// Notifiers receive notifications of changes, keyed by names
+	// that identify them in logs and metrics.
+	Notifiers map[string]Notifier
+	// NotificationQueueSize is the number of notifications that can be
+	// waiting for delivery to each notifier. When a queue is full, delivery
+	// waits; changes stay in the outbox until they are delivered.
+	NotificationQueueSize int
+	// BlobStore configures storage of spec and artifact contents.
+	BlobStore BlobStoreConfig
+	// DeleteRetention is the time that deleted resources
+	// are kept in the trash before they are purged. Default: 30 days.
+	DeleteRetention time.Duration
+	// Authenticators identify the callers of RPCs. If none are set,
+	// calls are not authenticated.
+	Authenticators []auth.Authenticator
+	// Policy grants roles in projects to authenticated callers. If it is
+	// nil, calls are not authorized.
+	Policy *auth.Policy
+	// TLS configures TLS for listeners that are started with ServeGRPC.
+	TLS TLSConfig
 }
 
 // RegistryServer implements a Registry server.
@@ -49,6 +71,15 @@ type RegistryServer struct {
 	projectID     string
 	storageClient *storage.Client
 	pubSubClient  *pubsub.Client
+	watchers      *watchHub
+	notifications *notificationDispatcher
+	outbox        *outbox
+	purger        *purger
+
+	deleteRetention time.Duration
+	authenticators  []auth.Authenticator
+	policy          *auth.Policy
+	tls             *tlsFiles
 
 	rpc.UnimplementedRegistryServer
 	rpc.UnimplementedAdminServer
@@ -60,6 +91,23 @@ func New(config Config) (*RegistryServer, error) {
 		dbConfig:      config.DBConfig,
 		notifyEnabled: config.Notify,
 		projectID:     config.ProjectID,
+		watchers:      newWatchHub(),
+
+		deleteRetention: config.DeleteRetention,
+		authenticators:  config.Authenticators,
+		policy:          config.Policy,
+	}
+	if s.deleteRetention <= 0 {
+		s.deleteRetention = defaultDeleteRetention
+	}
+
+	if config.TLS.CertFile != "" {
+		var err error
+		if s.tls, err = newTLSFiles(config.TLS); err != nil {
+			return nil, err
+		}
+	} else if config.TLS.KeyFile != "" || config.TLS.ClientCAFile != "" {
+		return nil, errors.New("TLS requires a certificate file")
 	}
 
 	if s.database == "" {
@@ -73,6 +121,11 @@ func New(config Config) (*RegistryServer, error) {
 	if err != nil {
 		return nil, err
 	}
+	blobs, err := newBlobStore(config.BlobStore)
+	if err != nil {
+		return nil, err
+	}
+	s.storageClient.SetBlobStore(blobs)
 	if err := s.storageClient.EnsureTables(ctx); err != nil {
 		return nil, err
 	}
@@ -92,38 +145,69 @@ func New(config Config) (*RegistryServer, error) {
 		}
 	}
 
+	notifiers := make(map[string]Notifier, len(config.Notifiers)+1)
+	for name, n := range config.Notifiers {
+		notifiers[name] = n
+	}
+	if s.pubSubClient != nil {
+		if _, ok := notifiers["pubsub"]; ok {
+			return nil, errors.New(`notifier name "pubsub" is reserved`)
+		}
+		notifiers["pubsub"] = newPubsubNotifier(s.pubSubClient)
+	}
+	s.notifications = newNotificationDispatcher(notifiers, config.NotificationQueueSize)
+	if err := s.startOutbox(ctx); err != nil {
+		return nil, err
+	}
+	s.startPurger()
+
 	return s, nil
 }
 
+// transactionKey is the context key of the storage client of the
+// transaction that a handler is running in, if any.
+type transactionKey struct{}
+
 func (s *RegistryServer) getStorageClient(ctx context.Context) (*storage.Client, error) {
+	if db, ok := ctx.Value(transactionKey{}).(*storage.Client); ok {
+		return db, nil
+	}
 	if s.storageClient == nil {
 		return nil, errors.New("no storageClient")
 	}
 	return s.storageClient, nil
 }
 
-var mutex sync.Mutex
-
 func (s *RegistryServer) runInTransaction(ctx context.Context, fn func(ctx context.Context, db *storage.Client) error) error {
-	s.begin()
-	defer s.end()
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return status.Error(codes.Unavailable, err.Error())
+	if err := s.transaction(ctx, fn); err != nil {
+		return err
 	}
-	return db.Transaction(ctx, fn)
+	// Deliver any changes recorded in the transaction.
+	s.outbox.signal()
+	return nil
 }
 
-func (s *RegistryServer) begin() {
-	if s.database == "sqlite3" {
-		mutex.Lock()
+func (s *RegistryServer) transaction(ctx context.Context, fn func(ctx context.Context, db *storage.Client) error) error {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
 	}
+	// Handlers called from a batch join the batch's transaction.
+	return db.Transaction(ctx, func(ctx context.Context, tx *storage.Client) error {
+		return fn(context.WithValue(ctx, transactionKey{}, tx), tx)
+	})
 }
 
-func (s *RegistryServer) end() {
-	if s.database == "sqlite3" {
-		mutex.Unlock()
+// readTransaction runs fn with a consistent snapshot of the database.
+// Handlers called from fn read from the same snapshot.
+func (s *RegistryServer) readTransaction(ctx context.Context, fn func(ctx context.Context, db *storage.Client) error) error {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
 	}
+	return db.ReadTransaction(ctx, func(ctx context.Context, tx *storage.Client) error {
+		return fn(context.WithValue(ctx, transactionKey{}, tx), tx)
+	})
 }
 
 func (s *RegistryServer) getPubSubClient(ctx context.Context) (*pubsub.Client, error) {
@@ -134,10 +218,10 @@ func (s *RegistryServer) getPubSubClient(ctx context.Context) (*pubsub.Client, e
 }
 
 func (s *RegistryServer) Close() {
+	s.stopPurger()
+	s.stopOutbox()
+	s.notifications.close()
 	s.storageClient.Close()
-	if s.pubSubClient != nil {
-		s.pubSubClient.Topic(TopicName).Flush()
-	}
 }
 
 func isNotFound(err error) bool {
@@ -145,6 +229,7 @@ func isNotFound(err error) bool {
 }
 
 // GRPCListen starts a net.Listener and grpc.Server for this RegistryServer.
+// Connections use TLS if the server is configured with a certificate.
 // Caller is responsible for stopping server.
 func (rs *RegistryServer) ServeGRPC(addr *net.TCPAddr, opt ...grpc.ServerOption) (net.Listener, *grpc.Server, error) {
 	l, err := net.ListenTCP("tcp", addr)
@@ -152,6 +237,15 @@ func (rs *RegistryServer) ServeGRPC(addr *net.TCPAddr, opt ...grpc.ServerOption)
 		return nil, nil, err
 	}
 
+	if rs.tls != nil {
+		opt = append(opt, grpc.Creds(credentials.NewTLS(rs.tls.serverConfig("h2"))))
+	}
+	if len(rs.authenticators) > 0 {
+		opt = append(opt,
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(rs.authenticators...)),
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(rs.authenticators...)),
+		)
+	}
 	s := grpc.NewServer(opt...)
 	reflection.Register(s)
 	rpc.RegisterRegistryServer(s, rs)
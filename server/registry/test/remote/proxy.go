@@ -161,6 +161,20 @@ func (p *Proxy) DeleteProject(ctx context.Context, req *rpc.DeleteProjectRequest
 	return p.adminClient.GrpcClient().DeleteProject(ctx, req)
 }
 
+func (p *Proxy) UndeleteProject(ctx context.Context, req *rpc.UndeleteProjectRequest) (*rpc.Project, error) {
+	if p.adminClient == nil {
+		return nil, ErrAdminServiceUnavailable
+	}
+	return p.adminClient.GrpcClient().UndeleteProject(ctx, req)
+}
+
+func (p *Proxy) ListAuditEvents(ctx context.Context, req *rpc.ListAuditEventsRequest) (*rpc.ListAuditEventsResponse, error) {
+	if p.adminClient == nil {
+		return nil, ErrAdminServiceUnavailable
+	}
+	return p.adminClient.GrpcClient().ListAuditEvents(ctx, req)
+}
+
 // Apis
 
 func (p *Proxy) GetApi(ctx context.Context, req *rpc.GetApiRequest) (*rpc.Api, error) {
@@ -218,6 +232,17 @@ func (p *Proxy) DeleteApi(ctx context.Context, req *rpc.DeleteApiRequest) (*empt
 	return p.registryClient.GrpcClient().DeleteApi(ctx, req)
 }
 
+func (p *Proxy) UndeleteApi(ctx context.Context, req *rpc.UndeleteApiRequest) (*rpc.Api, error) {
+	req, _ = proto.Clone(req).(*rpc.UndeleteApiRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	response, err := p.registryClient.GrpcClient().UndeleteApi(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response.Name = p.testResourceName(response.Name)
+	return response, err
+}
+
 // Versions
 
 func (p *Proxy) GetApiVersion(ctx context.Context, req *rpc.GetApiVersionRequest) (*rpc.ApiVersion, error) {
@@ -275,6 +300,17 @@ func (p *Proxy) DeleteApiVersion(ctx context.Context, req *rpc.DeleteApiVersionR
 	return p.registryClient.GrpcClient().DeleteApiVersion(ctx, req)
 }
 
+func (p *Proxy) UndeleteApiVersion(ctx context.Context, req *rpc.UndeleteApiVersionRequest) (*rpc.ApiVersion, error) {
+	req, _ = proto.Clone(req).(*rpc.UndeleteApiVersionRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	response, err := p.registryClient.GrpcClient().UndeleteApiVersion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response.Name = p.testResourceName(response.Name)
+	return response, err
+}
+
 // Specs
 
 func (p *Proxy) GetApiSpec(ctx context.Context, req *rpc.GetApiSpecRequest) (*rpc.ApiSpec, error) {
@@ -332,6 +368,17 @@ func (p *Proxy) DeleteApiSpec(ctx context.Context, req *rpc.DeleteApiSpecRequest
 	return p.registryClient.GrpcClient().DeleteApiSpec(ctx, req)
 }
 
+func (p *Proxy) UndeleteApiSpec(ctx context.Context, req *rpc.UndeleteApiSpecRequest) (*rpc.ApiSpec, error) {
+	req, _ = proto.Clone(req).(*rpc.UndeleteApiSpecRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	response, err := p.registryClient.GrpcClient().UndeleteApiSpec(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response.Name = p.testResourceName(response.Name)
+	return response, err
+}
+
 func (p *Proxy) GetApiSpecContents(ctx context.Context, req *rpc.GetApiSpecContentsRequest) (*httpbody.HttpBody, error) {
 	req, _ = proto.Clone(req).(*rpc.GetApiSpecContentsRequest)
 	req.Name = p.hostedResourceName(req.Name)
@@ -439,6 +486,17 @@ func (p *Proxy) DeleteApiDeployment(ctx context.Context, req *rpc.DeleteApiDeplo
 	return p.registryClient.GrpcClient().DeleteApiDeployment(ctx, req)
 }
 
+func (p *Proxy) UndeleteApiDeployment(ctx context.Context, req *rpc.UndeleteApiDeploymentRequest) (*rpc.ApiDeployment, error) {
+	req, _ = proto.Clone(req).(*rpc.UndeleteApiDeploymentRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	response, err := p.registryClient.GrpcClient().UndeleteApiDeployment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response.Name = p.testResourceName(response.Name)
+	return response, err
+}
+
 func (p *Proxy) ListApiDeploymentRevisions(ctx context.Context, req *rpc.ListApiDeploymentRevisionsRequest) (*rpc.ListApiDeploymentRevisionsResponse, error) {
 	req, _ = proto.Clone(req).(*rpc.ListApiDeploymentRevisionsRequest)
 	req.Name = p.hostedResourceName(req.Name)
@@ -536,6 +594,17 @@ func (p *Proxy) DeleteArtifact(ctx context.Context, req *rpc.DeleteArtifactReque
 	return p.registryClient.GrpcClient().DeleteArtifact(ctx, req)
 }
 
+func (p *Proxy) UndeleteArtifact(ctx context.Context, req *rpc.UndeleteArtifactRequest) (*rpc.Artifact, error) {
+	req, _ = proto.Clone(req).(*rpc.UndeleteArtifactRequest)
+	req.Name = p.hostedResourceName(req.Name)
+	response, err := p.registryClient.GrpcClient().UndeleteArtifact(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response.Name = p.testResourceName(response.Name)
+	return response, err
+}
+
 func (p *Proxy) GetArtifactContents(ctx context.Context, req *rpc.GetArtifactContentsRequest) (*httpbody.HttpBody, error) {
 	req, _ = proto.Clone(req).(*rpc.GetArtifactContentsRequest)
 	req.Name = p.hostedResourceName(req.Name)
@@ -0,0 +1,165 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+)
+
+// TLSConfig configures TLS for the server's listener. Files are read again
+// when they change, so certificates can be rotated without a restart.
+type TLSConfig struct {
+	// CertFile and KeyFile hold the server's certificate chain and private
+	// key in PEM format. If CertFile is empty, the listener doesn't use TLS.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile holds the PEM certificates of the authorities that sign
+	// client certificates. If it is set, clients must present a certificate
+	// that one of them signed (mutual TLS).
+	ClientCAFile string
+}
+
+// tlsFiles provides TLS configurations that are read from files.
+type tlsFiles struct {
+	config TLSConfig
+
+	mu      sync.Mutex
+	stamps  []fileStamp
+	current *tls.Config
+}
+
+// fileStamp identifies a version of a file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func newTLSFiles(config TLSConfig) (*tlsFiles, error) {
+	if config.KeyFile == "" {
+		return nil, errors.New("TLS requires a key file")
+	}
+	f := &tlsFiles{config: config}
+	stamps, err := f.stat()
+	if err != nil {
+		return nil, err
+	}
+	if f.current, err = f.read(); err != nil {
+		return nil, err
+	}
+	f.stamps = stamps
+	return f, nil
+}
+
+func (f *tlsFiles) paths() []string {
+	paths := []string{f.config.CertFile, f.config.KeyFile}
+	if f.config.ClientCAFile != "" {
+		paths = append(paths, f.config.ClientCAFile)
+	}
+	return paths
+}
+
+func (f *tlsFiles) stat() ([]fileStamp, error) {
+	var stamps []fileStamp
+	for _, path := range f.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		stamps = append(stamps, fileStamp{modTime: info.ModTime(), size: info.Size()})
+	}
+	return stamps, nil
+}
+
+// read returns a configuration with the current contents of the files.
+func (f *tlsFiles) read() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(f.config.CertFile, f.config.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %s", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if f.config.ClientCAFile != "" {
+		pem, err := os.ReadFile(f.config.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", f.config.ClientCAFile)
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// serverConfig returns a configuration for TLS listeners that checks the
+// files for changes before each handshake. Connections negotiate one of
+// the application protocols in nextProtos.
+func (f *tlsFiles) serverConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			config, err := f.configForClient(hello)
+			if err != nil {
+				return nil, err
+			}
+			config = config.Clone()
+			config.NextProtos = nextProtos
+			return config, nil
+		},
+	}
+}
+
+func (f *tlsFiles) configForClient(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stamps, err := f.stat()
+	if err != nil || stampsEqual(stamps, f.stamps) {
+		return f.current, nil
+	}
+	// Files may be replaced one at a time, so failed reloads are retried
+	// with the next handshake and the previous configuration is used until then.
+	config, err := f.read()
+	if err != nil {
+		log.FromContext(hello.Context()).WithError(err).Warn("Failed to reload TLS certificates.")
+		return f.current, nil
+	}
+	f.current, f.stamps = config, stamps
+	log.FromContext(hello.Context()).Info("Reloaded TLS certificates.")
+	return f.current, nil
+}
+
+func stampsEqual(a, b []fileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].modTime.Equal(b[i].modTime) || a[i].size != b[i].size {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,270 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA issues certificates for tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Setup: failed to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Setup: failed to create CA certificate: %s", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns PEM encodings of a new certificate and its key.
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Setup: failed to generate key: %s", err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("Setup: failed to create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Setup: failed to marshal key: %s", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, contents []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, contents, 0600); err != nil {
+		t.Fatalf("Setup: failed to write %s: %s", path, err)
+	}
+}
+
+func TestServeGRPCWithTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	config := TLSConfig{
+		CertFile:     filepath.Join(dir, "server.crt"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+	}
+	writeFile(t, config.CertFile, serverCert)
+	writeFile(t, config.KeyFile, serverKey)
+	writeFile(t, config.ClientCAFile, ca.pem)
+
+	server, err := New(Config{
+		Database: "sqlite3",
+		DBConfig: filepath.Join(dir, "registry.db"),
+		TLS:      config,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %s", err)
+	}
+	t.Cleanup(server.Close)
+	l, s, err := server.ServeGRPC(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("ServeGRPC() returned error: %s", err)
+	}
+	t.Cleanup(s.Stop)
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.pem)
+	cert, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		t.Fatalf("Setup: failed to load client certificate: %s", err)
+	}
+	// handshake returns the common name of the server's certificate.
+	handshake := func(certs ...tls.Certificate) (string, error) {
+		conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{
+			RootCAs:      roots,
+			ServerName:   "localhost",
+			Certificates: certs,
+			NextProtos:   []string{"h2"},
+		})
+		if err != nil {
+			return "", err
+		}
+		defer conn.Close()
+		// TLS 1.3 servers report rejected client certificates after the handshake.
+		if err := conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond)); err != nil {
+			return "", err
+		}
+		if _, err := conn.Read(make([]byte, 1)); err != nil {
+			if ne, ok := err.(net.Error); !ok || !ne.Timeout() {
+				return "", err
+			}
+		}
+		return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+	}
+
+	if name, err := handshake(cert); err != nil {
+		t.Fatalf("handshake returned error: %s", err)
+	} else if name != "server" {
+		t.Errorf("server presented certificate for %q, want %q", name, "server")
+	}
+	if _, err := handshake(); err == nil {
+		t.Errorf("handshake without a client certificate succeeded, want error")
+	}
+
+	// Certificates are reloaded when their files change.
+	newCert, newKey := ca.issue(t, "rotated", x509.ExtKeyUsageServerAuth)
+	writeFile(t, config.CertFile, newCert)
+	writeFile(t, config.KeyFile, newKey)
+	future := time.Now().Add(time.Minute)
+	for _, path := range []string{config.CertFile, config.KeyFile} {
+		if err := os.Chtimes(path, future, future); err != nil {
+			t.Fatalf("Setup: failed to change times of %s: %s", path, err)
+		}
+	}
+	if name, err := handshake(cert); err != nil {
+		t.Fatalf("handshake returned error: %s", err)
+	} else if name != "rotated" {
+		t.Errorf("server presented certificate for %q, want %q", name, "rotated")
+	}
+
+	// Invalid files are not loaded and the previous certificate is used.
+	writeFile(t, config.KeyFile, []byte("invalid"))
+	if name, err := handshake(cert); err != nil {
+		t.Fatalf("handshake returned error: %s", err)
+	} else if name != "rotated" {
+		t.Errorf("server presented certificate for %q, want %q", name, "rotated")
+	}
+}
+
+func TestServeGatewayWithTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, "server", x509.ExtKeyUsageServerAuth)
+	config := TLSConfig{
+		CertFile: filepath.Join(dir, "server.crt"),
+		KeyFile:  filepath.Join(dir, "server.key"),
+	}
+	writeFile(t, config.CertFile, serverCert)
+	writeFile(t, config.KeyFile, serverKey)
+	server, err := New(Config{
+		Database: "sqlite3",
+		DBConfig: filepath.Join(dir, "registry.db"),
+		TLS:      config,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %s", err)
+	}
+	t.Cleanup(server.Close)
+	l, s, err := server.ServeGateway(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("ServeGateway() returned error: %s", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.pem)
+	for _, h2 := range []bool{false, true} {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: roots},
+			ForceAttemptHTTP2: h2,
+		}}
+		_, port, _ := net.SplitHostPort(l.Addr().String())
+		resp, err := client.Get("https://localhost:" + port + "/v1/status")
+		if err != nil {
+			t.Fatalf("GET /v1/status returned error: %s", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("GET /v1/status returned status %d", resp.StatusCode)
+		}
+		if got := resp.ProtoMajor == 2; got != h2 {
+			t.Errorf("GET /v1/status used %s, want HTTP/2 %t", resp.Proto, h2)
+		}
+	}
+}
+
+func TestTLSConfigErrors(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	cert, key := ca.issue(t, "server", x509.ExtKeyUsageServerAuth)
+	writeFile(t, filepath.Join(dir, "server.crt"), cert)
+	writeFile(t, filepath.Join(dir, "server.key"), key)
+	writeFile(t, filepath.Join(dir, "invalid.crt"), []byte("invalid"))
+
+	tests := []TLSConfig{
+		{KeyFile: filepath.Join(dir, "server.key")},
+		{CertFile: filepath.Join(dir, "server.crt")},
+		{CertFile: filepath.Join(dir, "missing.crt"), KeyFile: filepath.Join(dir, "server.key")},
+		{CertFile: filepath.Join(dir, "server.crt"), KeyFile: filepath.Join(dir, "invalid.crt")},
+		{CertFile: filepath.Join(dir, "server.crt"), KeyFile: filepath.Join(dir, "server.key"), ClientCAFile: filepath.Join(dir, "invalid.crt")},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			server, err := New(Config{
+				Database: "sqlite3",
+				DBConfig: filepath.Join(t.TempDir(), "registry.db"),
+				TLS:      test,
+			})
+			if err == nil {
+				server.Close()
+				t.Errorf("New(%+v) returned no error", test)
+			}
+		})
+	}
+}
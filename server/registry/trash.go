@@ -0,0 +1,105 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/pkg/log"
+	"github.com/apigee/registry/server/registry/internal/storage"
+)
+
+const (
+	// defaultDeleteRetention is the time deleted resources are kept in the trash.
+	defaultDeleteRetention = 30 * 24 * time.Hour
+	// purgeInterval is the time between removals of expired resources from the trash.
+	purgeInterval = time.Hour
+)
+
+// purger permanently deletes resources that have expired in the trash.
+type purger struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+// deleteExpireTime returns the expiration time of resources deleted now.
+func (s *RegistryServer) deleteExpireTime() time.Time {
+	return time.Now().Add(s.deleteRetention).UTC().Round(time.Microsecond)
+}
+
+// startPurger starts removing expired resources from the trash in the background.
+func (s *RegistryServer) startPurger() {
+	p := &purger{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go s.runPurger(p)
+	s.purger = p
+}
+
+// stopPurger stops the purger and waits for any purge in progress to finish.
+func (s *RegistryServer) stopPurger() {
+	p := s.purger
+	if p == nil {
+		return
+	}
+	s.purger = nil
+	close(p.stop)
+	<-p.done
+}
+
+func (s *RegistryServer) runPurger(p *purger) {
+	defer close(p.done)
+	ctx := context.Background()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-timer.C:
+		}
+		if err := s.purgeExpiredResources(ctx, time.Now()); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to purge expired resources.")
+		}
+		if err := s.deleteUnusedBlobContents(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to delete unused blob contents.")
+		}
+		timer.Reset(purgeInterval)
+	}
+}
+
+// purgeExpiredResources permanently deletes resources that expired before a time.
+func (s *RegistryServer) purgeExpiredResources(ctx context.Context, expired time.Time) error {
+	return s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		return db.PurgeExpiredResources(ctx, expired)
+	})
+}
+
+// deleteUnusedBlobContents removes contents from the blob store that are no
+// longer referenced or that were stored by transactions that were rolled back.
+// The store can't be rolled back, so this runs after purges are committed.
+func (s *RegistryServer) deleteUnusedBlobContents(ctx context.Context) error {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := db.DeleteUnreferencedBlobContents(ctx); err != nil {
+		return err
+	}
+	_, err = db.DeleteOrphanedBlobContents(ctx)
+	return err
+}
@@ -0,0 +1,445 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	trashProject = "projects/trash"
+	trashApi     = trashProject + "/locations/global/apis/petstore"
+	trashVersion = trashApi + "/versions/v1"
+	trashSpec    = trashVersion + "/specs/openapi"
+	trashReport  = trashSpec + "/artifacts/report"
+	trashDeploy  = trashApi + "/deployments/prod"
+)
+
+func seedTrash(ctx context.Context, t *testing.T, server TestServer) {
+	t.Helper()
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.ApiSpec{
+			Name:     trashSpec,
+			MimeType: "application/x.openapi;version=3.0.0",
+			Contents: petstoreContents("listPets"),
+		},
+		&rpc.Artifact{
+			Name:     trashReport,
+			MimeType: "text/plain",
+			Contents: []byte("report"),
+		},
+		&rpc.ApiDeployment{
+			Name:        trashDeploy,
+			EndpointUri: "https://petstore.example.com",
+		},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+}
+
+// checkFound verifies that each named resource can be retrieved.
+func checkFound(ctx context.Context, t *testing.T, server TestServer, want bool, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		var err error
+		switch name {
+		case trashProject:
+			_, err = server.GetProject(ctx, &rpc.GetProjectRequest{Name: name})
+		case trashApi:
+			_, err = server.GetApi(ctx, &rpc.GetApiRequest{Name: name})
+		case trashVersion:
+			_, err = server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: name})
+		case trashSpec:
+			_, err = server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: name})
+		case trashReport:
+			_, err = server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name})
+		case trashDeploy:
+			_, err = server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: name})
+		default:
+			t.Fatalf("unexpected resource %q", name)
+		}
+		if want && err != nil {
+			t.Errorf("Get(%q) returned error: %s", name, err)
+		} else if !want && status.Code(err) != codes.NotFound {
+			t.Errorf("Get(%q) returned status code %q, want %q: %v", name, status.Code(err), codes.NotFound, err)
+		}
+	}
+}
+
+func listApiNames(ctx context.Context, t *testing.T, server TestServer, showDeleted bool) []string {
+	t.Helper()
+	resp, err := server.ListApis(ctx, &rpc.ListApisRequest{
+		Parent:      trashProject + "/locations/global",
+		ShowDeleted: showDeleted,
+	})
+	if err != nil {
+		t.Fatalf("ListApis() returned error: %s", err)
+	}
+	names := make([]string, 0)
+	for _, api := range resp.GetApis() {
+		names = append(names, api.GetName())
+	}
+	return names
+}
+
+func TestDeleteMovesResourcesToTrash(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: trashApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, false, trashApi, trashVersion, trashSpec, trashReport, trashDeploy)
+	checkFound(ctx, t, server, true, trashProject)
+
+	if got := listApiNames(ctx, t, server, false); len(got) != 0 {
+		t.Errorf("ListApis() returned %v, want no APIs", got)
+	}
+	resp, err := server.ListApis(ctx, &rpc.ListApisRequest{
+		Parent:      trashProject + "/locations/global",
+		ShowDeleted: true,
+	})
+	if err != nil {
+		t.Fatalf("ListApis(show_deleted) returned error: %s", err)
+	}
+	if len(resp.GetApis()) != 1 {
+		t.Fatalf("ListApis(show_deleted) returned %d APIs, want 1", len(resp.GetApis()))
+	}
+	deleted := resp.GetApis()[0]
+	if deleted.GetName() != trashApi || deleted.GetDeleteTime() == nil {
+		t.Errorf("ListApis(show_deleted) returned %v, want deleted %q", deleted, trashApi)
+	}
+	if got, want := deleted.GetExpireTime().AsTime().Sub(deleted.GetDeleteTime().AsTime()), defaultDeleteRetention; got < want-time.Minute || got > want+time.Minute {
+		t.Errorf("ListApis(show_deleted) returned an API that expires after %s, want %s", got, want)
+	}
+
+	api, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: trashApi})
+	if err != nil {
+		t.Fatalf("UndeleteApi() returned error: %s", err)
+	}
+	if api.GetName() != trashApi || api.GetDeleteTime() != nil || api.GetExpireTime() != nil {
+		t.Errorf("UndeleteApi() returned %v, want restored %q", api, trashApi)
+	}
+	checkFound(ctx, t, server, true, trashApi, trashVersion, trashSpec, trashReport, trashDeploy)
+}
+
+func TestUndeleteProject(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if adminServiceUnavailable() {
+		t.Skip(testRequiresAdminService)
+	}
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: trashProject, Force: true}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, false, trashProject, trashApi, trashSpec, trashReport)
+
+	resp, err := server.ListProjects(ctx, &rpc.ListProjectsRequest{
+		Filter:      "name == '" + trashProject + "'",
+		ShowDeleted: true,
+	})
+	if err != nil {
+		t.Fatalf("ListProjects(show_deleted) returned error: %s", err)
+	}
+	if len(resp.GetProjects()) != 1 || resp.GetProjects()[0].GetDeleteTime() == nil {
+		t.Errorf("ListProjects(show_deleted) returned %v, want deleted %q", resp.GetProjects(), trashProject)
+	}
+
+	if _, err := server.UndeleteProject(ctx, &rpc.UndeleteProjectRequest{Name: trashProject}); err != nil {
+		t.Fatalf("UndeleteProject() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, true, trashProject, trashApi, trashSpec, trashReport)
+}
+
+func TestUndeleteApiSpec(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: trashSpec, Force: true}); err != nil {
+		t.Fatalf("DeleteApiSpec() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, false, trashSpec, trashReport)
+
+	resp, err := server.ListApiSpecs(ctx, &rpc.ListApiSpecsRequest{Parent: trashVersion, ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("ListApiSpecs(show_deleted) returned error: %s", err)
+	}
+	if len(resp.GetApiSpecs()) != 1 || resp.GetApiSpecs()[0].GetDeleteTime() == nil {
+		t.Errorf("ListApiSpecs(show_deleted) returned %v, want deleted %q", resp.GetApiSpecs(), trashSpec)
+	}
+
+	// Resources that were deleted before their parent stay deleted when the parent is restored.
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: trashApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if _, err := server.UndeleteApiSpec(ctx, &rpc.UndeleteApiSpecRequest{Name: trashSpec}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UndeleteApiSpec() with deleted parent returned status code %q, want %q: %v", status.Code(err), codes.FailedPrecondition, err)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: trashApi}); err != nil {
+		t.Fatalf("UndeleteApi() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, true, trashApi, trashVersion)
+	checkFound(ctx, t, server, false, trashSpec, trashReport)
+
+	spec, err := server.UndeleteApiSpec(ctx, &rpc.UndeleteApiSpecRequest{Name: trashSpec})
+	if err != nil {
+		t.Fatalf("UndeleteApiSpec() returned error: %s", err)
+	}
+	if spec.GetName() != trashSpec || spec.GetDeleteTime() != nil {
+		t.Errorf("UndeleteApiSpec() returned %v, want restored %q", spec, trashSpec)
+	}
+	checkFound(ctx, t, server, true, trashSpec, trashReport)
+}
+
+func TestUndeleteApiVersion(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: trashVersion, Force: true}); err != nil {
+		t.Fatalf("DeleteApiVersion() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, false, trashVersion, trashSpec, trashReport)
+	checkFound(ctx, t, server, true, trashApi, trashDeploy)
+
+	resp, err := server.ListApiVersions(ctx, &rpc.ListApiVersionsRequest{Parent: trashApi, ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("ListApiVersions(show_deleted) returned error: %s", err)
+	}
+	if len(resp.GetApiVersions()) != 1 || resp.GetApiVersions()[0].GetDeleteTime() == nil {
+		t.Errorf("ListApiVersions(show_deleted) returned %v, want deleted %q", resp.GetApiVersions(), trashVersion)
+	}
+
+	version, err := server.UndeleteApiVersion(ctx, &rpc.UndeleteApiVersionRequest{Name: trashVersion})
+	if err != nil {
+		t.Fatalf("UndeleteApiVersion() returned error: %s", err)
+	}
+	if version.GetName() != trashVersion || version.GetDeleteTime() != nil {
+		t.Errorf("UndeleteApiVersion() returned %v, want restored %q", version, trashVersion)
+	}
+	checkFound(ctx, t, server, true, trashVersion, trashSpec, trashReport)
+}
+
+func TestUndeleteApiDeployment(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteApiDeployment(ctx, &rpc.DeleteApiDeploymentRequest{Name: trashDeploy}); err != nil {
+		t.Fatalf("DeleteApiDeployment() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, false, trashDeploy)
+	checkFound(ctx, t, server, true, trashApi, trashVersion)
+
+	resp, err := server.ListApiDeployments(ctx, &rpc.ListApiDeploymentsRequest{Parent: trashApi, ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("ListApiDeployments(show_deleted) returned error: %s", err)
+	}
+	if len(resp.GetApiDeployments()) != 1 || resp.GetApiDeployments()[0].GetDeleteTime() == nil {
+		t.Errorf("ListApiDeployments(show_deleted) returned %v, want deleted %q", resp.GetApiDeployments(), trashDeploy)
+	}
+
+	deployment, err := server.UndeleteApiDeployment(ctx, &rpc.UndeleteApiDeploymentRequest{Name: trashDeploy})
+	if err != nil {
+		t.Fatalf("UndeleteApiDeployment() returned error: %s", err)
+	}
+	if deployment.GetName() != trashDeploy || deployment.GetDeleteTime() != nil || deployment.GetEndpointUri() != "https://petstore.example.com" {
+		t.Errorf("UndeleteApiDeployment() returned %v, want restored %q", deployment, trashDeploy)
+	}
+	checkFound(ctx, t, server, true, trashDeploy)
+}
+
+func TestUndeleteArtifact(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: trashReport}); err != nil {
+		t.Fatalf("DeleteArtifact() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, false, trashReport)
+	checkFound(ctx, t, server, true, trashSpec)
+
+	resp, err := server.ListArtifacts(ctx, &rpc.ListArtifactsRequest{Parent: trashSpec, ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("ListArtifacts(show_deleted) returned error: %s", err)
+	}
+	if len(resp.GetArtifacts()) != 1 || resp.GetArtifacts()[0].GetDeleteTime() == nil {
+		t.Errorf("ListArtifacts(show_deleted) returned %v, want deleted %q", resp.GetArtifacts(), trashReport)
+	}
+
+	// Artifacts can't be restored while their parent is deleted.
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: trashSpec}); err != nil {
+		t.Fatalf("DeleteApiSpec() returned error: %s", err)
+	}
+	if _, err := server.UndeleteArtifact(ctx, &rpc.UndeleteArtifactRequest{Name: trashReport}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UndeleteArtifact() with deleted parent returned status code %q, want %q: %v", status.Code(err), codes.FailedPrecondition, err)
+	}
+	if _, err := server.UndeleteApiSpec(ctx, &rpc.UndeleteApiSpecRequest{Name: trashSpec}); err != nil {
+		t.Fatalf("UndeleteApiSpec() returned error: %s", err)
+	}
+
+	artifact, err := server.UndeleteArtifact(ctx, &rpc.UndeleteArtifactRequest{Name: trashReport})
+	if err != nil {
+		t.Fatalf("UndeleteArtifact() returned error: %s", err)
+	}
+	if artifact.GetDeleteTime() != nil {
+		t.Errorf("UndeleteArtifact() returned %v, want restored %q", artifact, trashReport)
+	}
+	checkFound(ctx, t, server, true, trashReport)
+
+	// Artifacts that are created again replace deleted ones.
+	if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: trashReport}); err != nil {
+		t.Fatalf("DeleteArtifact() returned error: %s", err)
+	}
+	if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     trashSpec,
+		ArtifactId: "report",
+		Artifact:   &rpc.Artifact{MimeType: "text/plain", Contents: []byte("new report")},
+	}); err != nil {
+		t.Fatalf("CreateArtifact() returned error: %s", err)
+	}
+	if _, err := server.UndeleteArtifact(ctx, &rpc.UndeleteArtifactRequest{Name: trashReport}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("UndeleteArtifact() returned status code %q, want %q: %v", status.Code(err), codes.AlreadyExists, err)
+	}
+}
+
+func TestUndeleteResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	tests := []struct {
+		desc string
+		req  *rpc.UndeleteApiRequest
+		want codes.Code
+	}{
+		{
+			desc: "invalid name",
+			req:  &rpc.UndeleteApiRequest{Name: "invalid"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "never deleted",
+			req:  &rpc.UndeleteApiRequest{Name: trashApi},
+			want: codes.AlreadyExists,
+		},
+		{
+			desc: "not found",
+			req:  &rpc.UndeleteApiRequest{Name: trashProject + "/locations/global/apis/missing"},
+			want: codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.UndeleteApi(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("UndeleteApi(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestCreateReplacesDeletedResource(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: trashApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: trashProject + "/locations/global",
+		ApiId:  "petstore",
+		Api:    &rpc.Api{DisplayName: "New Petstore"},
+	}); err != nil {
+		t.Fatalf("CreateApi() returned error: %s", err)
+	}
+	checkFound(ctx, t, server, false, trashVersion, trashSpec, trashReport)
+	if diff := cmp.Diff([]string{trashApi}, listApiNames(ctx, t, server, true)); diff != "" {
+		t.Errorf("ListApis(show_deleted) returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: trashApi}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("UndeleteApi() returned status code %q, want %q: %v", status.Code(err), codes.AlreadyExists, err)
+	}
+}
+
+func TestPurgeExpiredResources(t *testing.T) {
+	ctx := context.Background()
+	server, ok := defaultTestServer(t).(*RegistryServer)
+	if !ok {
+		t.Skip("purging requires a local server")
+	}
+	seedTrash(ctx, t, server)
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: trashApi, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+
+	// Resources are kept until they expire.
+	if err := server.purgeExpiredResources(ctx, time.Now()); err != nil {
+		t.Fatalf("purgeExpiredResources() returned error: %s", err)
+	}
+	if diff := cmp.Diff([]string{trashApi}, listApiNames(ctx, t, server, true)); diff != "" {
+		t.Errorf("ListApis(show_deleted) returned unexpected diff (-want +got):\n%s", diff)
+	}
+
+	if err := server.purgeExpiredResources(ctx, time.Now().Add(defaultDeleteRetention+time.Minute)); err != nil {
+		t.Fatalf("purgeExpiredResources() returned error: %s", err)
+	}
+	if got := listApiNames(ctx, t, server, true); len(got) != 0 {
+		t.Errorf("ListApis(show_deleted) returned %v, want no APIs", got)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: trashApi}); status.Code(err) != codes.NotFound {
+		t.Errorf("UndeleteApi() returned status code %q, want %q: %v", status.Code(err), codes.NotFound, err)
+	}
+	checkFound(ctx, t, server, true, trashProject)
+}
+
+func TestSearchHidesDeletedResources(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedTrash(ctx, t, server)
+
+	search := func() []string {
+		t.Helper()
+		return searchNames(ctx, t, server, trashProject+"/locations/global", "listPets")
+	}
+
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: trashSpec, Force: true}); err != nil {
+		t.Fatalf("DeleteApiSpec() returned error: %s", err)
+	}
+	if got := search(); len(got) != 0 {
+		t.Errorf("SearchResources() returned %v, want no results", got)
+	}
+	if _, err := server.UndeleteApiSpec(ctx, &rpc.UndeleteApiSpecRequest{Name: trashSpec}); err != nil {
+		t.Fatalf("UndeleteApiSpec() returned error: %s", err)
+	}
+	if diff := cmp.Diff([]string{trashSpec}, search()); diff != "" {
+		t.Errorf("SearchResources() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
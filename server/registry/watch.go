@@ -0,0 +1,133 @@
+// Copyright 2023 Google LLC.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/apigee/registry/rpc"
+)
+
+// watchBufferSize is the number of changes that can be queued for a watcher
+// before it is considered to have fallen behind.
+const watchBufferSize = 1000
+
+// watcher receives the changes published after it subscribed.
+// The events channel is closed if the watcher falls behind.
+type watcher struct {
+	events chan *rpc.Notification
+}
+
+// watchHub distributes notifications to watchers. Changes that were published
+// before a watcher subscribed are read from the outbox, which keeps them for
+// outboxRetention.
+type watchHub struct {
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		watchers: make(map[*watcher]struct{}),
+	}
+}
+
+// publish delivers a change to all current watchers.
+func (h *watchHub) publish(n *rpc.Notification) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for w := range h.watchers {
+		select {
+		case w.events <- n:
+		default:
+			// Rather than block publishers, drop watchers that can't keep up.
+			// They can resume from the last change they received.
+			close(w.events)
+			delete(h.watchers, w)
+		}
+	}
+}
+
+// subscribe registers a new watcher.
+func (h *watchHub) subscribe() *watcher {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	w := &watcher{events: make(chan *rpc.Notification, watchBufferSize)}
+	h.watchers[w] = struct{}{}
+	return w
+}
+
+// unsubscribe removes a watcher.
+func (h *watchHub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.events)
+	}
+}
+
+// watchToken returns a resume token for the change with sequence number seq.
+// Sequence numbers are assigned by the outbox, so tokens remain valid across
+// server restarts and between server instances that share a database.
+func watchToken(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(seq, 10)))
+}
+
+// parseWatchToken returns the sequence number of the change identified by a
+// resume token, or zero if the token is empty.
+func parseWatchToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	seq, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || seq <= 0 {
+		return 0, fmt.Errorf("malformed token")
+	}
+	return seq, nil
+}
+
+// matchesPattern returns true if name is the name of a resource that matches
+// pattern or is a child of a resource that matches pattern. In patterns, "-"
+// matches any ID and IDs without revisions match IDs with any revision.
+func matchesPattern(pattern, name string) bool {
+	p := strings.Split(pattern, "/")
+	n := strings.Split(name, "/")
+	if len(n) < len(p) {
+		return false
+	}
+	for i := range p {
+		if p[i] == "-" || p[i] == n[i] {
+			continue
+		}
+		if id, _, ok := strings.Cut(n[i], "@"); ok && !strings.Contains(p[i], "@") && id == p[i] {
+			continue
+		}
+		return false
+	}
+	return true
+}
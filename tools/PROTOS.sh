@@ -23,6 +23,7 @@ ALL_PROTOS=(
 	google/cloud/apigeeregistry/v1/scoring/*.proto
 	google/cloud/apigeeregistry/v1/style/*.proto
 	google/cloud/apigeeregistry/v1/check/*.proto
+	google/cloud/apigeeregistry/v1/diff/*.proto
 )
 
 SERVICE_PROTOS=(